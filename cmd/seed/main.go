@@ -0,0 +1,56 @@
+// Command seed loads the 5e SRD content embedded in the binary into the
+// database configured by the same DB_* variables as the server. It can be run
+// again after an upgrade: entries are matched by name and links are only added
+// when missing.
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+
+	"github.com/proyecto-dnd/backend/internal/seed"
+)
+
+func main() {
+	// The variables may come from the environment alone, as in containers.
+	if err := godotenv.Load(); err != nil {
+		log.Println("no .env file, using the environment")
+	}
+
+	db, err := connectDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	service := seed.NewSeedService(seed.NewSeedRepository(db))
+	report, err := service.LoadSRD()
+	if err != nil {
+		log.Fatal(err)
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func connectDB() (*sql.DB, error) {
+	dataSource := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true",
+		os.Getenv("DB_USERNAME"), os.Getenv("DB_PASSWORD"), os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_NAME"))
+	db, err := sql.Open("mysql", dataSource)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
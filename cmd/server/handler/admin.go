@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+var (
+	errAdminLogin = errors.New("log in as an administrator")
+	errNotAdmin   = errors.New("only administrators can do this")
+)
+
+// admins are the users allowed to seed the database and to manage the content
+// of generic classes. Blank ids are dropped, so an unset list lets nobody in.
+type admins struct {
+	userService user.ServiceUsers
+	ids         []string
+}
+
+func newAdmins(userService *user.ServiceUsers, ids []string) admins {
+	kept := []string{}
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			kept = append(kept, id)
+		}
+	}
+	return admins{userService: *userService, ids: kept}
+}
+
+// authorize checks the logged in user is an administrator.
+func (a admins) authorize(ctx *gin.Context) error {
+	cookie, err := ctx.Request.Cookie("Session")
+	if err != nil {
+		return errAdminLogin
+	}
+	claims, err := a.userService.GetJwtInfo(cookie.Value)
+	if err != nil {
+		return errAdminLogin
+	}
+	if !slices.Contains(a.ids, claims.Id) {
+		return errNotAdmin
+	}
+	return nil
+}
@@ -5,15 +5,21 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/proyecto-dnd/backend/internal/armor"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type ArmorHandler struct {
-	service armor.ArmorService
+	service    armor.ArmorService
+	homebrew   homebrew
+	translator translator
 }
 
-func NewArmorHandler(service *armor.ArmorService) *ArmorHandler {
-	return &ArmorHandler{service: *service}
+func NewArmorHandler(service *armor.ArmorService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *ArmorHandler {
+	return &ArmorHandler{service: *service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 func (h *ArmorHandler) HandlerCreate() gin.HandlerFunc {
@@ -23,6 +29,10 @@ func (h *ArmorHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		if err := h.homebrew.authorize(ctx, tempArmor.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdArmor, err := h.service.CreateArmor(tempArmor)
 		if err != nil {
@@ -36,13 +46,21 @@ func (h *ArmorHandler) HandlerCreate() gin.HandlerFunc {
 
 func (h *ArmorHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		armorList, err := h.service.GetAllArmor()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
-
+			ctx.JSON(400, err.Error())
+			return
+		}
+		armorList, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "armor", armorList.Items, armorText); err != nil {
 			ctx.JSON(500, err.Error())
 			return
 		}
-		ctx.JSON(200, armorList)
+		ctx.JSON(200, catalogList(params, armorList))
 	}
 }
 
@@ -61,6 +79,14 @@ func (h *ArmorHandler) HandlerGetById() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		if err := h.homebrew.visible(ctx, tempArmor.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "armor", &tempArmor, armorText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, tempArmor)
 	}
 }
@@ -80,6 +106,15 @@ func (h *ArmorHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetArmorByID(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId, tempArmor.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		updatedArmor, err := h.service.UpdateArmor(tempArmor, intId)
 		if err != nil {
@@ -99,6 +134,15 @@ func (h *ArmorHandler) HandlerDelete() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetArmorByID(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		serviceErr := h.service.DeleteArmor(intId)
 		if serviceErr != nil {
@@ -109,3 +153,7 @@ func (h *ArmorHandler) HandlerDelete() gin.HandlerFunc {
 		ctx.JSON(200, "Deleted Armor with id "+id)
 	}
 }
+
+func armorText(a *domain.Armor) translation.Text {
+	return translation.Text{Id: a.ArmorId, Name: &a.Name, Description: &a.Description}
+}
@@ -5,16 +5,26 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/armor"
 	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/equipment"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type ArmorXCharacterDataHandler struct {
-	service armorXCharacterData.ServiceArmorXCharacterData
+	service              armorXCharacterData.ServiceArmorXCharacterData
+	characterDataService characterdata.ServiceCharacterData
+	equipmentService     equipment.ServiceEquipment
+	armorService         armor.ArmorService
+	homebrew             homebrew
 }
 
-func NewArmorXCharacterDataHandler(service *armorXCharacterData.ServiceArmorXCharacterData) *ArmorXCharacterDataHandler {
-	return &ArmorXCharacterDataHandler{service: *service}
+func NewArmorXCharacterDataHandler(service *armorXCharacterData.ServiceArmorXCharacterData, characterDataService *characterdata.ServiceCharacterData, equipmentService *equipment.ServiceEquipment, armorService *armor.ArmorService, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *ArmorXCharacterDataHandler {
+	return &ArmorXCharacterDataHandler{service: *service, characterDataService: *characterDataService, equipmentService: *equipmentService, armorService: *armorService, homebrew: newHomebrew(campaignService, userService)}
 }
 
 // armorXCharacterData godoc
@@ -23,7 +33,7 @@ func NewArmorXCharacterDataHandler(service *armorXCharacterData.ServiceArmorXCha
 // @Accept json
 // @Produce json
 // @Param body body domain.ArmorXCharacterData true "ArmorXCharacterData"
-// @Success 201 {object} domain.ArmorXCharacterData
+// @Success 201 {object} dto.ArmorXCharacterDataResponseDto
 // @Failure 400 {object} error
 // @Failure 500 {object} error
 // @Router /armor_character [post]
@@ -34,13 +44,35 @@ func (h *ArmorXCharacterDataHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.AbortWithError(400, err)
 			return
 		}
+		if err := linkable(ctx, h.homebrew, h.armorService.GetArmorByID, tempArmorXCharacterData.Armor.ArmorId, func(a domain.Armor) *int { return a.CampaignId }); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		equipped, slot := tempArmorXCharacterData.Equipped, tempArmorXCharacterData.Slot
+		tempArmorXCharacterData.Equipped, tempArmorXCharacterData.Slot, tempArmorXCharacterData.Attuned = false, "", false
 		createdArmorXCharacterData, err := h.service.CreateArmorXCharacterData(tempArmorXCharacterData)
 		if err != nil {
 			fmt.Println(err)
 			ctx.AbortWithError(500, err)
 			return
 		}
-		ctx.JSON(201, createdArmorXCharacterData)
+		if equipped {
+			err = applyEquipped(h.equipmentService, createdArmorXCharacterData.CharacterData_Id, equipment.KindArmor, createdArmorXCharacterData.ArmorXCharacterData_Id, true, slot)
+			if err != nil {
+				h.service.DeleteArmorXCharacterData(createdArmorXCharacterData.ArmorXCharacterData_Id)
+				ctx.JSON(equipmentErrorStatus(err), err.Error())
+				return
+			}
+			createdArmorXCharacterData, err = h.service.GetByIdArmorXCharacterData(createdArmorXCharacterData.ArmorXCharacterData_Id)
+			if err != nil {
+				ctx.AbortWithError(500, err)
+				return
+			}
+		}
+		ctx.JSON(201, dto.ArmorXCharacterDataResponseDto{
+			ArmorXCharacterData: createdArmorXCharacterData,
+			EncumbranceWarning:  encumbranceWarning(h.characterDataService, createdArmorXCharacterData.CharacterData_Id),
+		})
 	}
 }
 
@@ -192,12 +224,41 @@ func (h *ArmorXCharacterDataHandler) HandlerUpdate() gin.HandlerFunc {
 
 		tempArmorXCharacterData.ArmorXCharacterData_Id = id
 
+		current, err := h.service.GetByIdArmorXCharacterData(id)
+		if err != nil {
+			ctx.AbortWithError(404, err)
+			return
+		}
+		// Equipping goes through the slot rules; armor changing hands is
+		// always unequipped.
+		if err := linkable(ctx, h.homebrew, h.armorService.GetArmorByID, tempArmorXCharacterData.Armor.ArmorId, func(a domain.Armor) *int { return a.CampaignId }); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		equipped, slot := tempArmorXCharacterData.Equipped, tempArmorXCharacterData.Slot
+		tempArmorXCharacterData.Equipped, tempArmorXCharacterData.Slot, tempArmorXCharacterData.Attuned = current.Equipped, current.Slot, current.Attuned
+		if tempArmorXCharacterData.CharacterData_Id != current.CharacterData_Id {
+			tempArmorXCharacterData.Equipped, tempArmorXCharacterData.Slot, tempArmorXCharacterData.Attuned = false, "", false
+		}
+
 		updatedItemXCharacterData, err := h.service.UpdateArmorXCharacterData(tempArmorXCharacterData)
 		if err != nil {
 			fmt.Println(err)
 			ctx.AbortWithError(500, err)
 			return
 		}
+		if equipped != updatedItemXCharacterData.Equipped {
+			err = applyEquipped(h.equipmentService, updatedItemXCharacterData.CharacterData_Id, equipment.KindArmor, id, equipped, slot)
+			if err != nil {
+				ctx.JSON(equipmentErrorStatus(err), err.Error())
+				return
+			}
+			updatedItemXCharacterData, err = h.service.GetByIdArmorXCharacterData(id)
+			if err != nil {
+				ctx.AbortWithError(500, err)
+				return
+			}
+		}
 		ctx.JSON(200, updatedItemXCharacterData)
 	}
 }
\ No newline at end of file
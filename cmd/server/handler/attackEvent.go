@@ -23,7 +23,9 @@ func NewAttackEventHandler(service *attackEvent.AttackEventService) *AttackEvent
 // @Accept json
 // @Produce json
 // @Param body body dto.CreateAttackEventDto true "CreateEventDto"
+// @Param slotlevel query int false "Spell slot level used to cast the spell"
 // @Success 201 {object} domain.Event
+// @Failure 400 {object} error
 // @Failure 500 {object} error
 // @Router /event [post]
 func (h *AttackEventHandler) HandlerCreate() gin.HandlerFunc {
@@ -34,9 +36,18 @@ func (h *AttackEventHandler) HandlerCreate() gin.HandlerFunc {
 			return
 		}
 
+		if slotParam := ctx.Query("slotlevel"); slotParam != "" {
+			slotLevel, err := strconv.Atoi(slotParam)
+			if err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+			tempEvent.SlotLevel = slotLevel
+		}
+
 		createdEvent, err := h.service.CreateEvent(tempEvent)
 		if err != nil {
-			ctx.JSON(500, err.Error())
+			ctx.JSON(statusErrorCode(err), err.Error())
 			return
 		}
 
@@ -1,19 +1,28 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
+var errInvalidBackgroundId = errors.New("background id must be a number")
+
 type BackgroundHandler struct {
-	service background.BackgroundService
+	service    background.BackgroundService
+	homebrew   homebrew
+	translator translator
 }
 
-func NewBackgroundHandler(service background.BackgroundService) *BackgroundHandler {
-	return &BackgroundHandler{service: service}
+func NewBackgroundHandler(service background.BackgroundService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *BackgroundHandler {
+	return &BackgroundHandler{service: service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 func (h *BackgroundHandler) HandlerCreate() gin.HandlerFunc {
@@ -23,6 +32,10 @@ func (h *BackgroundHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		if err := h.homebrew.authorize(ctx, tempBackground.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdBackground, err := h.service.CreateBackground(tempBackground)
 		if err != nil {
@@ -36,13 +49,21 @@ func (h *BackgroundHandler) HandlerCreate() gin.HandlerFunc {
 
 func (h *BackgroundHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		backgroundList, err := h.service.GetAllBackgrounds()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
-
+			ctx.JSON(400, err.Error())
+			return
+		}
+		backgroundList, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "background", backgroundList.Items, backgroundText); err != nil {
 			ctx.JSON(500, err.Error())
 			return
 		}
-		ctx.JSON(200, backgroundList)
+		ctx.JSON(200, catalogList(params, backgroundList))
 	}
 }
 
@@ -61,6 +82,14 @@ func (h *BackgroundHandler) HandlerGetById() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		if err := h.homebrew.visible(ctx, tempBackground.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "background", &tempBackground, backgroundText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, tempBackground)
 	}
 }
@@ -80,6 +109,15 @@ func (h *BackgroundHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetBackgroundByID(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId, tempBackground.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		updatedBackground, err := h.service.UpdateBackground(tempBackground, intId)
 		if err != nil {
@@ -99,6 +137,15 @@ func (h *BackgroundHandler) HandlerDelete() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetBackgroundByID(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		serviceErr := h.service.DeleteBackground(intId)
 		if serviceErr != nil {
@@ -109,3 +156,150 @@ func (h *BackgroundHandler) HandlerDelete() gin.HandlerFunc {
 		ctx.JSON(200, "Deleted Background with id "+id)
 	}
 }
+
+func (h *BackgroundHandler) HandlerGetGrants() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetBackgroundByID(id)
+		if err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		if err := h.homebrew.visible(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		h.respondGrants(ctx, id)
+	}
+}
+
+func (h *BackgroundHandler) HandlerAddSkill() gin.HandlerFunc {
+	return h.changeGrant("skillid", background.BackgroundService.AddSkill)
+}
+
+func (h *BackgroundHandler) HandlerRemoveSkill() gin.HandlerFunc {
+	return h.changeGrant("skillid", background.BackgroundService.RemoveSkill)
+}
+
+func (h *BackgroundHandler) HandlerAddProficiency() gin.HandlerFunc {
+	return h.changeGrant("proficiencyid", background.BackgroundService.AddProficiency)
+}
+
+func (h *BackgroundHandler) HandlerRemoveProficiency() gin.HandlerFunc {
+	return h.changeGrant("proficiencyid", background.BackgroundService.RemoveProficiency)
+}
+
+func (h *BackgroundHandler) HandlerRemoveEquipment() gin.HandlerFunc {
+	return h.changeGrant("equipmentid", background.BackgroundService.RemoveEquipment)
+}
+
+// HandlerSetFeature sets the background feature; a null feature_id removes it.
+func (h *BackgroundHandler) HandlerSetFeature() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := h.authorizeBackground(ctx)
+		if err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		var request dto.BackgroundFeatureDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.service.SetFeature(id, request.FeatureId); err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		h.respondGrants(ctx, id)
+	}
+}
+
+func (h *BackgroundHandler) HandlerAddEquipment() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := h.authorizeBackground(ctx)
+		if err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		var request domain.BackgroundEquipment
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		created, err := h.service.AddEquipment(id, request)
+		if err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, created)
+	}
+}
+
+// changeGrant applies a change that takes the background and the id in param.
+func (h *BackgroundHandler) changeGrant(param string, apply func(service background.BackgroundService, id int, grantId int) error) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := h.authorizeBackground(ctx)
+		if err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		grantId, err := strconv.Atoi(ctx.Param(param))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := apply(h.service, id, grantId); err != nil {
+			ctx.JSON(backgroundErrorStatus(err), err.Error())
+			return
+		}
+		h.respondGrants(ctx, id)
+	}
+}
+
+// authorizeBackground reads the background id and checks the user may change
+// the background, which for homebrew means owning its campaign.
+func (h *BackgroundHandler) authorizeBackground(ctx *gin.Context) (int, error) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return 0, errInvalidBackgroundId
+	}
+	current, err := h.service.GetBackgroundByID(id)
+	if err != nil {
+		return 0, err
+	}
+	return id, h.homebrew.authorize(ctx, current.CampaignId)
+}
+
+func (h *BackgroundHandler) respondGrants(ctx *gin.Context, id int) {
+	grants, err := h.service.GetGrants(id)
+	if err != nil {
+		ctx.JSON(backgroundErrorStatus(err), err.Error())
+		return
+	}
+	ctx.JSON(200, grants)
+}
+
+func backgroundErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errInvalidBackgroundId),
+		errors.Is(err, background.ErrUnknownSkill),
+		errors.Is(err, background.ErrUnknownProficiency),
+		errors.Is(err, background.ErrUnknownFeature),
+		errors.Is(err, background.ErrUnknownEquipment),
+		errors.Is(err, background.ErrInvalidKind),
+		errors.Is(err, background.ErrInvalidQuantity):
+		return 400
+	case errors.Is(err, background.ErrNotFound),
+		errors.Is(err, background.ErrNotGranted):
+		return 404
+	}
+	return homebrewErrorStatus(err)
+}
+
+func backgroundText(b *domain.Background) translation.Text {
+	return translation.Text{Id: b.BackgroundID, Name: &b.Name}
+}
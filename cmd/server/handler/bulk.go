@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/bulk"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+// catalogFileField is the multipart field catalog imports are read from.
+const catalogFileField = "file"
+
+// maxCatalogFileSize bounds the body of a catalog import.
+const maxCatalogFileSize = 10 << 20
+
+var errCatalogFileTooLarge = errors.New("the file is larger than 10 MB")
+
+var catalogContentTypes = map[string]string{
+	bulk.FormatCSV:  "text/csv",
+	bulk.FormatJSON: "application/json",
+	bulk.FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type BulkHandler struct {
+	service  bulk.ServiceBulk
+	homebrew homebrew
+}
+
+func NewBulkHandler(service *bulk.ServiceBulk, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *BulkHandler {
+	return &BulkHandler{service: *service, homebrew: newHomebrew(campaignService, userService)}
+}
+
+// HandlerImport upserts the rows of an uploaded CSV, JSON or XLSX file into a
+// catalog. The format is taken from the format query parameter or the file
+// extension, the scope from campaign_id, and dry_run only reports what would
+// change.
+func (h *BulkHandler) HandlerImport(catalogName string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		campaignId, err := campaignQuery(ctx)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		dryRun := false
+		if param := ctx.Query("dry_run"); param != "" {
+			if dryRun, err = strconv.ParseBool(param); err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+		}
+		if err := h.homebrew.authorize(ctx, campaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxCatalogFileSize)
+		header, err := ctx.FormFile(catalogFileField)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				ctx.JSON(413, errCatalogFileTooLarge.Error())
+				return
+			}
+			ctx.JSON(400, err.Error())
+			return
+		}
+		format := ctx.Query("format")
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+		}
+		file, err := header.Open()
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		defer file.Close()
+
+		report, err := h.service.Import(catalogName, format, file, campaignId, dryRun)
+		if errors.Is(err, bulk.ErrInvalidRows) {
+			ctx.JSON(422, report)
+			return
+		}
+		if err != nil {
+			ctx.JSON(bulkErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, report)
+	}
+}
+
+// HandlerExport downloads the generic entries of a catalog, or those of the
+// campaign in campaign_id, in a file that imports back unchanged.
+func (h *BulkHandler) HandlerExport(catalogName string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		campaignId, err := campaignQuery(ctx)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		format := ctx.DefaultQuery("format", bulk.FormatJSON)
+		file, err := h.service.Export(catalogName, format, campaignId, h.homebrew.viewer(ctx))
+		if err != nil {
+			ctx.JSON(bulkErrorStatus(err), err.Error())
+			return
+		}
+		scope := "generic"
+		if campaignId != nil {
+			scope = fmt.Sprintf("campaign-%d", *campaignId)
+		}
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.%s", catalogName, scope, format))
+		ctx.Data(200, catalogContentTypes[format], file)
+	}
+}
+
+// campaignQuery reads the optional campaign_id query parameter.
+func campaignQuery(ctx *gin.Context) (*int, error) {
+	param := ctx.Query("campaign_id")
+	if param == "" {
+		return nil, nil
+	}
+	id, err := strconv.Atoi(param)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func bulkErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, bulk.ErrUnknownCatalog):
+		return 404
+	case errors.Is(err, bulk.ErrUnknownFormat),
+		errors.Is(err, bulk.ErrInvalidFile),
+		errors.Is(err, bulk.ErrUnknownColumn),
+		errors.Is(err, bulk.ErrEmptyFile):
+		return 400
+	}
+	return 500
+}
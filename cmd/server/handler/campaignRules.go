@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	campaignrules "github.com/proyecto-dnd/backend/internal/campaignRules"
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+type CampaignRulesHandler struct {
+	service campaignrules.ServiceCampaignRules
+}
+
+func NewCampaignRulesHandler(service *campaignrules.ServiceCampaignRules) *CampaignRulesHandler {
+	return &CampaignRulesHandler{service: *service}
+}
+
+func (h *CampaignRulesHandler) HandlerGet() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		rules, err := h.service.GetByCampaignId(id)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, rules)
+	}
+}
+
+func (h *CampaignRulesHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var rules domain.CampaignRules
+		if err := ctx.BindJSON(&rules); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		rules.CampaignId = id
+		updatedRules, err := h.service.Update(rules, cookie.Value)
+		if err != nil {
+			if err == campaignrules.ErrNotDungeonMaster {
+				ctx.JSON(403, err.Error())
+				return
+			}
+			if err == campaignrules.ErrCampaignNotFound {
+				ctx.JSON(404, err.Error())
+				return
+			}
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, updatedRules)
+	}
+}
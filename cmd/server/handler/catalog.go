@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
+)
+
+func catalogErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, catalog.ErrUnknownFilter),
+		errors.Is(err, catalog.ErrInvalidFilter),
+		errors.Is(err, catalog.ErrUnknownSort),
+		errors.Is(err, catalog.ErrInvalidPage):
+		return 400
+	}
+	return 500
+}
+
+// catalogList is the body of a catalog list: the page when the request asked
+// for one, otherwise the plain array clients got before paging existed.
+func catalogList[T any](params catalog.Params, page catalog.Page[T]) any {
+	if params.Paged() {
+		return page
+	}
+	return page.Items
+}
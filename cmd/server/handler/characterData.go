@@ -1,18 +1,37 @@
 package handler
 
 import (
+	"errors"
+	"log"
 	"strconv"
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/campaign"
 	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	characterXclass "github.com/proyecto-dnd/backend/internal/characterXClass"
+	"github.com/proyecto-dnd/backend/internal/class"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/race"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+	"github.com/proyecto-dnd/backend/internal/subrace"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
+	"github.com/proyecto-dnd/backend/internal/user_campaign"
 )
 
 type CharacterHandler struct {
-	service characterdata.ServiceCharacterData
+	service             characterdata.ServiceCharacterData
+	userCampaignService user_campaign.UserCampaignService
+	raceService         race.RaceService
+	classService        class.ClassService
+	backgroundService   background.BackgroundService
+	homebrew            homebrew
+	translator          translator
 }
 
-func NewCharacterHandler(service *characterdata.ServiceCharacterData) *CharacterHandler {
-	return &CharacterHandler{service: *service}
+func NewCharacterHandler(service *characterdata.ServiceCharacterData, userCampaignService *user_campaign.UserCampaignService, raceService race.RaceService, classService *class.ClassService, backgroundService background.BackgroundService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *CharacterHandler {
+	return &CharacterHandler{service: *service, userCampaignService: *userCampaignService, raceService: raceService, classService: *classService, backgroundService: backgroundService, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 func (h *CharacterHandler) HandlerCreate() gin.HandlerFunc {
@@ -22,12 +41,16 @@ func (h *CharacterHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(400, err.Error())
 			return
 		}
+		if err := h.linkable(ctx, tempCharacterData); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		createdCharacterData, err := h.service.Create(tempCharacterData)
 		if err != nil {
-
-			ctx.JSON(500, err.Error())
+			ctx.JSON(classErrorStatus(err), err.Error())
 			return
 		}
+		setTrackedCharacter(ctx, createdCharacterData.Character_Id)
 		ctx.JSON(201, createdCharacterData)
 	}
 }
@@ -125,6 +148,10 @@ func (h *CharacterHandler) HandlerGetById() gin.HandlerFunc {
 			ctx.JSON(404, err.Error())
 			return
 		}
+		if err := localizeCharacter(ctx, h.translator, &characters); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, characters)
 	}
 }
@@ -159,10 +186,14 @@ func (h *CharacterHandler) HandlerUpdate() gin.HandlerFunc {
 		}
 
 		tempCharacterData.Character_Id = id
+		if err := h.linkable(ctx, tempCharacterData); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdCharacterData, err := h.service.Update(tempCharacterData)
 		if err != nil {
-			ctx.JSON(500, err.Error())
+			ctx.JSON(classErrorStatus(err), err.Error())
 			return
 		}
 		ctx.JSON(201, createdCharacterData)
@@ -185,3 +216,196 @@ func (h *CharacterHandler) HandlerGetByUser() gin.HandlerFunc {
 		ctx.JSON(200, characters)
 	}
 }
+
+func (h *CharacterHandler) HandlerCloneGeneric() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		var body dto.CloneCharacterDto
+		if ctx.Request.ContentLength > 0 {
+			if err := ctx.BindJSON(&body); err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+		}
+
+		cloned, err := h.service.CloneGeneric(id, cookie.Value)
+		if err != nil {
+			switch {
+			case errors.Is(err, characterdata.ErrNotFound):
+				ctx.JSON(404, err.Error())
+			case errors.Is(err, characterdata.ErrNotGeneric):
+				ctx.JSON(400, err.Error())
+			default:
+				ctx.JSON(500, err.Error())
+			}
+			return
+		}
+
+		if body.CampaignId != nil {
+			// A clone that cannot join the campaign is removed again rather
+			// than left behind outside of it.
+			if err := h.userCampaignService.AddCharacterToCampaign(cloned.Character_Id, *body.CampaignId, cookie.Value); err != nil {
+				if deleteErr := h.service.Delete(cloned.Character_Id); deleteErr != nil {
+					log.Println("clone cleanup", cloned.Character_Id, deleteErr)
+				}
+				ctx.JSON(500, err.Error())
+				return
+			}
+			cloned, err = h.service.GetById(cloned.Character_Id)
+			if err != nil {
+				ctx.JSON(500, err.Error())
+				return
+			}
+		}
+		setTrackedCharacter(ctx, cloned.Character_Id)
+		ctx.JSON(201, cloned)
+	}
+}
+
+func (h *CharacterHandler) HandlerAddClass() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var characterClass dto.CharacterClassDto
+		if err := ctx.BindJSON(&characterClass); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.linkable(ctx, domain.CharacterData{Class: domain.Class{ClassId: characterClass.ClassId}}); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		character, err := h.service.AddClass(id, characterClass.ClassId, characterClass.Level)
+		if err != nil {
+			ctx.JSON(classErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, character)
+	}
+}
+
+func (h *CharacterHandler) HandlerSetClassLevel() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		classId, err := strconv.Atoi(ctx.Param("classid"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var characterClass dto.CharacterClassDto
+		if err := ctx.BindJSON(&characterClass); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		character, err := h.service.SetClassLevel(id, classId, characterClass.Level)
+		if err != nil {
+			ctx.JSON(classErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, character)
+	}
+}
+
+func (h *CharacterHandler) HandlerRemoveClass() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		classId, err := strconv.Atoi(ctx.Param("classid"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		character, err := h.service.RemoveClass(id, classId)
+		if err != nil {
+			ctx.JSON(classErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, character)
+	}
+}
+
+// HandlerSetSubclass picks the subclass of one of the classes of a character,
+// or clears it when subclass_id is null.
+func (h *CharacterHandler) HandlerSetSubclass() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		classId, err := strconv.Atoi(ctx.Param("classid"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var characterSubclass dto.CharacterSubclassDto
+		if err := ctx.BindJSON(&characterSubclass); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		character, err := h.service.SetSubclass(id, classId, characterSubclass.SubclassId)
+		if err != nil {
+			ctx.JSON(classErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, character)
+	}
+}
+
+func classErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, characterXclass.ErrMulticlassPrerequisite),
+		errors.Is(err, characterdata.ErrInvalidClassLevel),
+		errors.Is(err, characterdata.ErrClassAlreadyAdded),
+		errors.Is(err, characterdata.ErrLastClass),
+		errors.Is(err, characterdata.ErrSubclassLevel),
+		errors.Is(err, subclass.ErrOtherClass),
+		errors.Is(err, subrace.ErrOtherRace):
+		return 400
+	case errors.Is(err, characterXclass.ErrNotFound), errors.Is(err, characterdata.ErrNotFound), errors.Is(err, subclass.ErrNotFound), errors.Is(err, subrace.ErrNotFound):
+		return 404
+	}
+	return 500
+}
+
+// linkable checks the user can see the race, classes and background given to
+// a character. Subraces and subclasses belong to them and need no check. Ids
+// that are not found are left for the character service to report.
+func (h *CharacterHandler) linkable(ctx *gin.Context, character domain.CharacterData) error {
+	campaignIds := []*int{}
+	if found, err := h.raceService.GetRaceByID(character.Race.RaceID); err == nil {
+		campaignIds = append(campaignIds, found.CampaignId)
+	}
+	classIds := []int{character.Class.ClassId}
+	for _, characterClass := range character.Classes {
+		classIds = append(classIds, characterClass.Class.ClassId)
+	}
+	for _, classId := range classIds {
+		if found, err := h.classService.GetById(classId); err == nil {
+			campaignIds = append(campaignIds, found.CampaignId)
+		}
+	}
+	if found, err := h.backgroundService.GetBackgroundByID(character.Background.BackgroundID); err == nil {
+		campaignIds = append(campaignIds, found.CampaignId)
+	}
+	return h.homebrew.visible(ctx, campaignIds...)
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	characterexport "github.com/proyecto-dnd/backend/internal/characterExport"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+type CharacterExportHandler struct {
+	service  characterexport.ServiceCharacterExport
+	homebrew homebrew
+}
+
+func NewCharacterExportHandler(service *characterexport.ServiceCharacterExport, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *CharacterExportHandler {
+	return &CharacterExportHandler{service: *service, homebrew: newHomebrew(campaignService, userService)}
+}
+
+func (h *CharacterExportHandler) HandlerExport() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		// The session is optional: without it the export leaves the journal out.
+		var session string
+		if cookie, err := ctx.Request.Cookie("Session"); err == nil {
+			session = cookie.Value
+		}
+		export, err := h.service.Export(id, session)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=character-%d-v%d.json", id, export.Version))
+		ctx.JSON(200, export)
+	}
+}
+
+func (h *CharacterExportHandler) HandlerImport() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		var campaignId *int
+		if campaignParam := ctx.Query("campaignid"); campaignParam != "" {
+			id, err := strconv.Atoi(campaignParam)
+			if err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+			campaignId = &id
+		}
+		// Only the members and the dungeon master of the campaign may import
+		// into it, and only its dungeon master has missing entries created as
+		// its homebrew.
+		if err := h.homebrew.visible(ctx, campaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		dungeonMaster := false
+		if campaignId != nil {
+			err := h.homebrew.authorize(ctx, campaignId)
+			if err != nil && !errors.Is(err, campaign.ErrNotHomebrewDungeonMaster) {
+				ctx.JSON(homebrewErrorStatus(err), err.Error())
+				return
+			}
+			dungeonMaster = err == nil
+		}
+		var export dto.CharacterExportDto
+		if err := ctx.BindJSON(&export); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		report, err := h.service.Import(export, cookie.Value, campaignId, dungeonMaster)
+		if err != nil {
+			switch {
+			case errors.Is(err, characterexport.ErrUnknownFormat), errors.Is(err, characterexport.ErrUnsupportedVersion), errors.Is(err, characterexport.ErrMissingName), errors.Is(err, characterexport.ErrNoCampaign):
+				ctx.JSON(400, err.Error())
+			case errors.Is(err, campaign.ErrNotHomebrewDungeonMaster):
+				ctx.JSON(403, err.Error())
+			default:
+				ctx.JSON(500, err.Error())
+			}
+			return
+		}
+		setTrackedCharacter(ctx, report.Character.Character_Id)
+		ctx.JSON(201, report)
+	}
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	characterhistory "github.com/proyecto-dnd/backend/internal/characterHistory"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+// trackedCharacterKey lets a handler report the character it touched when the
+// id is not known before the request runs (e.g. character creation).
+const trackedCharacterKey = "trackedCharacterId"
+
+// CharacterResolver returns the ids of the characters a request modifies.
+type CharacterResolver func(ctx *gin.Context) []int
+
+type CharacterHistoryHandler struct {
+	service     characterhistory.ServiceCharacterHistory
+	userService user.ServiceUsers
+}
+
+func NewCharacterHistoryHandler(service *characterhistory.ServiceCharacterHistory, userService *user.ServiceUsers) *CharacterHistoryHandler {
+	return &CharacterHistoryHandler{service: *service, userService: *userService}
+}
+
+// Track records a history snapshot for every character the request modified,
+// once the wrapped handler has answered successfully. A character without
+// history gets a baseline first, so its first tracked change can be undone.
+func (h *CharacterHistoryHandler) Track(change string, resolve CharacterResolver) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		characterIds := resolve(ctx)
+		for _, characterId := range characterIds {
+			if err := h.service.RecordBaseline(characterId); err != nil {
+				log.Println("character history", characterId, err)
+			}
+		}
+		ctx.Next()
+		if ctx.Writer.Status() >= 300 {
+			return
+		}
+		if len(characterIds) == 0 {
+			characterIds = resolve(ctx)
+		}
+		authorId := h.authorId(ctx)
+		for _, characterId := range characterIds {
+			if _, err := h.service.Record(characterId, authorId, change); err != nil {
+				log.Println("character history", characterId, err)
+			}
+		}
+	}
+}
+
+func (h *CharacterHistoryHandler) HandlerGetHistory() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		history, err := h.service.GetHistory(id)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, history)
+	}
+}
+
+func (h *CharacterHistoryHandler) HandlerGetVersion() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		version, err := strconv.Atoi(ctx.Param("version"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		characterVersion, err := h.service.GetVersion(id, version)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		ctx.JSON(200, characterVersion)
+	}
+}
+
+func (h *CharacterHistoryHandler) HandlerGetAtSessionStart() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		sessionId, err := strconv.Atoi(ctx.Param("sessionid"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		characterVersion, err := h.service.GetAtSessionStart(id, sessionId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		ctx.JSON(200, characterVersion)
+	}
+}
+
+func (h *CharacterHistoryHandler) HandlerRestore() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		version, err := strconv.Atoi(ctx.Param("version"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		restored, err := h.service.Restore(id, version, h.authorId(ctx))
+		if err != nil {
+			if err == characterhistory.ErrNotFound {
+				ctx.JSON(404, err.Error())
+				return
+			}
+			ctx.JSON(classErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, restored)
+	}
+}
+
+func (h *CharacterHistoryHandler) authorId(ctx *gin.Context) *string {
+	cookie, err := ctx.Request.Cookie("Session")
+	if err != nil {
+		return nil
+	}
+	claims, err := h.userService.GetJwtInfo(cookie.Value)
+	if err != nil {
+		return nil
+	}
+	return &claims.Id
+}
+
+func setTrackedCharacter(ctx *gin.Context, characterId int) {
+	ctx.Set(trackedCharacterKey, characterId)
+}
+
+// CharacterFromParam resolves the character from a path parameter.
+func CharacterFromParam(name string) CharacterResolver {
+	return func(ctx *gin.Context) []int {
+		id, err := strconv.Atoi(ctx.Param(name))
+		if err != nil {
+			return nil
+		}
+		return []int{id}
+	}
+}
+
+// CharacterFromQuery resolves the character from a query string parameter.
+func CharacterFromQuery(name string) CharacterResolver {
+	return func(ctx *gin.Context) []int {
+		id, err := strconv.Atoi(ctx.Query(name))
+		if err != nil {
+			return nil
+		}
+		return []int{id}
+	}
+}
+
+// CharacterFromBody resolves characters from numeric fields of the JSON body,
+// leaving the body untouched for the handler.
+func CharacterFromBody(fields ...string) CharacterResolver {
+	return func(ctx *gin.Context) []int {
+		if ctx.Request.Body == nil {
+			return nil
+		}
+		body, err := io.ReadAll(ctx.Request.Body)
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return nil
+		}
+		var payload map[string]interface{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil
+		}
+		ids := []int{}
+		for _, field := range fields {
+			if value, ok := payload[field].(float64); ok && value > 0 {
+				ids = append(ids, int(value))
+			}
+		}
+		return ids
+	}
+}
+
+// CharacterFromContext resolves the character a handler stored with
+// setTrackedCharacter. It only yields a value once the handler has run.
+func CharacterFromContext() CharacterResolver {
+	return func(ctx *gin.Context) []int {
+		if id, ok := ctx.Get(trackedCharacterKey); ok {
+			return []int{id.(int)}
+		}
+		return nil
+	}
+}
+
+// CharacterFromLink resolves the character owning the link row identified by
+// a path parameter, looked up before the handler runs.
+func CharacterFromLink(name string, lookup func(id int) (int, error)) CharacterResolver {
+	return func(ctx *gin.Context) []int {
+		id, err := strconv.Atoi(ctx.Param(name))
+		if err != nil {
+			return nil
+		}
+		characterId, err := lookup(id)
+		if err != nil {
+			return nil
+		}
+		return []int{characterId}
+	}
+}
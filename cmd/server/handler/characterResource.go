@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	characterresource "github.com/proyecto-dnd/backend/internal/characterResource"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/resource"
+)
+
+type CharacterResourceHandler struct {
+	service characterresource.ServiceCharacterResource
+}
+
+func NewCharacterResourceHandler(service *characterresource.ServiceCharacterResource) *CharacterResourceHandler {
+	return &CharacterResourceHandler{service: *service}
+}
+
+// Sync brings the feature resources of the characters a request modified in
+// line with their features, once the wrapped handler has answered
+// successfully. Like Track, it resolves the characters before the request
+// runs when it can.
+func (h *CharacterResourceHandler) Sync(resolve CharacterResolver) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		characterIds := resolve(ctx)
+		ctx.Next()
+		if ctx.Writer.Status() >= 300 {
+			return
+		}
+		if len(characterIds) == 0 {
+			characterIds = resolve(ctx)
+		}
+		for _, characterId := range characterIds {
+			if err := h.service.Sync(characterId); err != nil {
+				log.Println("character resources", characterId, err)
+			}
+		}
+	}
+}
+
+func (h *CharacterResourceHandler) HandlerGetByCharacterId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		resources, err := h.service.GetByCharacterId(id)
+		if err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, resources)
+	}
+}
+
+func (h *CharacterResourceHandler) HandlerCreate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.CreateCharacterResourceDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		created, err := h.service.Create(id, request)
+		if err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, created)
+	}
+}
+
+func (h *CharacterResourceHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, resourceId, err := resourceParams(ctx)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.CreateCharacterResourceDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		updated, err := h.service.Update(id, resourceId, request)
+		if err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+func (h *CharacterResourceHandler) HandlerDelete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, resourceId, err := resourceParams(ctx)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.service.Delete(id, resourceId); err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted resource with id "+ctx.Param("resourceId"))
+	}
+}
+
+func (h *CharacterResourceHandler) HandlerSpend() gin.HandlerFunc {
+	return h.change(true)
+}
+
+func (h *CharacterResourceHandler) HandlerRestore() gin.HandlerFunc {
+	return h.change(false)
+}
+
+func (h *CharacterResourceHandler) change(spend bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, resourceId, err := resourceParams(ctx)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.ResourceChangeDto
+		if ctx.Request.ContentLength > 0 {
+			if err := ctx.BindJSON(&request); err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+		}
+		var changed dto.CharacterResourceDto
+		if spend {
+			changed, err = h.service.Spend(id, resourceId, request.Amount)
+		} else {
+			changed, err = h.service.Restore(id, resourceId, request.Amount)
+		}
+		if err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, changed)
+	}
+}
+
+func (h *CharacterResourceHandler) HandlerRecharge() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.ResourceRechargeDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if _, err := h.service.Recharge(id, []string{request.Recharge}); err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		resources, err := h.service.GetByCharacterId(id)
+		if err != nil {
+			ctx.JSON(resourceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, resources)
+	}
+}
+
+func resourceParams(ctx *gin.Context) (int, int, error) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return 0, 0, err
+	}
+	resourceId, err := strconv.Atoi(ctx.Param("resourceId"))
+	if err != nil {
+		return 0, 0, err
+	}
+	return id, resourceId, nil
+}
+
+func resourceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, resource.ErrInvalidRecharge),
+		errors.Is(err, resource.ErrInvalidMaximum),
+		errors.Is(err, resource.ErrMissingName),
+		errors.Is(err, characterresource.ErrInvalidAmount):
+		return 400
+	case errors.Is(err, characterresource.ErrNotOwned),
+		errors.Is(err, characterresource.ErrNotFound):
+		return 404
+	case errors.Is(err, characterresource.ErrFeatureResource),
+		errors.Is(err, characterresource.ErrNotEnoughUses):
+		return 409
+	}
+	return 500
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	characterstatus "github.com/proyecto-dnd/backend/internal/characterStatus"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type CharacterStatusHandler struct {
+	service characterstatus.ServiceCharacterStatus
+}
+
+func NewCharacterStatusHandler(service *characterstatus.ServiceCharacterStatus) *CharacterStatusHandler {
+	return &CharacterStatusHandler{service: *service}
+}
+
+func (h *CharacterStatusHandler) HandlerGetStatus() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		status, err := h.service.GetStatus(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		ctx.JSON(200, status)
+	}
+}
+
+func (h *CharacterStatusHandler) HandlerSetHitpoints() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var hitpoints dto.HitpointsDto
+		if err := ctx.BindJSON(&hitpoints); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		status, err := h.service.SetHitpoints(id, hitpoints.CurrentHitpoints, hitpoints.SessionId)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+		ctx.JSON(200, status)
+	}
+}
+
+func (h *CharacterStatusHandler) HandlerExpendSlot() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SpellSlotRequestDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		status, err := h.service.ExpendSlot(id, request)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+		ctx.JSON(200, status)
+	}
+}
+
+func (h *CharacterStatusHandler) HandlerRestoreSlot() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SpellSlotRequestDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		status, err := h.service.RestoreSlot(id, request)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+		ctx.JSON(200, status)
+	}
+}
+
+func (h *CharacterStatusHandler) HandlerRest() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.RestRequestDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		result, err := h.service.Rest(id, request)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+		ctx.JSON(200, result)
+	}
+}
+
+func (h *CharacterStatusHandler) HandlerRollDeathSave() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.DeathSaveRequestDto
+		if ctx.Request.ContentLength > 0 {
+			if err := ctx.BindJSON(&request); err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+		}
+		result, err := h.service.RollDeathSave(id, request.SessionId)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+		ctx.JSON(200, result)
+	}
+}
+
+func (h *CharacterStatusHandler) HandlerStabilize() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.DeathSaveRequestDto
+		if ctx.Request.ContentLength > 0 {
+			if err := ctx.BindJSON(&request); err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+		}
+		status, err := h.service.Stabilize(id, request.SessionId)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+		ctx.JSON(200, status)
+	}
+}
+
+func statusErrorCode(err error) int {
+	switch {
+	case errors.Is(err, characterstatus.ErrInvalidRestType),
+		errors.Is(err, characterstatus.ErrInvalidSlotLevel),
+		errors.Is(err, characterstatus.ErrNoSlotAvailable),
+		errors.Is(err, characterstatus.ErrNoHitDice),
+		errors.Is(err, characterstatus.ErrInvalidHitpoints),
+		errors.Is(err, characterstatus.ErrInvalidDamage),
+		errors.Is(err, characterstatus.ErrInvalidHealing):
+		return 400
+	case errors.Is(err, characterstatus.ErrNotDying),
+		errors.Is(err, characterstatus.ErrCharacterDead),
+		errors.Is(err, characterstatus.ErrUnconscious):
+		return 409
+	}
+	return 500
+}
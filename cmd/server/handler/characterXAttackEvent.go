@@ -97,7 +97,7 @@ func (h *CharacterXAttackEventHandler) HandlerCreate() gin.HandlerFunc {
 
 		createdCharacterXSpellEvent, err := h.service.Create(tempCharacterXSpellEvent)
 		if err != nil {
-			ctx.JSON(500, err)
+			ctx.JSON(statusErrorCode(err), err.Error())
 			return
 		}
 
@@ -105,6 +105,32 @@ func (h *CharacterXAttackEventHandler) HandlerCreate() gin.HandlerFunc {
 	}
 }
 
+func (h *CharacterXAttackEventHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.Param("id")
+
+		intId, err := strconv.Atoi(id)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+
+		var tempCharacterXAttackEvent dto.CharacterXAttackEventDto
+		if err := ctx.BindJSON(&tempCharacterXAttackEvent); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+
+		updatedCharacterXAttackEvent, err := h.service.Update(tempCharacterXAttackEvent, intId)
+		if err != nil {
+			ctx.JSON(statusErrorCode(err), err.Error())
+			return
+		}
+
+		ctx.JSON(200, updatedCharacterXAttackEvent)
+	}
+}
+
 func (h *CharacterXAttackEventHandler) HandlerDelete() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		id := ctx.Param("id")
@@ -117,7 +143,7 @@ func (h *CharacterXAttackEventHandler) HandlerDelete() gin.HandlerFunc {
 
 		err = h.service.Delete(int(intId))
 		if err != nil {
-			ctx.JSON(500, err)
+			ctx.JSON(statusErrorCode(err), err.Error())
 			return
 		}
 
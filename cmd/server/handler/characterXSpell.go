@@ -1,19 +1,27 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
 	characterXspell "github.com/proyecto-dnd/backend/internal/characterXSpell"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/spellbook"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type CharacterXSpellHandler struct {
-	service characterXspell.ServiceCharacterXSpell
+	service          characterXspell.ServiceCharacterXSpell
+	spellbookService spellbook.ServiceSpellbook
+	spellService     spell.ServiceSpell
+	homebrew         homebrew
 }
 
-func NewCharacterXSpellHandler(service *characterXspell.ServiceCharacterXSpell) *CharacterXSpellHandler {
-	return &CharacterXSpellHandler{service: *service}
+func NewCharacterXSpellHandler(service *characterXspell.ServiceCharacterXSpell, spellbookService *spellbook.ServiceSpellbook, spellService *spell.ServiceSpell, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *CharacterXSpellHandler {
+	return &CharacterXSpellHandler{service: *service, spellbookService: *spellbookService, spellService: *spellService, homebrew: newHomebrew(campaignService, userService)}
 }
 
 func (h *CharacterXSpellHandler) HandlerCreate() gin.HandlerFunc {
@@ -26,9 +34,14 @@ func (h *CharacterXSpellHandler) HandlerCreate() gin.HandlerFunc {
 			return
 		}
 
-		createdCharacterXSpell, err := h.service.Create(tempCharacterXSpell)
+		err := linkable(ctx, h.homebrew, h.spellService.GetById, tempCharacterXSpell.SpellId, func(s domain.Spell) *int { return s.CampaignId })
 		if err != nil {
-			ctx.JSON(500, err.Error())
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		createdCharacterXSpell, err := h.spellbookService.AddSpell(tempCharacterXSpell)
+		if err != nil {
+			ctx.JSON(spellbookErrorStatus(err), err.Error())
 			return
 		}
 
@@ -77,3 +90,40 @@ func (h *CharacterXSpellHandler) HandlerDeleteParams() gin.HandlerFunc {
 		ctx.JSON(200, "Deleted characterXspell with characterId="+strconv.Itoa(characterId)+" and spellId="+strconv.Itoa(spellId))
 	}
 }
+
+func (h *CharacterXSpellHandler) HandlerUpdatePreparation() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var tempCharacterXSpell domain.CharacterXSpell
+		if err := ctx.BindJSON(&tempCharacterXSpell); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		updatedCharacterXSpell, err := h.spellbookService.SetPreparation(id, tempCharacterXSpell.Preparation)
+		if err != nil {
+			ctx.JSON(spellbookErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updatedCharacterXSpell)
+	}
+}
+
+func spellbookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, characterXspell.ErrInvalidPreparation),
+		errors.Is(err, spellbook.ErrSpellAlreadyAdded),
+		errors.Is(err, spellbook.ErrNotOnClassList),
+		errors.Is(err, spellbook.ErrSpellLevelTooHigh),
+		errors.Is(err, spellbook.ErrSpellLimitReached),
+		errors.Is(err, spellbook.ErrCantripLimitReached),
+		errors.Is(err, spellbook.ErrOtherClass):
+		return 400
+	case errors.Is(err, characterXspell.ErrNotFound):
+		return 404
+	}
+	return 500
+}
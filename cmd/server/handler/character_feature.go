@@ -4,16 +4,22 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
 	"github.com/proyecto-dnd/backend/internal/character_feature"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type CharacterFeature struct {
-	service character_feature.CharacterFeatureService
+	service        character_feature.CharacterFeatureService
+	featureService feature.FeatureService
+	homebrew       homebrew
 }
 
-func NewCharacterFeatureHandler(service *character_feature.CharacterFeatureService) *CharacterFeature {
-	return &CharacterFeature{service: *service}
+func NewCharacterFeatureHandler(service *character_feature.CharacterFeatureService, featureService *feature.FeatureService, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *CharacterFeature {
+	return &CharacterFeature{service: *service, featureService: *featureService, homebrew: newHomebrew(campaignService, userService)}
 }
 
 // characterFeature godoc
@@ -33,6 +39,11 @@ func (h *CharacterFeature) HandlerCreate() gin.HandlerFunc {
 			return
 		}
 
+		err := linkable(ctx, h.homebrew, h.featureService.GetFeatureById, tempCharacterFeature.FeatureId, func(f domain.Feature) *int { return f.CampaignId })
+		if err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		createdCharacterFeature, err := h.service.CreateCharacterFeature(tempCharacterFeature)
 		if err != nil {
 			ctx.JSON(500, err)
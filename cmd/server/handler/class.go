@@ -4,16 +4,22 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
 	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type ClassHandler struct {
-	service class.ClassService
+	service    class.ClassService
+	homebrew   homebrew
+	translator translator
 }
 
-func NewClassHandler(service *class.ClassService) *ClassHandler {
-	return &ClassHandler{service: *service}
+func NewClassHandler(service *class.ClassService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *ClassHandler {
+	return &ClassHandler{service: *service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 // class godoc
@@ -32,6 +38,10 @@ func (h *ClassHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		if err := h.homebrew.authorize(ctx, tempClass.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdClass, err := h.service.Create(tempClass)
 		if err != nil {
@@ -47,18 +57,30 @@ func (h *ClassHandler) HandlerCreate() gin.HandlerFunc {
 // @Summary Get all classes
 // @Tags class
 // @Produce json
+// @Param q query string false "text searched in name and description"
+// @Param sort query string false "field to sort by, prefixed with - for descending"
+// @Param limit query int false "page size up to 200; paged lists come wrapped with their total"
+// @Param offset query int false "rows to skip, paging by 50 when no limit is given"
 // @Success 200 {array} domain.Class
 // @Failure 500 {object} error
 // @Router /class [get]
 func (h *ClassHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		classList, err := h.service.GetAll()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		classList, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "class", classList.Items, classText); err != nil {
 			ctx.JSON(500, err.Error())
 			return
 		}
-
-		ctx.JSON(200, classList)
+		ctx.JSON(200, catalogList(params, classList))
 	}
 }
 
@@ -86,6 +108,14 @@ func (h *ClassHandler) HandlerGetById() gin.HandlerFunc {
 			return
 		}
 
+		if err := h.homebrew.visible(ctx, class.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "class", &class, classText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, class)
 	}
 }
@@ -115,6 +145,15 @@ func (h *ClassHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetById(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId, tempClass.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		updatedClass, err := h.service.Update(tempClass, intId)
 		if err != nil {
@@ -143,6 +182,15 @@ func (h *ClassHandler) HandlerDelete() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetById(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		if err = h.service.Delete(intId); err != nil {
 			ctx.JSON(500, err.Error())
@@ -152,3 +200,7 @@ func (h *ClassHandler) HandlerDelete() gin.HandlerFunc {
 		ctx.JSON(200, "Deleted Class with id: "+id)
 	}
 }
+
+func classText(c *domain.Class) translation.Text {
+	return translation.Text{Id: c.ClassId, Name: &c.Name, Description: &c.Description}
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"log"
+
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+// encumbranceWarning returns the character's load when an inventory change
+// left it encumbered or over capacity, and nil otherwise.
+func encumbranceWarning(service characterdata.ServiceCharacterData, characterId int) *dto.EncumbranceWarningDto {
+	encumbrance, err := service.GetEncumbrance(characterId)
+	if err != nil {
+		log.Println("encumbrance", characterId, err)
+		return nil
+	}
+	if !encumbrance.Encumbered && !encumbrance.OverCapacity {
+		return nil
+	}
+	return &dto.EncumbranceWarningDto{CharacterId: characterId, EncumbranceDto: encumbrance}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/equipment"
+)
+
+type EquipmentHandler struct {
+	service              equipment.ServiceEquipment
+	characterDataService characterdata.ServiceCharacterData
+}
+
+func NewEquipmentHandler(service *equipment.ServiceEquipment, characterDataService *characterdata.ServiceCharacterData) *EquipmentHandler {
+	return &EquipmentHandler{service: *service, characterDataService: *characterDataService}
+}
+
+func (h *EquipmentHandler) HandlerGet() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		h.respond(ctx, id)
+	}
+}
+
+func (h *EquipmentHandler) HandlerEquip() gin.HandlerFunc {
+	return h.change(true)
+}
+
+func (h *EquipmentHandler) HandlerUnequip() gin.HandlerFunc {
+	return h.change(false)
+}
+
+func (h *EquipmentHandler) change(equip bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.EquipRequestDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		apply := h.service.Unequip
+		if equip {
+			apply = h.service.Equip
+		}
+		if err := apply(id, request); err != nil {
+			ctx.JSON(equipmentErrorStatus(err), err.Error())
+			return
+		}
+		h.respond(ctx, id)
+	}
+}
+
+func (h *EquipmentHandler) respond(ctx *gin.Context, characterId int) {
+	character, err := h.characterDataService.GetById(characterId)
+	if err != nil {
+		ctx.JSON(404, err.Error())
+		return
+	}
+	ctx.JSON(200, character.Equipment)
+}
+
+// applyEquipped moves the equipped flag sent to the weapon and armor endpoints
+// through the slot rules instead of writing it directly.
+func applyEquipped(service equipment.ServiceEquipment, characterId int, kind string, id int, equipped bool, slot string) error {
+	request := dto.EquipRequestDto{Kind: kind, Id: id, Slot: slot}
+	if equipped {
+		return service.Equip(characterId, request)
+	}
+	return service.Unequip(characterId, request)
+}
+
+func equipmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, equipment.ErrInvalidKind),
+		errors.Is(err, equipment.ErrInvalidSlot),
+		errors.Is(err, equipment.ErrTwoHandedOffHand),
+		errors.Is(err, equipment.ErrNotAttunable):
+		return 400
+	case errors.Is(err, equipment.ErrNotOwned):
+		return 404
+	case errors.Is(err, equipment.ErrAttunementFull):
+		return 409
+	}
+	return 500
+}
@@ -4,16 +4,22 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type FeatureHandler struct {
-	service feature.FeatureService
+	service    feature.FeatureService
+	homebrew   homebrew
+	translator translator
 }
 
-func NewFeatureHandler(service *feature.FeatureService) *FeatureHandler {
-	return &FeatureHandler{service: *service}
+func NewFeatureHandler(service *feature.FeatureService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *FeatureHandler {
+	return &FeatureHandler{service: *service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 // feature godoc
@@ -32,10 +38,14 @@ func (h *FeatureHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		if err := h.homebrew.authorize(ctx, tempFeature.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdFeature, err := h.service.CreateFeature(tempFeature)
 		if err != nil {
-			ctx.JSON(500, err)
+			ctx.JSON(resourceErrorStatus(err), err.Error())
 			return
 		}
 
@@ -47,17 +57,30 @@ func (h *FeatureHandler) HandlerCreate() gin.HandlerFunc {
 // @Summary Get all features
 // @Tags feature
 // @Produce json
+// @Param q query string false "text searched in name and description"
+// @Param sort query string false "field to sort by, prefixed with - for descending"
+// @Param limit query int false "page size up to 200; paged lists come wrapped with their total"
+// @Param offset query int false "rows to skip, paging by 50 when no limit is given"
 // @Success 200 {array} domain.Feature
 // @Failure 500 {object} error
 // @Router /feature [get]
 func (h *FeatureHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		featureList, err := h.service.GetAllFeatures()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
-			ctx.JSON(500, err)
+			ctx.JSON(400, err.Error())
 			return
 		}
-		ctx.JSON(200, featureList)
+		featureList, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "feature", featureList.Items, featureText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, catalogList(params, featureList))
 	}
 }
 
@@ -109,6 +132,14 @@ func (h *FeatureHandler) HandlerGetById() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		if err := h.homebrew.visible(ctx, tempFeature.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "feature", &tempFeature, featureText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, tempFeature)
 	}
 }
@@ -135,9 +166,18 @@ func (h *FeatureHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		current, err := h.service.GetFeatureById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId, tempFeature.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		createdFeature, err := h.service.UpdateFeature(tempFeature, id)
 		if err != nil {
-			ctx.JSON(500, err)
+			ctx.JSON(resourceErrorStatus(err), err.Error())
 			return
 		}
 		ctx.JSON(201, createdFeature)
@@ -159,6 +199,15 @@ func (h *FeatureHandler) HandlerDelete() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		current, err := h.service.GetFeatureById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		err = h.service.DeleteFeature(id)
 		if err != nil {
 			ctx.JSON(500, err)
@@ -175,6 +224,15 @@ func(h *FeatureHandler) HandlerDeleteByQueries() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		current, err := h.service.GetFeatureById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		err = h.service.DeleteFeature(id)
 		if err != nil {
 			ctx.JSON(500, err)
@@ -182,4 +240,8 @@ func(h *FeatureHandler) HandlerDeleteByQueries() gin.HandlerFunc {
 		}
 		ctx.JSON(200, "Feature deleted")
 	}
-}
\ No newline at end of file
+}
+
+func featureText(f *domain.Feature) translation.Text {
+	return translation.Text{Id: f.FeatureId, Name: &f.Name, Description: &f.Description}
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+var (
+	errHomebrewLogin  = errors.New("log in as the campaign dungeon master to manage its homebrew content")
+	errHomebrewHidden = errors.New("log in as a campaign member to use its homebrew content")
+)
+
+// homebrew decides who sees and manages the campaign content of the catalogs.
+// Generic content, without a campaign, stays open to everyone.
+type homebrew struct {
+	campaignService campaign.CampaignService
+	userService     user.ServiceUsers
+}
+
+func newHomebrew(campaignService *campaign.CampaignService, userService *user.ServiceUsers) homebrew {
+	return homebrew{campaignService: *campaignService, userService: *userService}
+}
+
+// catalogParams parses the catalog options of a list request. Logged in users
+// also see the homebrew content of their campaigns.
+func (h homebrew) catalogParams(ctx *gin.Context) (catalog.Params, error) {
+	params, err := catalog.ParseParams(ctx.Request.URL.Query())
+	if err != nil {
+		return catalog.Params{}, err
+	}
+	params.Viewer = h.viewer(ctx)
+	return params, nil
+}
+
+// viewer returns the id of the logged in user, or nothing for anonymous
+// requests.
+func (h homebrew) viewer(ctx *gin.Context) string {
+	cookie, err := ctx.Request.Cookie("Session")
+	if err != nil {
+		return ""
+	}
+	claims, err := h.userService.GetJwtInfo(cookie.Value)
+	if err != nil {
+		return ""
+	}
+	return claims.Id
+}
+
+// authorize checks the user is the dungeon master of every campaign given. Nil
+// campaigns are generic content and need no check.
+func (h homebrew) authorize(ctx *gin.Context, campaignIds ...*int) error {
+	var userId string
+	for _, campaignId := range campaignIds {
+		if campaignId == nil {
+			continue
+		}
+		if userId == "" {
+			cookie, err := ctx.Request.Cookie("Session")
+			if err != nil {
+				return errHomebrewLogin
+			}
+			claims, err := h.userService.GetJwtInfo(cookie.Value)
+			if err != nil {
+				return errHomebrewLogin
+			}
+			userId = claims.Id
+		}
+		if err := h.campaignService.CheckDungeonMaster(*campaignId, userId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visible checks the user is a member or the dungeon master of every campaign
+// given, so they can see its homebrew content and give it to characters. Nil
+// campaigns are generic content, open to everyone.
+func (h homebrew) visible(ctx *gin.Context, campaignIds ...*int) error {
+	var userId string
+	for _, campaignId := range campaignIds {
+		if campaignId == nil {
+			continue
+		}
+		if userId == "" {
+			if userId = h.viewer(ctx); userId == "" {
+				return errHomebrewHidden
+			}
+		}
+		if err := h.campaignService.CheckMember(*campaignId, userId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkable checks the user can see the catalog entry given to a character.
+// Entries that are not found are left for the service linking them to report.
+func linkable[T any](ctx *gin.Context, h homebrew, get func(int) (T, error), id int, campaignId func(T) *int) error {
+	entry, err := get(id)
+	if err != nil {
+		return nil
+	}
+	return h.visible(ctx, campaignId(entry))
+}
+
+// visibleEntries keeps the entries of a list the user can see.
+func visibleEntries[T any](ctx *gin.Context, h homebrew, entries []T, campaignId func(T) *int) ([]T, error) {
+	checked := map[int]bool{}
+	kept := []T{}
+	for _, entry := range entries {
+		id := campaignId(entry)
+		if id == nil {
+			kept = append(kept, entry)
+			continue
+		}
+		shown, ok := checked[*id]
+		if !ok {
+			err := h.visible(ctx, id)
+			if err != nil && homebrewErrorStatus(err) != 404 {
+				return nil, err
+			}
+			shown = err == nil
+			checked[*id] = shown
+		}
+		if shown {
+			kept = append(kept, entry)
+		}
+	}
+	return kept, nil
+}
+
+// Homebrew of campaigns the user is not part of is reported as missing.
+func homebrewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errHomebrewLogin), errors.Is(err, errAdminLogin):
+		return 401
+	case errors.Is(err, campaign.ErrNotHomebrewDungeonMaster), errors.Is(err, errNotAdmin):
+		return 403
+	case errors.Is(err, campaign.ErrNotFound), errors.Is(err, errHomebrewHidden), errors.Is(err, campaign.ErrNotCampaignMember):
+		return 404
+	}
+	return 500
+}
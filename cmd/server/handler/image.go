@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/user"
+	"github.com/proyecto-dnd/backend/pkg/storage"
+)
+
+// imageFormField is the multipart field uploads are read from.
+const imageFormField = "image"
+
+// maxUploadSize bounds the whole multipart body, leaving room for the form
+// overhead around the image itself.
+const maxUploadSize = storage.MaxImageSize + 1<<20
+
+type ImageHandler struct {
+	store            storage.Storage
+	characterService characterdata.ServiceCharacterData
+	campaignService  campaign.CampaignService
+	userService      user.ServiceUsers
+}
+
+func NewImageHandler(store storage.Storage, characterService *characterdata.ServiceCharacterData, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *ImageHandler {
+	return &ImageHandler{store: store, characterService: *characterService, campaignService: *campaignService, userService: *userService}
+}
+
+// HandlerCharacterPortrait replaces the portrait of a character owned by the
+// user and returns the updated character.
+func (h *ImageHandler) HandlerCharacterPortrait() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		image, err := readImage(ctx)
+		if err != nil {
+			ctx.JSON(imageErrorStatus(err), err.Error())
+			return
+		}
+		_, err = storage.ReplaceImage(ctx.Request.Context(), h.store, "characters", image, func(url string) (string, error) {
+			return h.characterService.SetPortrait(id, url, cookie.Value)
+		})
+		if err != nil {
+			ctx.JSON(imageErrorStatus(err), err.Error())
+			return
+		}
+		character, err := h.characterService.GetById(id)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, character)
+	}
+}
+
+// HandlerCampaignImage replaces the image of a campaign run by the user and
+// returns the updated campaign.
+func (h *ImageHandler) HandlerCampaignImage() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		image, err := readImage(ctx)
+		if err != nil {
+			ctx.JSON(imageErrorStatus(err), err.Error())
+			return
+		}
+		_, err = storage.ReplaceImage(ctx.Request.Context(), h.store, "campaigns", image, func(url string) (string, error) {
+			return h.campaignService.SetImage(id, url, cookie.Value)
+		})
+		if err != nil {
+			ctx.JSON(imageErrorStatus(err), err.Error())
+			return
+		}
+		updated, err := h.campaignService.GetCampaignByID(id)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+// HandlerUserAvatar replaces the avatar of the logged in user and returns the
+// updated user.
+func (h *ImageHandler) HandlerUserAvatar() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		claims, err := h.userService.GetJwtInfo(cookie.Value)
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		image, err := readImage(ctx)
+		if err != nil {
+			ctx.JSON(imageErrorStatus(err), err.Error())
+			return
+		}
+		var updated domain.UserResponse
+		_, err = storage.ReplaceImage(ctx.Request.Context(), h.store, "avatars", image, func(url string) (string, error) {
+			return h.setAvatar(claims.Id, url, &updated)
+		})
+		if err != nil {
+			ctx.JSON(imageErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+// setAvatar stores the new avatar and returns the one it replaces.
+func (h *ImageHandler) setAvatar(userId string, url string, updated *domain.UserResponse) (string, error) {
+	current, err := h.userService.GetById(userId)
+	if err != nil {
+		return "", err
+	}
+	*updated, err = h.userService.Patch(domain.UserUpdate{Image: &url}, userId)
+	if err != nil {
+		return "", err
+	}
+	if current.Image == nil {
+		return "", nil
+	}
+	return *current.Image, nil
+}
+
+// readImage reads the uploaded image from the multipart form, checking its
+// content rather than trusting the declared type.
+func readImage(ctx *gin.Context) (storage.Image, error) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxUploadSize)
+	header, err := ctx.FormFile(imageFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return storage.Image{}, storage.ErrTooLarge
+		}
+		return storage.Image{}, err
+	}
+	if header.Size > storage.MaxImageSize {
+		return storage.Image{}, storage.ErrTooLarge
+	}
+	file, err := header.Open()
+	if err != nil {
+		return storage.Image{}, err
+	}
+	defer file.Close()
+	return storage.ReadImage(file)
+}
+
+func imageErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, storage.ErrTooLarge),
+		errors.Is(err, storage.ErrImageDimensions):
+		return 413
+	case errors.Is(err, storage.ErrUnsupportedImage),
+		errors.Is(err, storage.ErrInvalidImage),
+		errors.Is(err, storage.ErrInvalidDataURL),
+		errors.Is(err, http.ErrMissingFile),
+		errors.Is(err, http.ErrNotMultipart):
+		return 400
+	case errors.Is(err, characterdata.ErrNotCharacterOwner),
+		errors.Is(err, campaign.ErrNotDungeonMaster):
+		return 403
+	case errors.Is(err, characterdata.ErrNotFound),
+		errors.Is(err, campaign.ErrNotFound):
+		return 404
+	}
+	return 500
+}
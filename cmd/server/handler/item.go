@@ -1,18 +1,25 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/item"
+	"github.com/proyecto-dnd/backend/internal/resource"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type ItemHandler struct {
-	service item.ServiceItem
+	service    item.ServiceItem
+	homebrew   homebrew
+	translator translator
 }
 
-func NewItemHandler(service *item.ServiceItem) *ItemHandler {
-    return &ItemHandler{service: *service}
+func NewItemHandler(service *item.ServiceItem, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *ItemHandler {
+    return &ItemHandler{service: *service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 // item godoc
@@ -32,9 +39,13 @@ func (h *ItemHandler) HandlerCreate() gin.HandlerFunc {
             ctx.JSON(400, err)
             return
         }
+		if err := h.homebrew.authorize(ctx, tempItem.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		createdItem, err := h.service.Create(tempItem)
 		if err!= nil {
-            ctx.JSON(500, err)
+            ctx.JSON(itemErrorStatus(err), err.Error())
             return
         }
 		ctx.JSON(201, createdItem)
@@ -57,6 +68,15 @@ func (h *ItemHandler) HandlerDelete() gin.HandlerFunc{
             ctx.JSON(400, err)
             return
         }
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
         err = h.service.Delete(id)
         if err!= nil {
             ctx.JSON(404, err)
@@ -70,17 +90,30 @@ func (h *ItemHandler) HandlerDelete() gin.HandlerFunc{
 // @Summary Get all items
 // @Tags item
 // @Produce json
+// @Param q query string false "text searched in name and description"
+// @Param sort query string false "field to sort by, prefixed with - for descending"
+// @Param limit query int false "page size up to 200; paged lists come wrapped with their total"
+// @Param offset query int false "rows to skip, paging by 50 when no limit is given"
 // @Success 200 {array} domain.Item
 // @Failure 500 {object} error
 // @Router /item [get]
-func (h *ItemHandler) HandlerGetAll() gin.HandlerFunc{
+func (h *ItemHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		items, err := h.service.GetAll()
-        if err!= nil {
-            ctx.JSON(500, err)
-            return
-        }
-        ctx.JSON(200, items)
+		params, err := h.homebrew.catalogParams(ctx)
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		items, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "item", items.Items, itemText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, catalogList(params, items))
 	}
 }
 
@@ -101,11 +134,19 @@ func (h *ItemHandler) HandlerGetByCampaignId() gin.HandlerFunc{
             ctx.JSON(500, err)
             return
         }
+        if err := h.homebrew.visible(ctx, &intId); err != nil {
+            ctx.JSON(homebrewErrorStatus(err), err.Error())
+            return
+        }
         items, err := h.service.GetByCampaignId(intId)
         if err!= nil {
             ctx.JSON(500, err)
             return
         }
+        if err := localize(ctx, h.translator, "item", items, itemText); err != nil {
+            ctx.JSON(500, err.Error())
+            return
+        }
         ctx.JSON(200, items)
     }
 }
@@ -133,6 +174,14 @@ func (h *ItemHandler) HandlerGetById() gin.HandlerFunc{
             ctx.JSON(404, err)
             return
         }
+        if err := h.homebrew.visible(ctx, items.Campaign_Id); err != nil {
+            ctx.JSON(homebrewErrorStatus(err), err.Error())
+            return
+        }
+        if err := localizeEntry(ctx, h.translator, "item", &items, itemText); err != nil {
+            ctx.JSON(500, err.Error())
+            return
+        }
         ctx.JSON(200, items)
     }
 }
@@ -151,6 +200,10 @@ func (h *ItemHandler) HandlerGetAllGeneric() gin.HandlerFunc{
             ctx.JSON(500, err)
             return
         }
+        if err := localize(ctx, h.translator, "item", items, itemText); err != nil {
+            ctx.JSON(500, err.Error())
+            return
+        }
         ctx.JSON(200, items)
 	}
 }
@@ -180,14 +233,39 @@ func (h *ItemHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.AbortWithError(400, err)
 			return
 		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.Campaign_Id, tempItem.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
         tempItem.Item_Id = id
 
 		updatedItem, err := h.service.Update(tempItem)
 		if err!= nil {
-            ctx.JSON(500, err)
+            ctx.JSON(itemErrorStatus(err), err.Error())
             return
         }
 		ctx.JSON(200, updatedItem)
     }
-}
\ No newline at end of file
+}
+
+func itemErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, item.ErrInvalidRarity),
+		errors.Is(err, item.ErrInvalidCharges),
+		errors.Is(err, item.ErrInvalidRechargeDice),
+		errors.Is(err, item.ErrAttunementRules),
+		errors.Is(err, resource.ErrInvalidRecharge):
+		return 400
+	}
+	return 500
+}
+
+func itemText(i *domain.Item) translation.Text {
+	return translation.Text{Id: i.Item_Id, Name: &i.Name, Description: &i.Description}
+}
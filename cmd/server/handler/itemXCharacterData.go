@@ -1,20 +1,31 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/equipment"
+	"github.com/proyecto-dnd/backend/internal/item"
 	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type ItemXCharacterDataHandler struct {
-	service itemxcharacterdata.ServiceItemXCharacterData
+	service              itemxcharacterdata.ServiceItemXCharacterData
+	characterDataService characterdata.ServiceCharacterData
+	equipmentService     equipment.ServiceEquipment
+	itemService          item.ServiceItem
+	homebrew             homebrew
 }
 
-func NewItemXCharacterDataHandler(service *itemxcharacterdata.ServiceItemXCharacterData) *ItemXCharacterDataHandler {
-    return &ItemXCharacterDataHandler{service: *service}
+func NewItemXCharacterDataHandler(service *itemxcharacterdata.ServiceItemXCharacterData, characterDataService *characterdata.ServiceCharacterData, equipmentService *equipment.ServiceEquipment, itemService *item.ServiceItem, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *ItemXCharacterDataHandler {
+    return &ItemXCharacterDataHandler{service: *service, characterDataService: *characterDataService, equipmentService: *equipmentService, itemService: *itemService, homebrew: newHomebrew(campaignService, userService)}
 }
 
 // itemXCharacterData godoc
@@ -23,7 +34,7 @@ func NewItemXCharacterDataHandler(service *itemxcharacterdata.ServiceItemXCharac
 // @Accept json
 // @Produce json
 // @Param body body domain.ItemXCharacterData true "ItemXCharacterData"
-// @Success 201 {object} domain.ItemXCharacterData
+// @Success 201 {object} dto.ItemXCharacterDataResponseDto
 // @Failure 400 {object} error
 // @Failure 500 {object} error
 // @Router /item_character [post]
@@ -34,13 +45,20 @@ func (h *ItemXCharacterDataHandler) HandlerCreate() gin.HandlerFunc{
 			ctx.AbortWithError(400, err)
 			return
 		}
+		if err := linkable(ctx, h.homebrew, h.itemService.GetById, tempItemXCharacterData.Item.Item_Id, func(i domain.Item) *int { return i.Campaign_Id }); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		createdItemXCharacterData, err := h.service.Create(tempItemXCharacterData)
 		if err != nil {
 			fmt.Println(err)
 			ctx.AbortWithError(500, err)
 			return
 		}
-		ctx.JSON(201, createdItemXCharacterData)
+		ctx.JSON(201, dto.ItemXCharacterDataResponseDto{
+			ItemXCharacterData: createdItemXCharacterData,
+			EncumbranceWarning: encumbranceWarning(h.characterDataService, createdItemXCharacterData.CharacterData_Id),
+		})
 	}
 }
 
@@ -191,6 +209,10 @@ func (h *ItemXCharacterDataHandler) HandlerUpdate() gin.HandlerFunc{
 		}
 
 		tempItemXCharacterData.Character_Item_Id = id
+		if err := linkable(ctx, h.homebrew, h.itemService.GetById, tempItemXCharacterData.Item.Item_Id, func(i domain.Item) *int { return i.Campaign_Id }); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		updatedItemXCharacterData, err := h.service.Update(tempItemXCharacterData)
 		if err != nil {
@@ -198,6 +220,161 @@ func (h *ItemXCharacterDataHandler) HandlerUpdate() gin.HandlerFunc{
 			ctx.AbortWithError(500, err)
 			return
 		}
-		ctx.JSON(200, updatedItemXCharacterData)
+		characterId := updatedItemXCharacterData.CharacterData_Id
+		if characterId == 0 {
+			if storedItemXCharacterData, err := h.service.GetById(id); err == nil {
+				characterId = storedItemXCharacterData.CharacterData_Id
+			}
+		}
+		ctx.JSON(200, dto.ItemXCharacterDataResponseDto{
+			ItemXCharacterData: updatedItemXCharacterData,
+			EncumbranceWarning: encumbranceWarning(h.characterDataService, characterId),
+		})
+	}
+}
+
+// itemXCharacterData godoc
+// @Summary Attune the character to an item
+// @Description Items that require attunement share the limit of three attuned items with weapons and armor, and may be restricted to some classes or alignments
+// @Tags itemXCharacterData
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} domain.ItemXCharacterData
+// @Failure 400 {object} error
+// @Failure 403 {object} error
+// @Failure 404 {object} error
+// @Failure 409 {object} error
+// @Router /item_character/{id}/attune [post]
+func (h *ItemXCharacterDataHandler) HandlerAttune() gin.HandlerFunc {
+	return h.attune(true)
+}
+
+// itemXCharacterData godoc
+// @Summary End the attunement to an item
+// @Tags itemXCharacterData
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} domain.ItemXCharacterData
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Router /item_character/{id}/attune [delete]
+func (h *ItemXCharacterDataHandler) HandlerUnattune() gin.HandlerFunc {
+	return h.attune(false)
+}
+
+func (h *ItemXCharacterDataHandler) attune(attune bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		owned, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if attune {
+			character, err := h.characterDataService.GetById(owned.CharacterData_Id)
+			if err != nil {
+				ctx.JSON(404, err.Error())
+				return
+			}
+			err = h.equipmentService.AttuneItem(character, id)
+		} else {
+			err = h.equipmentService.UnattuneItem(owned.CharacterData_Id, id)
+		}
+		if err != nil {
+			ctx.JSON(itemChargesErrorStatus(err), err.Error())
+			return
+		}
+		h.respond(ctx, id)
+	}
+}
+
+// itemXCharacterData godoc
+// @Summary Spend charges of an item
+// @Tags itemXCharacterData
+// @Accept json
+// @Produce json
+// @Param id path int true "id"
+// @Param body body dto.ResourceChangeDto false "Charges, one by default"
+// @Success 200 {object} domain.ItemXCharacterData
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Failure 409 {object} error
+// @Router /item_character/{id}/charges/spend [post]
+func (h *ItemXCharacterDataHandler) HandlerSpendCharges() gin.HandlerFunc {
+	return h.charges(true)
+}
+
+// itemXCharacterData godoc
+// @Summary Restore charges of an item
+// @Tags itemXCharacterData
+// @Accept json
+// @Produce json
+// @Param id path int true "id"
+// @Param body body dto.ResourceChangeDto false "Charges, one by default and all of them when negative"
+// @Success 200 {object} domain.ItemXCharacterData
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Router /item_character/{id}/charges/restore [post]
+func (h *ItemXCharacterDataHandler) HandlerRestoreCharges() gin.HandlerFunc {
+	return h.charges(false)
+}
+
+func (h *ItemXCharacterDataHandler) charges(spend bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.ResourceChangeDto
+		if ctx.Request.ContentLength > 0 {
+			if err := ctx.BindJSON(&request); err != nil {
+				ctx.JSON(400, err.Error())
+				return
+			}
+		}
+		var changed domain.ItemXCharacterData
+		if spend {
+			changed, err = h.service.SpendCharges(id, request.Amount)
+		} else {
+			changed, err = h.service.RestoreCharges(id, request.Amount)
+		}
+		if err != nil {
+			ctx.JSON(itemChargesErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, changed)
+	}
+}
+
+func (h *ItemXCharacterDataHandler) respond(ctx *gin.Context, id int) {
+	owned, err := h.service.GetById(id)
+	if err != nil {
+		ctx.JSON(404, err.Error())
+		return
 	}
-}
\ No newline at end of file
+	ctx.JSON(200, owned)
+}
+
+func itemChargesErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, itemxcharacterdata.ErrInvalidAmount),
+		errors.Is(err, itemxcharacterdata.ErrNoCharges),
+		errors.Is(err, equipment.ErrNotAttunable):
+		return 400
+	case errors.Is(err, equipment.ErrAttunementClass),
+		errors.Is(err, equipment.ErrAttunementAlignment):
+		return 403
+	case errors.Is(err, itemxcharacterdata.ErrNotFound),
+		errors.Is(err, equipment.ErrNotOwned):
+		return 404
+	case errors.Is(err, itemxcharacterdata.ErrNotEnoughCharges),
+		errors.Is(err, equipment.ErrAttunementFull):
+		return 409
+	}
+	return 500
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/journal"
+	"github.com/proyecto-dnd/backend/pkg/storage"
+)
+
+type JournalHandler struct {
+	service journal.ServiceJournal
+}
+
+func NewJournalHandler(service *journal.ServiceJournal) *JournalHandler {
+	return &JournalHandler{service: *service}
+}
+
+func (h *JournalHandler) HandlerCreate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		characterId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.JournalEntryDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		entry, err := h.service.Create(characterId, request, cookie.Value)
+		if err != nil {
+			ctx.JSON(journalErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, entry)
+	}
+}
+
+func (h *JournalHandler) HandlerGetByCharacterId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		characterId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		entries, err := h.service.GetByCharacterId(characterId, cookie.Value)
+		if err != nil {
+			ctx.JSON(journalErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, entries)
+	}
+}
+
+func (h *JournalHandler) HandlerGetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		entry, err := h.service.GetById(id, cookie.Value)
+		if err != nil {
+			ctx.JSON(journalErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, entry)
+	}
+}
+
+func (h *JournalHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.JournalEntryDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		entry, err := h.service.Update(id, request, cookie.Value)
+		if err != nil {
+			ctx.JSON(journalErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, entry)
+	}
+}
+
+func (h *JournalHandler) HandlerDelete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.service.Delete(id, cookie.Value); err != nil {
+			ctx.JSON(journalErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted journal entry with id "+ctx.Param("id"))
+	}
+}
+
+// HandlerSearch searches the journal entries the user may read, optionally
+// within one character (character_id) or session (session_id).
+func (h *JournalHandler) HandlerSearch() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		characterId, err := optionalQueryInt(ctx, "character_id")
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		sessionId, err := optionalQueryInt(ctx, "session_id")
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		entries, err := h.service.Search(ctx.Query("q"), characterId, sessionId, cookie.Value)
+		if err != nil {
+			ctx.JSON(journalErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, entries)
+	}
+}
+
+func optionalQueryInt(ctx *gin.Context, name string) (*int, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func journalErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, journal.ErrInvalidVisibility),
+		errors.Is(err, journal.ErrEmptyEntry),
+		errors.Is(err, journal.ErrInvalidAttachment),
+		errors.Is(err, journal.ErrEmptySearch):
+		return 400
+	case errors.Is(err, journal.ErrNotOwner):
+		return 403
+	case errors.Is(err, journal.ErrNotFound),
+		errors.Is(err, journal.ErrCharacterNotFound):
+		return 404
+	case errors.Is(err, storage.ErrTooLarge),
+		errors.Is(err, storage.ErrImageDimensions),
+		errors.Is(err, storage.ErrUnsupportedImage),
+		errors.Is(err, storage.ErrInvalidImage),
+		errors.Is(err, storage.ErrInvalidDataURL):
+		return imageErrorStatus(err)
+	}
+	return 500
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/progression"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+type ProgressionHandler struct {
+	service      progression.ServiceProgression
+	classService class.ClassService
+	homebrew     homebrew
+	admins       admins
+}
+
+func NewProgressionHandler(service *progression.ServiceProgression, classService *class.ClassService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, adminIds []string) *ProgressionHandler {
+	return &ProgressionHandler{service: *service, classService: *classService, homebrew: newHomebrew(campaignService, userService), admins: newAdmins(userService, adminIds)}
+}
+
+// progression godoc
+// @Summary Get the progression table of a class
+// @Tags progression
+// @Produce json
+// @Param id path int true "class id"
+// @Success 200 {array} domain.ClassProgression
+// @Failure 500 {object} error
+// @Router /class/{id}/progression [get]
+func (h *ProgressionHandler) HandlerGetByClassId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		classId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		rows, err := h.service.GetByClassId(classId)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, rows)
+	}
+}
+
+// progression godoc
+// @Summary Add a progression row to a class
+// @Tags progression
+// @Accept json
+// @Produce json
+// @Param id path int true "class id"
+// @Param body body dto.ClassProgressionDto true "ClassProgressionDto"
+// @Success 201 {object} domain.ClassProgression
+// @Failure 400 {object} error
+// @Router /class/{id}/progression [post]
+func (h *ProgressionHandler) HandlerCreate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		classId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.ClassProgressionDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, classId); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+
+		created, err := h.service.Create(classId, request)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, created)
+	}
+}
+
+// progression godoc
+// @Summary Update a progression row
+// @Tags progression
+// @Accept json
+// @Produce json
+// @Param id path int true "id"
+// @Param body body dto.ClassProgressionDto true "ClassProgressionDto"
+// @Success 200 {object} domain.ClassProgression
+// @Failure 400 {object} error
+// @Router /progression/{id} [put]
+func (h *ProgressionHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.ClassProgressionDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, current.ClassId); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+
+		updated, err := h.service.Update(id, request)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+// progression godoc
+// @Summary Delete a progression row
+// @Tags progression
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} string
+// @Failure 404 {object} error
+// @Router /progression/{id} [delete]
+func (h *ProgressionHandler) HandlerDelete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, current.ClassId); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+
+		if err := h.service.Delete(id); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted progression row with id: "+strconv.Itoa(id))
+	}
+}
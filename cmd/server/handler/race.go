@@ -4,16 +4,22 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 	"github.com/proyecto-dnd/backend/internal/race"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type RaceHandler struct {
-	service race.RaceService
+	service    race.RaceService
+	homebrew   homebrew
+	translator translator
 }
 
-func NewRaceHandler(service race.RaceService) *RaceHandler {
-	return &RaceHandler{service: service}
+func NewRaceHandler(service race.RaceService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *RaceHandler {
+	return &RaceHandler{service: service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 func (h *RaceHandler) HandlerCreate() gin.HandlerFunc {
@@ -23,6 +29,10 @@ func (h *RaceHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		if err := h.homebrew.authorize(ctx, tempRace.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdRace, err := h.service.CreateRace(tempRace)
 		if err != nil {
@@ -36,13 +46,21 @@ func (h *RaceHandler) HandlerCreate() gin.HandlerFunc {
 
 func (h *RaceHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		races, err := h.service.GetAllRaces()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
-
+			ctx.JSON(400, err.Error())
+			return
+		}
+		races, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "race", races.Items, raceText); err != nil {
 			ctx.JSON(500, err.Error())
 			return
 		}
-		ctx.JSON(200, races)
+		ctx.JSON(200, catalogList(params, races))
 	}
 }
 
@@ -61,6 +79,14 @@ func (h *RaceHandler) HandlerGetById() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		if err := h.homebrew.visible(ctx, race.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "race", &race, raceText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, race)
 	}
 }
@@ -79,6 +105,15 @@ func (h *RaceHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetRaceByID(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId, tempRace.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		updatedRace, err := h.service.UpdateRace(tempRace, intId)
 		if err != nil {
@@ -98,6 +133,15 @@ func (h *RaceHandler) HandlerDelete() gin.HandlerFunc {
 			ctx.JSON(500, err)
 			return
 		}
+		current, err := h.service.GetRaceByID(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		serviceErr := h.service.DeleteRace(intId)
 		if serviceErr != nil {
@@ -108,3 +152,7 @@ func (h *RaceHandler) HandlerDelete() gin.HandlerFunc {
 		ctx.JSON(200, "Deleted Race with id "+id)
 	}
 }
+
+func raceText(r *domain.Race) translation.Text {
+	return translation.Text{Id: r.RaceID, Name: &r.Name, Description: &r.Description}
+}
@@ -7,14 +7,17 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/proyecto-dnd/backend/internal/report"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type ReportHandler struct {
-	service report.ReportGenerator
+	service    report.ReportGenerator
+	translator translator
 }
 
-func NewReportHandler(service *report.ReportGenerator) *ReportHandler {
-	return &ReportHandler{service: *service}
+func NewReportHandler(service *report.ReportGenerator, translationService *translation.ServiceTranslation, userService *user.ServiceUsers) *ReportHandler {
+	return &ReportHandler{service: *service, translator: newTranslator(translationService, userService)}
 }
 
 func (h *ReportHandler) HandlerGetSessionReport() gin.HandlerFunc {
@@ -51,4 +54,21 @@ func (h *ReportHandler) HandlerGetCharacterCampaignReport() gin.HandlerFunc {
 		c.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=report-characters-campaign-%d-%s.xlsx", id, time.Now().Format("2006-01-02 15:04")))
 		c.Writer.Write(report.Bytes())
 	}
-}
\ No newline at end of file
+}
+func (h *ReportHandler) HandlerGetCharacterSheetPdf() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		report, err := h.service.GenerateCharacterSheetPdf(id, h.translator.locale(c))
+		if err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		c.Writer.Header().Set("Content-Type", "application/pdf")
+		c.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=character-sheet-%d-%s.pdf", id, time.Now().Format("2006-01-02 15:04")))
+		c.Writer.Write(report.Bytes())
+	}
+}
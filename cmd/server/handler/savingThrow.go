@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/saving_throws"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+type SavingThrowHandler struct {
+	service      saving_throws.SavingThrowsService
+	classService class.ClassService
+	homebrew     homebrew
+	admins       admins
+}
+
+func NewSavingThrowHandler(service *saving_throws.SavingThrowsService, classService *class.ClassService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, adminIds []string) *SavingThrowHandler {
+	return &SavingThrowHandler{service: *service, classService: *classService, homebrew: newHomebrew(campaignService, userService), admins: newAdmins(userService, adminIds)}
+}
+
+// savingThrow godoc
+// @Summary Create the saving throw proficiencies of a class
+// @Tags saving_throw
+// @Accept json
+// @Produce json
+// @Param body body dto.SavingThrowDto true "SavingThrowDto"
+// @Success 201 {object} domain.SavingThrow
+// @Failure 400 {object} error
+// @Failure 409 {object} error
+// @Router /saving_throw [post]
+func (h *SavingThrowHandler) HandlerCreate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var request dto.SavingThrowDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, request.ClassId); err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+
+		created, err := h.service.Create(request)
+		if err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, created)
+	}
+}
+
+// savingThrow godoc
+// @Summary List the saving throw proficiencies of every class
+// @Tags saving_throw
+// @Produce json
+// @Success 200 {array} domain.SavingThrow
+// @Failure 500 {object} error
+// @Router /saving_throw [get]
+func (h *SavingThrowHandler) HandlerGetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		savingThrows, err := h.service.GetAll()
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, savingThrows)
+	}
+}
+
+// savingThrow godoc
+// @Summary Get saving throw proficiencies by id
+// @Tags saving_throw
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} domain.SavingThrow
+// @Failure 404 {object} error
+// @Router /saving_throw/{id} [get]
+func (h *SavingThrowHandler) HandlerGetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		found, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, found)
+	}
+}
+
+// savingThrow godoc
+// @Summary Get the saving throw proficiencies of a class
+// @Tags saving_throw
+// @Produce json
+// @Param id path int true "class id"
+// @Success 200 {object} domain.SavingThrow
+// @Failure 404 {object} error
+// @Router /class/{id}/saving_throw [get]
+func (h *SavingThrowHandler) HandlerGetByClassId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		classId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		found, err := h.service.GetByClassId(classId)
+		if err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, found)
+	}
+}
+
+// savingThrow godoc
+// @Summary Update saving throw proficiencies
+// @Tags saving_throw
+// @Accept json
+// @Produce json
+// @Param id path int true "id"
+// @Param body body dto.SavingThrowDto true "SavingThrowDto"
+// @Success 200 {object} domain.SavingThrow
+// @Failure 400 {object} error
+// @Failure 409 {object} error
+// @Router /saving_throw/{id} [put]
+func (h *SavingThrowHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SavingThrowDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, current.ClassId); err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		if request.ClassId != current.ClassId {
+			if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, request.ClassId); err != nil {
+				ctx.JSON(savingThrowErrorStatus(err), err.Error())
+				return
+			}
+		}
+
+		updated, err := h.service.Update(request, id)
+		if err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+// savingThrow godoc
+// @Summary Delete saving throw proficiencies
+// @Tags saving_throw
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} string
+// @Failure 404 {object} error
+// @Router /saving_throw/{id} [delete]
+func (h *SavingThrowHandler) HandlerDelete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, current.ClassId); err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+
+		if err := h.service.Delete(id); err != nil {
+			ctx.JSON(savingThrowErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted saving throws with id: "+strconv.Itoa(id))
+	}
+}
+
+func savingThrowErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errClassNotFound),
+		errors.Is(err, saving_throws.ErrNotFound):
+		return 404
+	case errors.Is(err, saving_throws.ErrAlreadyDefined):
+		return 409
+	}
+	return homebrewErrorStatus(err)
+}
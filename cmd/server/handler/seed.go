@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/seed"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+type SeedHandler struct {
+	service seed.ServiceSeed
+	admins  admins
+}
+
+// NewSeedHandler takes the ids of the users allowed to seed the database.
+func NewSeedHandler(service *seed.ServiceSeed, userService *user.ServiceUsers, adminIds []string) *SeedHandler {
+	return &SeedHandler{service: *service, admins: newAdmins(userService, adminIds)}
+}
+
+// HandlerLoadSRD loads the embedded SRD content into the database, the same as
+// the seed command, and returns what it did to each table.
+func (h *SeedHandler) HandlerLoadSRD() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if err := h.admins.authorize(ctx); err != nil {
+			if errors.Is(err, errAdminLogin) {
+				ctx.JSON(401, err.Error())
+				return
+			}
+			ctx.JSON(403, err.Error())
+			return
+		}
+
+		report, err := h.service.LoadSRD()
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, report)
+	}
+}
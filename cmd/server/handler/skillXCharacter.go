@@ -32,19 +32,26 @@ func (h *SkillXCharacterHandler) HandlerCreate() gin.HandlerFunc {
 	}
 }
 
+// HandlerDelete removes the skill_id query parameter skill from the character
+// of the :id path parameter. Skill links have no id of their own.
 func (h *SkillXCharacterHandler) HandlerDelete() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		var tempSkillXCharacter domain.SkillXCharacterData
-		if err := ctx.BindJSON(&tempSkillXCharacter); err != nil {
-			ctx.JSON(500, err)
+		characterId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
 			return
 		}
-		err := h.service.Delete(tempSkillXCharacter)
+		skillId, err := strconv.Atoi(ctx.Query("skill_id"))
+		if err != nil {
+			ctx.JSON(400, "skill_id query parameter is required")
+			return
+		}
+		tempSkillXCharacter := domain.SkillXCharacterData{SkillID: int64(skillId), CharacterID: int64(characterId)}
+		err = h.service.Delete(tempSkillXCharacter)
 		if err != nil {
 			ctx.JSON(500, err)
 			return
 		}
-		ctx.JSON(201, "Successfully deleted row with skill_id="+strconv.Itoa(int(tempSkillXCharacter.SkillID))+" and character_id="+strconv.Itoa(int(tempSkillXCharacter.CharacterID)))
+		ctx.JSON(201, "Successfully deleted row with skill_id="+strconv.Itoa(skillId)+" and character_id="+strconv.Itoa(characterId))
 	}
 }
-
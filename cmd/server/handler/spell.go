@@ -4,16 +4,22 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 )
 
 type SpellHandler struct {
-	service spell.ServiceSpell
+	service    spell.ServiceSpell
+	homebrew   homebrew
+	translator translator
 }
 
-func NewSpellHandler(service *spell.ServiceSpell) *SpellHandler {
-	return &SpellHandler{service: *service}
+func NewSpellHandler(service *spell.ServiceSpell, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *SpellHandler {
+	return &SpellHandler{service: *service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 func (h *SpellHandler) HandlerCreate() gin.HandlerFunc {
@@ -23,6 +29,10 @@ func (h *SpellHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		if err := h.homebrew.authorize(ctx, tempSpell.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		createdSpell, err := h.service.Create(tempSpell)
 		if err != nil {
 			ctx.JSON(500, err.Error())
@@ -35,14 +45,21 @@ func (h *SpellHandler) HandlerCreate() gin.HandlerFunc {
 
 func (h *SpellHandler) HandlergetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		spellList, err := h.service.GetAll()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
-
 			ctx.JSON(400, err.Error())
 			return
 		}
-
-		ctx.JSON(200, spellList)
+		spellList, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "spell", spellList.Items, spellText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, catalogList(params, spellList))
 	}
 }
 
@@ -62,6 +79,14 @@ func (h *SpellHandler) HandlerGetById() gin.HandlerFunc {
 			return
 		}
 
+		if err := h.homebrew.visible(ctx, tempSpell.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "spell", &tempSpell, spellText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, tempSpell)
 	}
 }
@@ -98,6 +123,15 @@ func (h *SpellHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetById(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId, tempSpell.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		updatedSpell, err := h.service.Update(tempSpell, intId)
 		if err != nil {
 			ctx.JSON(500, err.Error())
@@ -117,6 +151,15 @@ func (h *SpellHandler) HandlerDelete() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		current, err := h.service.GetById(intId)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.CampaignId); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		if err = h.service.Delete(intId); err != nil {
 			ctx.JSON(500, err.Error())
 			return
@@ -139,6 +182,19 @@ func (h *SpellHandler) HandlerGetByClassId() gin.HandlerFunc {
 			ctx.JSON(500, err.Error())
 			return
 		}
+		spells, err = visibleEntries(ctx, h.homebrew, spells, func(s domain.Spell) *int { return s.CampaignId })
+		if err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "spell", spells, spellText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, spells)
 	}
 }
+
+func spellText(s *domain.Spell) translation.Text {
+	return translation.Text{Id: s.SpellId, Name: &s.Name, Description: &s.Description}
+}
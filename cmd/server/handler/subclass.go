@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/progression"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+var errClassNotFound = errors.New("class not found")
+
+type SubclassHandler struct {
+	service            subclass.ServiceSubclass
+	progressionService progression.ServiceProgression
+	classService       class.ClassService
+	homebrew           homebrew
+	admins             admins
+}
+
+func NewSubclassHandler(service *subclass.ServiceSubclass, progressionService *progression.ServiceProgression, classService *class.ClassService, campaignService *campaign.CampaignService, userService *user.ServiceUsers, adminIds []string) *SubclassHandler {
+	return &SubclassHandler{service: *service, progressionService: *progressionService, classService: *classService, homebrew: newHomebrew(campaignService, userService), admins: newAdmins(userService, adminIds)}
+}
+
+// subclass godoc
+// @Summary Get the subclasses of a class
+// @Tags subclass
+// @Produce json
+// @Param id path int true "class id"
+// @Success 200 {array} domain.Subclass
+// @Failure 500 {object} error
+// @Router /class/{id}/subclass [get]
+func (h *SubclassHandler) HandlerGetByClassId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		classId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		subclasses, err := h.service.GetByClassId(classId)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, subclasses)
+	}
+}
+
+// subclass godoc
+// @Summary Create a subclass of a class
+// @Tags subclass
+// @Accept json
+// @Produce json
+// @Param id path int true "class id"
+// @Param body body dto.SubclassDto true "SubclassDto"
+// @Success 201 {object} domain.Subclass
+// @Failure 400 {object} error
+// @Router /class/{id}/subclass [post]
+func (h *SubclassHandler) HandlerCreate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		classId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SubclassDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, classId); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+
+		created, err := h.service.Create(classId, request)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, created)
+	}
+}
+
+// subclass godoc
+// @Summary Get subclass by id
+// @Tags subclass
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} domain.Subclass
+// @Failure 404 {object} error
+// @Router /subclass/{id} [get]
+func (h *SubclassHandler) HandlerGetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		found, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, found)
+	}
+}
+
+// subclass godoc
+// @Summary Update subclass
+// @Tags subclass
+// @Accept json
+// @Produce json
+// @Param id path int true "id"
+// @Param body body dto.SubclassDto true "SubclassDto"
+// @Success 200 {object} domain.Subclass
+// @Failure 400 {object} error
+// @Router /subclass/{id} [put]
+func (h *SubclassHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SubclassDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, current.ClassId); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+
+		updated, err := h.service.Update(id, request)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+// subclass godoc
+// @Summary Delete subclass and its progression rows
+// @Tags subclass
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} string
+// @Failure 404 {object} error
+// @Router /subclass/{id} [delete]
+func (h *SubclassHandler) HandlerDelete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		if err := authorizeClass(ctx, h.homebrew, h.admins, h.classService, current.ClassId); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+
+		if err := h.progressionService.DeleteBySubclassId(id); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		if err := h.service.Delete(id); err != nil {
+			ctx.JSON(progressionErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted subclass with id: "+strconv.Itoa(id))
+	}
+}
+
+// authorizeClass checks the user may manage the subclasses and progression of
+// a class, which follow the homebrew rules of the class itself. Those of
+// generic classes are shared by everyone and only administrators change them.
+func authorizeClass(ctx *gin.Context, h homebrew, a admins, classService class.ClassService, classId int) error {
+	current, err := classService.GetById(classId)
+	if err != nil {
+		return errClassNotFound
+	}
+	if current.CampaignId == nil {
+		return a.authorize(ctx)
+	}
+	return h.authorize(ctx, current.CampaignId)
+}
+
+func progressionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errClassNotFound),
+		errors.Is(err, subclass.ErrNotFound),
+		errors.Is(err, progression.ErrNotFound):
+		return 404
+	case errors.Is(err, subclass.ErrMissingName),
+		errors.Is(err, subclass.ErrInvalidLevel),
+		errors.Is(err, subclass.ErrOtherClass),
+		errors.Is(err, progression.ErrInvalidLevel),
+		errors.Is(err, progression.ErrUnknownFeature),
+		errors.Is(err, progression.ErrInvalidSpellSlots),
+		errors.Is(err, progression.ErrInvalidResource):
+		return 400
+	}
+	return homebrewErrorStatus(err)
+}
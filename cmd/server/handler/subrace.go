@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/race"
+	"github.com/proyecto-dnd/backend/internal/subrace"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+var errRaceNotFound = errors.New("race not found")
+
+type SubraceHandler struct {
+	service     subrace.ServiceSubrace
+	raceService race.RaceService
+	homebrew    homebrew
+}
+
+func NewSubraceHandler(service *subrace.ServiceSubrace, raceService race.RaceService, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *SubraceHandler {
+	return &SubraceHandler{service: *service, raceService: raceService, homebrew: newHomebrew(campaignService, userService)}
+}
+
+// subrace godoc
+// @Summary Get the subraces of a race
+// @Tags subrace
+// @Produce json
+// @Param id path int true "race id"
+// @Success 200 {array} domain.Subrace
+// @Failure 500 {object} error
+// @Router /race/{id}/subrace [get]
+func (h *SubraceHandler) HandlerGetByRaceId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		raceId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		subraces, err := h.service.GetByRaceId(raceId)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, subraces)
+	}
+}
+
+// subrace godoc
+// @Summary Create a subrace of a race
+// @Tags subrace
+// @Accept json
+// @Produce json
+// @Param id path int true "race id"
+// @Param body body dto.SubraceDto true "SubraceDto"
+// @Success 201 {object} domain.Subrace
+// @Failure 400 {object} error
+// @Router /race/{id}/subrace [post]
+func (h *SubraceHandler) HandlerCreate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		raceId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SubraceDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.authorizeRace(ctx, raceId); err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+
+		created, err := h.service.Create(raceId, request)
+		if err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(201, created)
+	}
+}
+
+// subrace godoc
+// @Summary Get subrace by id
+// @Tags subrace
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} domain.Subrace
+// @Failure 404 {object} error
+// @Router /subrace/{id} [get]
+func (h *SubraceHandler) HandlerGetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		found, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, found)
+	}
+}
+
+// subrace godoc
+// @Summary Update subrace
+// @Tags subrace
+// @Accept json
+// @Produce json
+// @Param id path int true "id"
+// @Param body body dto.SubraceDto true "SubraceDto"
+// @Success 200 {object} domain.Subrace
+// @Failure 400 {object} error
+// @Router /subrace/{id} [put]
+func (h *SubraceHandler) HandlerUpdate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.SubraceDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+		if err := h.authorizeRace(ctx, current.RaceId); err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+
+		updated, err := h.service.Update(id, request)
+		if err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, updated)
+	}
+}
+
+// subrace godoc
+// @Summary Delete subrace, leaving its characters with just their race
+// @Tags subrace
+// @Produce json
+// @Param id path int true "id"
+// @Success 200 {object} string
+// @Failure 404 {object} error
+// @Router /subrace/{id} [delete]
+func (h *SubraceHandler) HandlerDelete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+		if err := h.authorizeRace(ctx, current.RaceId); err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+
+		if err := h.service.Delete(id); err != nil {
+			ctx.JSON(subraceErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted subrace with id: "+strconv.Itoa(id))
+	}
+}
+
+// authorizeRace checks the user may manage the subraces of a race, which
+// follow the homebrew rules of the race itself.
+func (h *SubraceHandler) authorizeRace(ctx *gin.Context, raceId int) error {
+	current, err := h.raceService.GetRaceByID(raceId)
+	if err != nil {
+		return errRaceNotFound
+	}
+	return h.homebrew.authorize(ctx, current.CampaignId)
+}
+
+func subraceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errRaceNotFound), errors.Is(err, subrace.ErrNotFound):
+		return 404
+	case errors.Is(err, subrace.ErrMissingName),
+		errors.Is(err, subrace.ErrUnknownProficiency),
+		errors.Is(err, subrace.ErrUnknownFeature):
+		return 400
+	}
+	return homebrewErrorStatus(err)
+}
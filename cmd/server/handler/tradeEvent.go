@@ -3,16 +3,19 @@ package handler
 import (
 	"strconv"
 	"github.com/gin-gonic/gin"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
 	tradeevent "github.com/proyecto-dnd/backend/internal/tradeEvent"
 )
 
 type TradeEventHandler struct {
-	service tradeevent.ServiceTradeEvent
+	service              tradeevent.ServiceTradeEvent
+	characterDataService characterdata.ServiceCharacterData
 }
 
-func NewTradeEventHandler(service *tradeevent.ServiceTradeEvent) *TradeEventHandler {
-	return &TradeEventHandler{service: *service}
+func NewTradeEventHandler(service *tradeevent.ServiceTradeEvent, characterDataService *characterdata.ServiceCharacterData) *TradeEventHandler {
+	return &TradeEventHandler{service: *service, characterDataService: *characterDataService}
 }
 
 func (h *TradeEventHandler) HandlerCreate() gin.HandlerFunc {
@@ -28,10 +31,21 @@ func (h *TradeEventHandler) HandlerCreate() gin.HandlerFunc {
 			return
 		}
 		if err != nil {
+			if code := walletErrorStatus(err); code != 500 {
+				ctx.JSON(code, err.Error())
+				return
+			}
 			ctx.JSON(500, err)
 			return
 		}
-		ctx.JSON(201, createdTradeEvent)
+		// Items may flow both ways, so both sides of the trade are checked.
+		response := dto.TradeEventResponseDto{TradeEvent: createdTradeEvent}
+		for _, characterId := range []int{createdTradeEvent.Sender, createdTradeEvent.Receiver} {
+			if warning := encumbranceWarning(h.characterDataService, characterId); warning != nil {
+				response.EncumbranceWarnings = append(response.EncumbranceWarnings, *warning)
+			}
+		}
+		ctx.JSON(201, response)
 	}
 }
 
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+// translator shows catalog entries in the locale of the request.
+type translator struct {
+	service     translation.ServiceTranslation
+	userService user.ServiceUsers
+}
+
+func newTranslator(service *translation.ServiceTranslation, userService *user.ServiceUsers) translator {
+	return translator{service: *service, userService: *userService}
+}
+
+// locale negotiates the locale of a request from the preference of the logged
+// in user and the Accept-Language header, and reports it in Content-Language.
+func (t translator) locale(ctx *gin.Context) string {
+	preference := ""
+	if cookie, err := ctx.Request.Cookie("Session"); err == nil {
+		if claims, err := t.userService.GetJwtInfo(cookie.Value); err == nil {
+			preference, _ = t.userService.GetLocale(claims.Id)
+		}
+	}
+	locale := translation.Negotiate(preference, ctx.GetHeader("Accept-Language"))
+	ctx.Header("Content-Language", locale)
+	return locale
+}
+
+// localize translates the entries of a catalog response.
+func localize[T any](ctx *gin.Context, t translator, catalogName string, entries []T, text func(*T) translation.Text) error {
+	return translation.Localize(t.service, catalogName, t.locale(ctx), entries, text)
+}
+
+// localizeEntry translates a single catalog entry.
+func localizeEntry[T any](ctx *gin.Context, t translator, catalogName string, entry *T, text func(*T) translation.Text) error {
+	entries := []T{*entry}
+	if err := localize(ctx, t, catalogName, entries, text); err != nil {
+		return err
+	}
+	*entry = entries[0]
+	return nil
+}
+
+// localizeCharacter translates the catalog entries of a character sheet.
+func localizeCharacter(ctx *gin.Context, t translator, character *dto.FullCharacterData) error {
+	return translation.LocalizeCharacter(t.service, t.locale(ctx), character)
+}
+
+type TranslationHandler struct {
+	service  translation.ServiceTranslation
+	homebrew homebrew
+}
+
+func NewTranslationHandler(service *translation.ServiceTranslation, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *TranslationHandler {
+	return &TranslationHandler{service: *service, homebrew: newHomebrew(campaignService, userService)}
+}
+
+// translation godoc
+// @Summary Get every translation of a catalog entry by locale
+// @Tags translation
+// @Produce json
+// @Param id path int true "entry id"
+// @Success 200 {object} dto.TranslationsDto
+// @Failure 404 {object} error
+// @Router /{catalog}/{id}/translation [get]
+func (h *TranslationHandler) HandlerGet(catalogName string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		translations, err := h.service.GetByEntry(catalogName, id)
+		if err != nil {
+			ctx.JSON(translationErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, translations)
+	}
+}
+
+// translation godoc
+// @Summary Translate a catalog entry to a locale
+// @Tags translation
+// @Accept json
+// @Produce json
+// @Param id path int true "entry id"
+// @Param locale path string true "locale"
+// @Param body body dto.TranslationDto true "TranslationDto"
+// @Success 200 {object} dto.TranslationsDto
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Router /{catalog}/{id}/translation/{locale} [put]
+func (h *TranslationHandler) HandlerSet(catalogName string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var request dto.TranslationDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.authorize(ctx, catalogName, id); err != nil {
+			ctx.JSON(translationErrorStatus(err), err.Error())
+			return
+		}
+
+		translations, err := h.service.Set(catalogName, id, strings.ToLower(ctx.Param("locale")), request)
+		if err != nil {
+			ctx.JSON(translationErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, translations)
+	}
+}
+
+// translation godoc
+// @Summary Delete the translation of a catalog entry to a locale
+// @Tags translation
+// @Produce json
+// @Param id path int true "entry id"
+// @Param locale path string true "locale"
+// @Success 200 {object} string
+// @Failure 404 {object} error
+// @Router /{catalog}/{id}/translation/{locale} [delete]
+func (h *TranslationHandler) HandlerDelete(catalogName string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		if err := h.authorize(ctx, catalogName, id); err != nil {
+			ctx.JSON(translationErrorStatus(err), err.Error())
+			return
+		}
+
+		locale := strings.ToLower(ctx.Param("locale"))
+		if err := h.service.Delete(catalogName, id, locale); err != nil {
+			ctx.JSON(translationErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, "Deleted "+locale+" translation of "+catalogName+" with id: "+strconv.Itoa(id))
+	}
+}
+
+// authorize checks the user may edit the entry, which follows the homebrew
+// rules of the entry itself.
+func (h *TranslationHandler) authorize(ctx *gin.Context, catalogName string, id int) error {
+	campaignId, err := h.service.GetCampaignId(catalogName, id)
+	if err != nil {
+		return err
+	}
+	return h.homebrew.authorize(ctx, campaignId)
+}
+
+func translationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, translation.ErrEntryNotFound),
+		errors.Is(err, translation.ErrNotFound),
+		errors.Is(err, translation.ErrUnknownCatalog):
+		return 404
+	case errors.Is(err, translation.ErrUnsupportedLocale),
+		errors.Is(err, translation.ErrMissingName):
+		return 400
+	}
+	return homebrewErrorStatus(err)
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"strconv"
 	"strings"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
 	"github.com/proyecto-dnd/backend/internal/user"
 )
 
@@ -329,3 +332,77 @@ func (h *UserHandler) HandlerSendEmailVerification() gin.HandlerFunc {
 		ctx.JSON(200, "Email sent")
 	}
 }
+
+// user godoc
+// @Summary Get the locale catalog content is shown in for the logged in user
+// @Tags user
+// @Produce json
+// @Success 200 {object} dto.UserLocaleDto
+// @Failure 401 {object} error
+// @Router /user/locale [get]
+func (h *UserHandler) HandlerGetLocale() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		jwtClaimsInfo, err := h.service.GetJwtInfo(cookie.Value)
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		locale, err := h.service.GetLocale(jwtClaimsInfo.Id)
+		if err != nil {
+			ctx.JSON(userLocaleErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, dto.UserLocaleDto{Locale: locale})
+	}
+}
+
+// user godoc
+// @Summary Set the locale catalog content is shown in, over the Accept-Language header
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param body body dto.UserLocaleDto true "an empty locale clears the preference"
+// @Success 200 {object} dto.UserLocaleDto
+// @Failure 400 {object} error
+// @Failure 401 {object} error
+// @Router /user/locale [put]
+func (h *UserHandler) HandlerSetLocale() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cookie, err := ctx.Request.Cookie("Session")
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		jwtClaimsInfo, err := h.service.GetJwtInfo(cookie.Value)
+		if err != nil {
+			ctx.JSON(401, err.Error())
+			return
+		}
+		var request dto.UserLocaleDto
+		if err := ctx.BindJSON(&request); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		locale := strings.ToLower(strings.TrimSpace(request.Locale))
+		if err := h.service.SetLocale(jwtClaimsInfo.Id, locale); err != nil {
+			ctx.JSON(userLocaleErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, dto.UserLocaleDto{Locale: locale})
+	}
+}
+
+func userLocaleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, translation.ErrUnsupportedLocale):
+		return 400
+	case errors.Is(err, user.ErrNotFound):
+		return 404
+	}
+	return 500
+}
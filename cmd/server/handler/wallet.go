@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/wallet"
+)
+
+var ErrInvalidAdjustmentType = errors.New("type must be credit or debit")
+
+type WalletHandler struct {
+	service wallet.ServiceWallet
+}
+
+func NewWalletHandler(service *wallet.ServiceWallet) *WalletHandler {
+	return &WalletHandler{service: *service}
+}
+
+func (h *WalletHandler) HandlerGet() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		characterWallet, err := h.service.GetByCharacterId(id)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, walletResponse(characterWallet))
+	}
+}
+
+func (h *WalletHandler) HandlerGetTransactions() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		transactions, err := h.service.GetTransactions(id)
+		if err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, transactions)
+	}
+}
+
+func (h *WalletHandler) HandlerAdjust() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+		var adjustment dto.WalletAdjustmentDto
+		if err := ctx.BindJSON(&adjustment); err != nil {
+			ctx.JSON(400, err.Error())
+			return
+		}
+
+		var characterWallet domain.Wallet
+		switch adjustment.Type {
+		case "credit":
+			characterWallet, err = h.service.Credit(id, adjustment.Amount, adjustment.Reason)
+		case "debit":
+			characterWallet, err = h.service.Debit(id, adjustment.Amount, adjustment.Reason)
+		default:
+			err = ErrInvalidAdjustmentType
+		}
+		if err != nil {
+			ctx.JSON(walletErrorStatus(err), err.Error())
+			return
+		}
+		ctx.JSON(200, walletResponse(characterWallet))
+	}
+}
+
+func walletResponse(characterWallet domain.Wallet) dto.WalletDto {
+	return dto.WalletDto{Wallet: characterWallet, TotalCp: wallet.Value(characterWallet.Currency)}
+}
+
+func walletErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrInvalidAdjustmentType),
+		errors.Is(err, wallet.ErrNegativeAmount),
+		errors.Is(err, wallet.ErrEmptyAmount),
+		errors.Is(err, wallet.ErrMissingReason),
+		errors.Is(err, wallet.ErrSameCharacter):
+		return 400
+	case errors.Is(err, wallet.ErrInsufficientFunds):
+		return 409
+	}
+	return 500
+}
@@ -3,16 +3,21 @@ package handler
 import (
 	"strconv"
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/user"
 	"github.com/proyecto-dnd/backend/internal/weapon"
 )
 
 type WeaponHandler struct {
-	service weapon.ServiceWeapon
+	service    weapon.ServiceWeapon
+	homebrew   homebrew
+	translator translator
 }
 
-func NewWeaponHandler(service *weapon.ServiceWeapon) *WeaponHandler{
-	return &WeaponHandler{service: *service}
+func NewWeaponHandler(service *weapon.ServiceWeapon, campaignService *campaign.CampaignService, userService *user.ServiceUsers, translationService *translation.ServiceTranslation) *WeaponHandler{
+	return &WeaponHandler{service: *service, homebrew: newHomebrew(campaignService, userService), translator: newTranslator(translationService, userService)}
 }
 
 // weapon godoc
@@ -32,6 +37,10 @@ func (h *WeaponHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.AbortWithError(400, err)
             return
 		}
+		if err := h.homebrew.authorize(ctx, tempWeapon.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		createdWeapon, err := h.service.Create(tempWeapon)
 		if err!= nil {
@@ -58,6 +67,15 @@ func (h *WeaponHandler) HandlerDelete() gin.HandlerFunc{
 			ctx.AbortWithError(400, err)
 			return
 		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		err = h.service.Delete(id)
 		if err != nil {
 			ctx.AbortWithError(404, err)
@@ -71,17 +89,30 @@ func (h *WeaponHandler) HandlerDelete() gin.HandlerFunc{
 // @Summary Get all weapons
 // @Tags weapon
 // @Produce json
+// @Param q query string false "text searched in name and description"
+// @Param sort query string false "field to sort by, prefixed with - for descending"
+// @Param limit query int false "page size up to 200; paged lists come wrapped with their total"
+// @Param offset query int false "rows to skip, paging by 50 when no limit is given"
 // @Success 200 {array} domain.Weapon
 // @Failure 500 {object} error
 // @Router /weapon [get]
-func (h *WeaponHandler) HandlerGetAll() gin.HandlerFunc{
+func (h *WeaponHandler) HandlerGetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		weapons, err := h.service.GetAll()
+		params, err := h.homebrew.catalogParams(ctx)
 		if err != nil {
-			ctx.AbortWithError(500, err)
+			ctx.JSON(400, err.Error())
 			return
 		}
-		ctx.JSON(200, weapons)
+		weapons, err := h.service.Search(params)
+		if err != nil {
+			ctx.JSON(catalogErrorStatus(err), err.Error())
+			return
+		}
+		if err := localize(ctx, h.translator, "weapon", weapons.Items, weaponText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
+		ctx.JSON(200, catalogList(params, weapons))
 	}
 }
 
@@ -103,6 +134,10 @@ func (h *WeaponHandler) HandlerGetByCampaignId() gin.HandlerFunc{
 			ctx.AbortWithError(400, err)
 			return
 		}
+		if err := h.homebrew.visible(ctx, &id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 		weapons, err := h.  service.GetByCampaignId(id)
 		if err == weapon.ErrNotFound {
 			ctx.AbortWithError(404, err)
@@ -112,6 +147,10 @@ func (h *WeaponHandler) HandlerGetByCampaignId() gin.HandlerFunc{
 			ctx.AbortWithError(500, err)
 			return
 		}
+		if err := localize(ctx, h.translator, "weapon", weapons, weaponText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, weapons)
 	}
 }
@@ -138,6 +177,14 @@ func (h *WeaponHandler) HandlerGetById() gin.HandlerFunc{
 			ctx.AbortWithError(500, err)
 			return
 		}
+		if err := h.homebrew.visible(ctx, weapon.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		if err := localizeEntry(ctx, h.translator, "weapon", &weapon, weaponText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, weapon)
 	}
 }
@@ -156,6 +203,10 @@ func (h *WeaponHandler) HandlerGetAllGeneric() gin.HandlerFunc {
 			ctx.AbortWithError(500, err)
 			return
 		}
+		if err := localize(ctx, h.translator, "weapon", weapons, weaponText); err != nil {
+			ctx.JSON(500, err.Error())
+			return
+		}
 		ctx.JSON(200, weapons)
 	}
 }
@@ -184,6 +235,15 @@ func (h *WeaponHandler) HandlerUpdate() gin.HandlerFunc {
 			ctx.AbortWithError(400, err)
 			return
 		}
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.JSON(404, err.Error())
+			return
+		}
+		if err := h.homebrew.authorize(ctx, current.Campaign_Id, tempWeapon.Campaign_Id); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
 
 		tempWeapon.Weapon_Id = id
 
@@ -194,4 +254,8 @@ func (h *WeaponHandler) HandlerUpdate() gin.HandlerFunc {
         }
 		ctx.JSON(200, updatedWeapon)
 	}
-}
\ No newline at end of file
+}
+
+func weaponText(w *domain.Weapon) translation.Text {
+	return translation.Text{Id: w.Weapon_Id, Name: &w.Name, Description: &w.Description}
+}
@@ -5,16 +5,26 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/equipment"
+	"github.com/proyecto-dnd/backend/internal/user"
+	"github.com/proyecto-dnd/backend/internal/weapon"
 	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
 )
 
 type WeaponXCharacterDataHandler struct {
-	service weaponxcharacterdata.ServiceWeaponXCharacterData
+	service              weaponxcharacterdata.ServiceWeaponXCharacterData
+	characterDataService characterdata.ServiceCharacterData
+	equipmentService     equipment.ServiceEquipment
+	weaponService        weapon.ServiceWeapon
+	homebrew             homebrew
 }
 
-func NewWeaponXCharacterDataHandler(service *weaponxcharacterdata.ServiceWeaponXCharacterData) *WeaponXCharacterDataHandler {
-	return &WeaponXCharacterDataHandler{service: *service}
+func NewWeaponXCharacterDataHandler(service *weaponxcharacterdata.ServiceWeaponXCharacterData, characterDataService *characterdata.ServiceCharacterData, equipmentService *equipment.ServiceEquipment, weaponService *weapon.ServiceWeapon, campaignService *campaign.CampaignService, userService *user.ServiceUsers) *WeaponXCharacterDataHandler {
+	return &WeaponXCharacterDataHandler{service: *service, characterDataService: *characterDataService, equipmentService: *equipmentService, weaponService: *weaponService, homebrew: newHomebrew(campaignService, userService)}
 }
 
 // weaponXCharacterData godoc
@@ -23,7 +33,7 @@ func NewWeaponXCharacterDataHandler(service *weaponxcharacterdata.ServiceWeaponX
 // @Accept json
 // @Produce json
 // @Param body body domain.WeaponXCharacterData true "WeaponXCharacterData"
-// @Success 201 {object} domain.WeaponXCharacterData
+// @Success 201 {object} dto.WeaponXCharacterDataResponseDto
 // @Failure 400 {object} error
 // @Failure 500 {object} error
 // @Router /weapon_character [post]
@@ -36,15 +46,37 @@ func (h *WeaponXCharacterDataHandler) HandlerCreate() gin.HandlerFunc {
 			ctx.AbortWithError(400, err)
 			return
 		}
+		if err := linkable(ctx, h.homebrew, h.weaponService.GetById, tempWeaponXCharacterData.Weapon.Weapon_Id, func(w domain.Weapon) *int { return w.Campaign_Id }); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		equipped, slot := tempWeaponXCharacterData.Equipped, tempWeaponXCharacterData.Slot
+		tempWeaponXCharacterData.Equipped, tempWeaponXCharacterData.Slot, tempWeaponXCharacterData.Attuned = false, "", false
 		createdWeaponXCharacterData, err := h.service.Create(tempWeaponXCharacterData)
 		if err != nil {
 			log.Println(3, err)
 			ctx.AbortWithError(500, err)
 			return
 		}
+		if equipped {
+			err = applyEquipped(h.equipmentService, createdWeaponXCharacterData.CharacterData_Id, equipment.KindWeapon, createdWeaponXCharacterData.Character_Weapon_Id, true, slot)
+			if err != nil {
+				h.service.Delete(createdWeaponXCharacterData.Character_Weapon_Id)
+				ctx.JSON(equipmentErrorStatus(err), err.Error())
+				return
+			}
+			createdWeaponXCharacterData, err = h.service.GetById(createdWeaponXCharacterData.Character_Weapon_Id)
+			if err != nil {
+				ctx.AbortWithError(500, err)
+				return
+			}
+		}
 		
 		log.Println(4)
-		ctx.JSON(201, createdWeaponXCharacterData)
+		ctx.JSON(201, dto.WeaponXCharacterDataResponseDto{
+			WeaponXCharacterData: createdWeaponXCharacterData,
+			EncumbranceWarning:   encumbranceWarning(h.characterDataService, createdWeaponXCharacterData.CharacterData_Id),
+		})
 	}
 }
 
@@ -195,11 +227,40 @@ func (h *WeaponXCharacterDataHandler) HandlerUpdate() gin.HandlerFunc {
 
 		tempWeaponXCharacterData.Character_Weapon_Id = id
 
+		current, err := h.service.GetById(id)
+		if err != nil {
+			ctx.AbortWithError(404, err)
+			return
+		}
+		// Equipping goes through the slot rules; a weapon changing hands is
+		// always unequipped.
+		if err := linkable(ctx, h.homebrew, h.weaponService.GetById, tempWeaponXCharacterData.Weapon.Weapon_Id, func(w domain.Weapon) *int { return w.Campaign_Id }); err != nil {
+			ctx.JSON(homebrewErrorStatus(err), err.Error())
+			return
+		}
+		equipped, slot := tempWeaponXCharacterData.Equipped, tempWeaponXCharacterData.Slot
+		tempWeaponXCharacterData.Equipped, tempWeaponXCharacterData.Slot, tempWeaponXCharacterData.Attuned = current.Equipped, current.Slot, current.Attuned
+		if tempWeaponXCharacterData.CharacterData_Id != current.CharacterData_Id {
+			tempWeaponXCharacterData.Equipped, tempWeaponXCharacterData.Slot, tempWeaponXCharacterData.Attuned = false, "", false
+		}
+
 		updatedWeaponXCharacterData, err := h.service.Update(tempWeaponXCharacterData)
 		if err != nil {
 			ctx.AbortWithError(500, err)
 			return
 		}
+		if equipped != updatedWeaponXCharacterData.Equipped || (equipped && slot != "" && slot != updatedWeaponXCharacterData.Slot) {
+			err = applyEquipped(h.equipmentService, updatedWeaponXCharacterData.CharacterData_Id, equipment.KindWeapon, id, equipped, slot)
+			if err != nil {
+				ctx.JSON(equipmentErrorStatus(err), err.Error())
+				return
+			}
+			updatedWeaponXCharacterData, err = h.service.GetById(id)
+			if err != nil {
+				ctx.AbortWithError(500, err)
+				return
+			}
+		}
 
 		ctx.JSON(200, updatedWeaponXCharacterData)
 	}
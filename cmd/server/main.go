@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 
 	"database/sql"
@@ -14,7 +16,7 @@ import (
 
 	"github.com/proyecto-dnd/backend/cmd/server/router"
 	"github.com/proyecto-dnd/backend/pkg/firebaseConnection"
-	"github.com/proyecto-dnd/backend/pkg/s3"
+	"github.com/proyecto-dnd/backend/pkg/storage"
 
 	_ "github.com/proyecto-dnd/backend/docs"
 )
@@ -51,15 +53,14 @@ func main() {
 
 	db := ConnectDB()
 	firebaseApp := firebaseConnection.InitializeFirebaseApp()
-	go s3.InitializeS3()
 
-	
-	
 	engine := gin.New()
 	engine.Use(gin.Recovery())
 	engine.Use(cors.Default())
-	
-	router := router.NewRouter(engine, db, firebaseApp)
+
+	store := ConnectStorage(engine)
+
+	router := router.NewRouter(engine, db, firebaseApp, store)
 	router.MapRoutes()
 	
 	//PARA DOCKERIZAR CAMBIAR localhost por 0.0.0.0
@@ -102,6 +103,38 @@ func main() {
 // 	return app
 // }
 
+// ConnectStorage picks where uploaded images are kept. STORAGE_BACKEND=local
+// keeps them on disk and serves them from this server, which needs no AWS
+// credentials; otherwise they go to S3.
+func ConnectStorage(engine *gin.Engine) storage.Storage {
+	if os.Getenv("STORAGE_BACKEND") == "local" {
+		dir := envOrDefault("LOCAL_STORAGE_DIR", "uploads")
+		baseURL := envOrDefault("LOCAL_STORAGE_URL", "http://localhost:8080/uploads")
+		parsed, err := url.Parse(baseURL)
+		if err != nil {
+			panic(err)
+		}
+		engine.Static(parsed.Path, dir)
+		return storage.NewLocalStorage(dir, baseURL)
+	}
+
+	bucket := envOrDefault("S3_BUCKET", "dicelogger-images")
+	region := envOrDefault("S3_REGION", "sa-east-1")
+	store, err := storage.NewS3Storage(context.Background(), bucket, region, os.Getenv("S3_PUBLIC_READ") != "false")
+	if err != nil {
+		panic(err)
+	}
+	log.Println("S3 Initialized")
+	return store
+}
+
+func envOrDefault(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
 func ConnectDB() *sql.DB {
 	var dbUsername, dbPassword, dbHost, dbPort, dbName string
 	dbUsername = os.Getenv("DB_USERNAME")
@@ -0,0 +1,58 @@
+package router
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Lookups used by the history tracker and the resource sync to find the
+// characters a request modifies, before the rows are modified or deleted.
+
+func characterOfItemLink(id int) (int, error) {
+	link, err := itemXCharacterDataService.GetById(id)
+	return link.CharacterData_Id, err
+}
+
+func characterOfWeaponLink(id int) (int, error) {
+	link, err := weaponXCharacterDataService.GetById(id)
+	return link.CharacterData_Id, err
+}
+
+func characterOfArmorLink(id int) (int, error) {
+	link, err := armorXCharacterDataService.GetByIdArmorXCharacterData(id)
+	return link.CharacterData_Id, err
+}
+
+func characterOfSpellLink(id int) (int, error) {
+	link, err := characterXSpellService.GetById(id)
+	return link.CharacterId, err
+}
+
+func characterOfProficiencyLink(id int) (int, error) {
+	link, err := characterXProficiencyService.GetById(id)
+	return link.CharacterId, err
+}
+
+func characterOfAttackTarget(id int) (int, error) {
+	target, err := characterXAttackEventService.GetById(id)
+	return target.CharacterId, err
+}
+
+// charactersWithFeature resolves every character holding the feature of the
+// :id path parameter, whose resources follow the feature definition.
+func charactersWithFeature(ctx *gin.Context) []int {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return nil
+	}
+	links, err := featureXCharacterDataService.GetCharacterFeatureByFeatureId(id)
+	if err != nil {
+		return nil
+	}
+	characterIds := []int{}
+	for _, link := range links {
+		characterIds = append(characterIds, link.CharacterId)
+	}
+	return characterIds
+}
@@ -2,6 +2,8 @@ package router
 
 import (
 	"database/sql"
+	"os"
+	"strings"
 
 	firebase "firebase.google.com/go/v4"
 	"github.com/gin-gonic/gin"
@@ -10,16 +12,26 @@ import (
 	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
 	"github.com/proyecto-dnd/backend/internal/attackEvent"
 	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/bulk"
 	backgroundXproficiency "github.com/proyecto-dnd/backend/internal/backgroundXProficiency"
+	"github.com/proyecto-dnd/backend/internal/backgroundxSkill"
 	"github.com/proyecto-dnd/backend/internal/campaign"
+	campaignrules "github.com/proyecto-dnd/backend/internal/campaignRules"
 	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	characterexport "github.com/proyecto-dnd/backend/internal/characterExport"
+	characterhistory "github.com/proyecto-dnd/backend/internal/characterHistory"
+	characterresource "github.com/proyecto-dnd/backend/internal/characterResource"
+	characterstatus "github.com/proyecto-dnd/backend/internal/characterStatus"
 	charactertrade "github.com/proyecto-dnd/backend/internal/characterTrade"
 	characterXproficiency "github.com/proyecto-dnd/backend/internal/characterXProficiency"
+	characterXclass "github.com/proyecto-dnd/backend/internal/characterXClass"
 	characterXspell "github.com/proyecto-dnd/backend/internal/characterXSpell"
 	classXspell "github.com/proyecto-dnd/backend/internal/classXSpell"
 	"github.com/proyecto-dnd/backend/internal/dice_event"
+	"github.com/proyecto-dnd/backend/internal/equipment"
 	"github.com/proyecto-dnd/backend/internal/report"
 	tradeevent "github.com/proyecto-dnd/backend/internal/tradeEvent"
+	"github.com/proyecto-dnd/backend/internal/wallet"
 	"github.com/proyecto-dnd/backend/internal/ws"
 
 	// "github.com/proyecto-dnd/backend/internal/ws"
@@ -35,18 +47,27 @@ import (
 	"github.com/proyecto-dnd/backend/internal/friendship"
 	"github.com/proyecto-dnd/backend/internal/item"
 	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/journal"
 	"github.com/proyecto-dnd/backend/internal/proficiency"
 	"github.com/proyecto-dnd/backend/internal/proficiencyXclass.go"
+	"github.com/proyecto-dnd/backend/internal/progression"
 	"github.com/proyecto-dnd/backend/internal/race"
 	raceXproficiency "github.com/proyecto-dnd/backend/internal/raceXProficiency"
+	"github.com/proyecto-dnd/backend/internal/saving_throws"
+	"github.com/proyecto-dnd/backend/internal/seed"
 	"github.com/proyecto-dnd/backend/internal/session"
 	"github.com/proyecto-dnd/backend/internal/skill"
 	skillxcharacterdata "github.com/proyecto-dnd/backend/internal/skillXCharacterData"
 	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/spellbook"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+	"github.com/proyecto-dnd/backend/internal/subrace"
+	"github.com/proyecto-dnd/backend/internal/translation"
 	"github.com/proyecto-dnd/backend/internal/user"
 	"github.com/proyecto-dnd/backend/internal/user_campaign"
 	"github.com/proyecto-dnd/backend/internal/weapon"
 	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
+	"github.com/proyecto-dnd/backend/pkg/storage"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
@@ -60,6 +81,10 @@ var (
 	campaignService    campaign.CampaignService
 	campaignHandler    *handler.CampaignHandler
 
+	campaignRulesRepository campaignrules.RepositoryCampaignRules
+	campaignRulesService    campaignrules.ServiceCampaignRules
+	campaignRulesHandler    *handler.CampaignRulesHandler
+
 	sessionRepository session.SessionRepository
 	sessionService    session.SessionService
 	sessionHandler    *handler.SessionHandler
@@ -68,6 +93,20 @@ var (
 	classService    class.ClassService
 	classHandler    *handler.ClassHandler
 
+	subclassRepository    subclass.RepositorySubclass
+	subclassService       subclass.ServiceSubclass
+	subclassHandler       *handler.SubclassHandler
+
+	savingThrowsRepository saving_throws.SavingThrowsRepository
+	savingThrowsService    saving_throws.SavingThrowsService
+	savingThrowHandler     *handler.SavingThrowHandler
+	progressionRepository progression.RepositoryProgression
+	progressionService    progression.ServiceProgression
+	progressionHandler    *handler.ProgressionHandler
+	subraceRepository     subrace.RepositorySubrace
+	subraceService        subrace.ServiceSubrace
+	subraceHandler        *handler.SubraceHandler
+
 	proficiencyRepository            proficiency.RepositoryProficiency
 	proficiencyService               proficiency.ProficiencyService
 	proficiencyHandler               *handler.ProficiencyHandler
@@ -78,6 +117,9 @@ var (
 	backgroundXProficiencyService    backgroundXproficiency.BackgroundXProficiencyService
 	backgroundXProficiencyHandler    *handler.BackgroundXProficiencyHandler
 
+	backgroundXSkillsRepository backgroundxSkill.RepositoryBackgroundXSkills
+	backgroundXSkillsService    backgroundxSkill.ServiceBackgroundXSkills
+
 	userCampaignRepository user_campaign.UserCampaignRepository
 	userCampaignService    user_campaign.UserCampaignService
 	userCampaignHandler    *handler.UserCampaignHandler
@@ -117,10 +159,15 @@ var (
 	raceXProficiencyService    raceXproficiency.RaceXProficiencyService
 	raceXProficiencyHandler    *handler.RaceXProficiencyHandler
 
+	characterXClassRepository characterXclass.RepositoryCharacterXClass
+	characterXClassService    characterXclass.ServiceCharacterXClass
+
 	characterXSpellRepository characterXspell.RepositoryCharacterXSpell
 	characterXSpellService    characterXspell.ServiceCharacterXSpell
 	characterXSpellHandler    *handler.CharacterXSpellHandler
 
+	spellbookService spellbook.ServiceSpellbook
+
 	attackEventRepository attackEvent.AttackEventRepository
 	attackEventService    attackEvent.AttackEventService
 	attackEventHandler    *handler.AttackEventHandler
@@ -160,6 +207,34 @@ var (
 	characterDataService    characterdata.ServiceCharacterData
 	characterDataHandler    *handler.CharacterHandler
 
+	characterExportService characterexport.ServiceCharacterExport
+	characterExportHandler *handler.CharacterExportHandler
+
+	characterHistoryRepository characterhistory.RepositoryCharacterHistory
+	characterHistoryService    characterhistory.ServiceCharacterHistory
+	characterHistoryHandler    *handler.CharacterHistoryHandler
+
+	characterStatusRepository characterstatus.RepositoryCharacterStatus
+	characterStatusService    characterstatus.ServiceCharacterStatus
+	characterStatusHandler    *handler.CharacterStatusHandler
+
+	characterResourceRepository characterresource.RepositoryCharacterResource
+	characterResourceService    characterresource.ServiceCharacterResource
+	characterResourceHandler    *handler.CharacterResourceHandler
+
+	journalRepository journal.RepositoryJournal
+	journalService    journal.ServiceJournal
+	journalHandler    *handler.JournalHandler
+
+	imageHandler *handler.ImageHandler
+
+	walletRepository wallet.RepositoryWallet
+	walletService    wallet.ServiceWallet
+	walletHandler    *handler.WalletHandler
+
+	equipmentService equipment.ServiceEquipment
+	equipmentHandler *handler.EquipmentHandler
+
 	characterXAttackEventRepository characterXAttackEvent.CharacterXAttackEventRepository
 	characterXAttackEventService    characterXAttackEvent.CharacterXAttackEventService
 	characterXAttackEventHandler    *handler.CharacterXAttackEventHandler
@@ -172,6 +247,18 @@ var (
 	backgroundService    background.BackgroundService
 	backgroundHandler    *handler.BackgroundHandler
 
+	translationRepository translation.RepositoryTranslation
+	translationService    translation.ServiceTranslation
+	translationHandler    *handler.TranslationHandler
+
+	bulkRepository bulk.RepositoryBulk
+	bulkService    bulk.ServiceBulk
+	bulkHandler    *handler.BulkHandler
+
+	seedRepository seed.RepositorySeed
+	seedService    seed.ServiceSeed
+	seedHandler    *handler.SeedHandler
+
 	reportGenerator *report.ReportGenerator
 	reportHandler   *handler.ReportHandler
 )
@@ -188,39 +275,31 @@ type router struct {
 	hub         *ws.Hub
 }
 
-func NewRouter(engine *gin.Engine, db *sql.DB, firebaseApp *firebase.App) Router {
+func NewRouter(engine *gin.Engine, db *sql.DB, firebaseApp *firebase.App, store storage.Storage) Router {
 	userFirebaseRepository = user.NewUserFirebaseRepository(firebaseApp, db)
 	userFirebaseService = user.NewServiceUser(userFirebaseRepository)
 	userFirebaseHandler = handler.NewUserHandler(&userFirebaseService)
 
 	itemRepository = item.NewItemRepository(db)
 	itemService = item.NewItemService(itemRepository)
-	itemHandler = handler.NewItemHandler(&itemService)
 	itemXCharacterDataRepository = itemxcharacterdata.NewItemXCharacterDataSqlRepository(db)
 	itemXCharacterDataService = itemxcharacterdata.NewItemXCharacterDataService(itemXCharacterDataRepository, itemRepository)
-	itemXCharacterDataHandler = handler.NewItemXCharacterDataHandler(&itemXCharacterDataService)
 
 	weaponRepository = weapon.NewWeaponRepository(db)
 	weaponService = weapon.NewWeaponService(weaponRepository)
-	weaponHandler = handler.NewWeaponHandler(&weaponService)
 	weaponXCharacterDataRepository = weaponxcharacterdata.NewWeaponXCharacterDataSqlRepository(db)
 	weaponXCharacterDataService = weaponxcharacterdata.NewWeaponXCharacterDataService(weaponXCharacterDataRepository, weaponRepository)
-	weaponXCharacterDataHandler = handler.NewWeaponXCharacterDataHandler(&weaponXCharacterDataService)
 
 	armorRepository = armor.NewArmorRepository(db)
 	armorService = armor.NewArmorService(armorRepository)
-	armorHandler = handler.NewArmorHandler(&armorService)
 	armorXCharacterDataRepository = armorXCharacterData.NewArmorXCharacterDataSqlRepository(db)
 	armorXCharacterDataService = armorXCharacterData.NewServiceArmorXCharacterData(armorXCharacterDataRepository, armorService)
-	armorXCharacterDataHandler = handler.NewArmorXCharacterDataHandler(&armorXCharacterDataService) // TO DO Check if armorXCharacterDataHandler works correctly, it was done fast to compile the rest
 
 	classRepository = class.NewClassRepository(db)
 	classService = class.NewClassService(classRepository)
-	classHandler = handler.NewClassHandler(&classService)
 
 	raceRepository = race.NewRaceRepository(db)
 	raceService = race.NewRaceService(raceRepository)
-	raceHandler = handler.NewRaceHandler(raceService)
 	raceXProficiencyRepository = raceXproficiency.NewRaceXProficiencyRepository(db)
 	raceXProficiencyService = raceXproficiency.NewRaceXProficiencyService(raceXProficiencyRepository)
 	raceXProficiencyHandler = handler.NewRaceXProficiencyHandler(&raceXProficiencyService)
@@ -250,20 +329,16 @@ func NewRouter(engine *gin.Engine, db *sql.DB, firebaseApp *firebase.App) Router
 
 	featureRepository = feature.NewFeatureRepository(db)
 	featureService = feature.NewFeatureService(featureRepository)
-	featureHandler = handler.NewFeatureHandler(&featureService)
 	featureXCharacterDataRepository = character_feature.NewCharacterFeatureRepository(db)
 	featureXCharacterDataService = character_feature.NewCharacterFeatureService(featureXCharacterDataRepository)
-	featureXCharacterDataHandler = *handler.NewCharacterFeatureHandler(&featureXCharacterDataService)
 
 	spellRepository = spell.NewSpellRepository(db)
 	spellService = spell.NewSpellService(spellRepository)
-	spellHandler = handler.NewSpellHandler(&spellService)
 	classXSpellRepository = classXspell.NewClassXSpellRepository(db)
 	classXSpellService = classXspell.NewClassXSpelService(classXSpellRepository)
 	classXSpellHandler = handler.NewClassXSpellHandler(&classXSpellService)
 	characterXSpellRepository = characterXspell.NewCharacterXSpellRepository(db)
 	characterXSpellService = characterXspell.NewCharacterXSpellService(characterXSpellRepository)
-	characterXSpellHandler = handler.NewCharacterXSpellHandler(&characterXSpellService)
 
 	sessionRepository = session.NewSessionRepository(db)
 	sessionService = session.NewSessionService(sessionRepository)
@@ -272,45 +347,142 @@ func NewRouter(engine *gin.Engine, db *sql.DB, firebaseApp *firebase.App) Router
 	backgroundXProficiencyRepository = backgroundXproficiency.NewBackgroundXProficiencyRepository(db)
 	backgroundXProficiencyService = backgroundXproficiency.NewBackgroundXProficiencyService(backgroundXProficiencyRepository)
 	backgroundXProficiencyHandler = handler.NewBackgroundXProficiencyHandler(backgroundXProficiencyService)
+	backgroundXSkillsRepository = backgroundxSkill.NewBackgroundXSkillsRepository(db)
+	backgroundXSkillsService = backgroundxSkill.NewBackgroundXSkillsService(backgroundXSkillsRepository)
 	backgroundRepository = background.NewBackgroundRepository(db)
-	backgroundService = background.NewBackgroundService(backgroundRepository)
-	backgroundHandler = handler.NewBackgroundHandler(backgroundService)
+	backgroundService = background.NewBackgroundService(backgroundRepository, backgroundXSkillsService, backgroundXProficiencyService, skillService, proficiencyService, featureService, itemService, weaponService, armorService)
 
 	characterFeatureRepository = character_feature.NewCharacterFeatureRepository(db)
 	characterFeatureService = character_feature.NewCharacterFeatureService(characterFeatureRepository)
-	characterFeatureHandler = handler.NewCharacterFeatureHandler(&characterFeatureService)
 
 	characterTradeRepository = charactertrade.NewCharacterTradeMySqlRepository(db)
 	characterTradeService = charactertrade.NewCharacterTradeService(characterTradeRepository)
+	walletRepository = wallet.NewWalletRepository(db)
+	walletService = wallet.NewWalletService(walletRepository)
+
+	journalRepository = journal.NewJournalRepository(db)
+	journalService = journal.NewJournalService(journalRepository, userFirebaseService, store)
+	journalHandler = handler.NewJournalHandler(&journalService)
+	walletHandler = handler.NewWalletHandler(&walletService)
+
 	tradeEventRepository = tradeevent.NewTradeEventMySqlRepository(db)
-	tradeEventService = tradeevent.NewTradeEventService(tradeEventRepository, characterTradeService, weaponXCharacterDataService, armorXCharacterDataService, itemXCharacterDataService)
-	tradeEventHandler = handler.NewTradeEventHandler(&tradeEventService)
+	tradeEventService = tradeevent.NewTradeEventService(tradeEventRepository, characterTradeService, itemXCharacterDataService, walletService)
 
 	attackEventRepository = attackEvent.NewAttackEventRepository(db)
 	attackEventService = attackEvent.NewAttackEventService(attackEventRepository)
-	attackEventHandler = handler.NewAttackEventHandler(&attackEventService)
 
 	diceEventRepository = dice_event.NewDiceEventRepository(db)
 	diceEventService = dice_event.NewDiceEventService(diceEventRepository)
-	diceEventHandler = handler.NewDiceEventHandler(diceEventService)
+
+	characterXClassRepository = characterXclass.NewCharacterXClassRepository(db)
+	characterXClassService = characterXclass.NewCharacterXClassService(characterXClassRepository)
+
+	campaignRulesRepository = campaignrules.NewCampaignRulesRepository(db)
+	campaignRulesService = campaignrules.NewCampaignRulesService(campaignRulesRepository, userFirebaseService)
+	campaignRulesHandler = handler.NewCampaignRulesHandler(&campaignRulesService)
+
+	subclassRepository = subclass.NewSubclassRepository(db)
+	subclassService = subclass.NewSubclassService(subclassRepository, classService)
+	progressionRepository = progression.NewProgressionRepository(db)
+	progressionService = progression.NewProgressionService(progressionRepository, classService, subclassService, featureService)
+	subraceRepository = subrace.NewSubraceRepository(db)
+	subraceService = subrace.NewSubraceService(subraceRepository, raceService, proficiencyService, featureService)
+	savingThrowsRepository = saving_throws.NewRepositorySqlSavingThrows(db)
+	savingThrowsService = saving_throws.NewSavingThrowsService(savingThrowsRepository)
 
 	characterDataRepository = characterdata.NewCharacterDataRepository(db)
-	characterDataService = characterdata.NewServiceCharacterData(characterDataRepository, itemXCharacterDataService, weaponXCharacterDataService, armorXCharacterDataService, skillService, skillXCharacterDataService, featureService, featureXCharacterDataService, spellService, characterXSpellService, proficiencyService, characterXProficiencyService, tradeEventService, attackEventService, diceEventService, userFirebaseService)
-	characterDataHandler = handler.NewCharacterHandler(&characterDataService)
+	characterDataService = characterdata.NewServiceCharacterData(characterDataRepository, itemXCharacterDataService, weaponXCharacterDataService, armorXCharacterDataService, skillService, skillXCharacterDataService, featureService, featureXCharacterDataService, spellService, characterXSpellService, proficiencyService, characterXProficiencyService, tradeEventService, attackEventService, diceEventService, userFirebaseService, characterXClassService, classService, campaignRulesService, walletService, journalService, subclassService, progressionService, subraceService, backgroundService, savingThrowsService)
+
+	// Dice events of saving throws get the modifier from the character sheet.
+	diceEventService = dice_event.WithStatModifiers(diceEventService, characterDataService)
+	diceEventHandler = handler.NewDiceEventHandler(diceEventService)
+
+	equipmentService = equipment.NewEquipmentService(weaponXCharacterDataService, armorXCharacterDataService, itemXCharacterDataService)
+	equipmentHandler = handler.NewEquipmentHandler(&equipmentService, &characterDataService)
+	tradeEventHandler = handler.NewTradeEventHandler(&tradeEventService, &characterDataService)
+
+	hub := ws.NewHub(tradeEventService, attackEventService, diceEventService)
+	go hub.Run()
+
+	characterResourceRepository = characterresource.NewCharacterResourceRepository(db)
+	characterResourceService = characterresource.NewCharacterResourceService(characterResourceRepository, characterDataService)
+	characterResourceHandler = handler.NewCharacterResourceHandler(&characterResourceService)
+
+	characterStatusRepository = characterstatus.NewCharacterStatusRepository(db)
+	characterStatusService = characterstatus.NewCharacterStatusService(characterStatusRepository, characterDataService, diceEventService, characterResourceService, itemXCharacterDataService, hub)
+	characterStatusHandler = handler.NewCharacterStatusHandler(&characterStatusService)
+	// Services built from here on delete the play state with the character.
+	characterDataService = characterstatus.WithStateCleanup(characterDataService, characterStatusService)
+
+	characterHistoryRepository = characterhistory.NewCharacterHistoryRepository(db)
+	characterHistoryService = characterhistory.NewCharacterHistoryService(characterHistoryRepository, characterDataService, sessionService, itemXCharacterDataService, weaponXCharacterDataService, armorXCharacterDataService, characterXSpellService, featureXCharacterDataService, characterXProficiencyService, skillXCharacterDataService, walletService, characterStatusService, characterResourceService)
+	characterHistoryHandler = handler.NewCharacterHistoryHandler(&characterHistoryService, &userFirebaseService)
+	// Attacks and rolls sent over the session socket are versioned as well.
+	hub.UseHistory(characterHistoryService)
+
+	// Spell attacks expend the caster's spell slot, whether created through
+	// the handler or the session socket.
+	attackEventService = characterstatus.WithSpellSlots(attackEventService, characterStatusService, spellService)
+	hub.UseAttackEventService(attackEventService)
+	attackEventHandler = handler.NewAttackEventHandler(&attackEventService)
 
 	campaignRepository = campaign.NewCampaignRepository(db)
 	campaignService = campaign.NewCampaignService(campaignRepository, sessionService, userCampaignService, characterDataService, userFirebaseService)
 	campaignHandler = handler.NewCampaignHandler(&campaignService, &userFirebaseService)
+	imageHandler = handler.NewImageHandler(store, &characterDataService, &campaignService, &userFirebaseService)
+
+	characterExportService = characterexport.NewCharacterExportService(characterDataService, raceService, classService, backgroundService, itemService, weaponService, armorService, spellService, featureService, proficiencyService, skillService, itemXCharacterDataService, weaponXCharacterDataService, armorXCharacterDataService, characterXSpellService, featureXCharacterDataService, characterXProficiencyService, skillXCharacterDataService, userFirebaseService, journalService, subraceService, subclassService, walletService, characterResourceService)
+	characterExportHandler = handler.NewCharacterExportHandler(&characterExportService, &campaignService, &userFirebaseService)
+
+	translationRepository = translation.NewTranslationRepository(db)
+	translationService = translation.NewTranslationService(translationRepository, spell.CatalogTable, race.CatalogTable, class.CatalogTable, feature.CatalogTable, background.CatalogTable, armor.CatalogTable, weapon.CatalogTable, item.CatalogTable)
+	translationHandler = handler.NewTranslationHandler(&translationService, &campaignService, &userFirebaseService)
+
+	// Handlers linking catalog entries to characters check the user can see
+	// the campaign homebrew.
+	characterDataHandler = handler.NewCharacterHandler(&characterDataService, &userCampaignService, raceService, &classService, backgroundService, &campaignService, &userFirebaseService, &translationService)
+	featureXCharacterDataHandler = *handler.NewCharacterFeatureHandler(&featureXCharacterDataService, &featureService, &campaignService, &userFirebaseService)
+	characterFeatureHandler = handler.NewCharacterFeatureHandler(&characterFeatureService, &featureService, &campaignService, &userFirebaseService)
+	itemXCharacterDataHandler = handler.NewItemXCharacterDataHandler(&itemXCharacterDataService, &characterDataService, &equipmentService, &itemService, &campaignService, &userFirebaseService)
+	weaponXCharacterDataHandler = handler.NewWeaponXCharacterDataHandler(&weaponXCharacterDataService, &characterDataService, &equipmentService, &weaponService, &campaignService, &userFirebaseService)
+	armorXCharacterDataHandler = handler.NewArmorXCharacterDataHandler(&armorXCharacterDataService, &characterDataService, &equipmentService, &armorService, &campaignService, &userFirebaseService) // TO DO Check if armorXCharacterDataHandler works correctly, it was done fast to compile the rest
+
+	// ADMIN_USER_IDS is a comma separated list of the users that may seed the
+	// database and manage the subclasses and progression of generic classes.
+	adminUserIds := strings.Split(os.Getenv("ADMIN_USER_IDS"), ",")
+
+	// Catalog handlers check campaign homebrew against the campaign service.
+	itemHandler = handler.NewItemHandler(&itemService, &campaignService, &userFirebaseService, &translationService)
+	weaponHandler = handler.NewWeaponHandler(&weaponService, &campaignService, &userFirebaseService, &translationService)
+	armorHandler = handler.NewArmorHandler(&armorService, &campaignService, &userFirebaseService, &translationService)
+	classHandler = handler.NewClassHandler(&classService, &campaignService, &userFirebaseService, &translationService)
+	subclassHandler = handler.NewSubclassHandler(&subclassService, &progressionService, &classService, &campaignService, &userFirebaseService, adminUserIds)
+	savingThrowHandler = handler.NewSavingThrowHandler(&savingThrowsService, &classService, &campaignService, &userFirebaseService, adminUserIds)
+	progressionHandler = handler.NewProgressionHandler(&progressionService, &classService, &campaignService, &userFirebaseService, adminUserIds)
+	subraceHandler = handler.NewSubraceHandler(&subraceService, raceService, &campaignService, &userFirebaseService)
+	raceHandler = handler.NewRaceHandler(raceService, &campaignService, &userFirebaseService, &translationService)
+	featureHandler = handler.NewFeatureHandler(&featureService, &campaignService, &userFirebaseService, &translationService)
+	spellHandler = handler.NewSpellHandler(&spellService, &campaignService, &userFirebaseService, &translationService)
+	backgroundHandler = handler.NewBackgroundHandler(backgroundService, &campaignService, &userFirebaseService, &translationService)
+
+	bulkRepository = bulk.NewBulkRepository(db)
+	bulkService = bulk.NewBulkService(bulkRepository, translationService, spellService, raceService, classService, featureService, backgroundService, armorService, weaponService, itemService)
+	bulkHandler = handler.NewBulkHandler(&bulkService, &campaignService, &userFirebaseService)
+
+	seedRepository = seed.NewSeedRepository(db)
+	seedService = seed.NewSeedService(seedRepository)
+	seedHandler = handler.NewSeedHandler(&seedService, &userFirebaseService, adminUserIds)
+
+	spellbookService = spellbook.NewSpellbookService(characterXSpellService, characterDataService, spellService, campaignRulesService)
+	characterXSpellHandler = handler.NewCharacterXSpellHandler(&characterXSpellService, &spellbookService, &spellService, &campaignService, &userFirebaseService)
 
 	characterXAttackEventRepository = characterXAttackEvent.NewCharacterXAttackEventRepository(db)
-	characterXAttackEventService = characterXAttackEvent.NewCharacterXAttackEventService(characterXAttackEventRepository)
+	characterXAttackEventService = characterXAttackEvent.NewCharacterXAttackEventService(characterXAttackEventRepository, attackEventService, characterStatusService)
 	characterXAttackEventHandler = handler.NewCharacterXAttackEventHandler(characterXAttackEventService)
 
-	reportGenerator = report.NewReportGenerator(tradeEventService, attackEventService, diceEventService, characterDataService)
-	reportHandler = handler.NewReportHandler(reportGenerator)
+	reportGenerator = report.NewReportGenerator(tradeEventService, attackEventService, diceEventService, characterDataService, translationService)
+	reportHandler = handler.NewReportHandler(reportGenerator, &translationService, &userFirebaseService)
 
-	hub := ws.NewHub(tradeEventService, attackEventService, diceEventService)
-	go hub.Run()
 	return &router{
 		engine:      engine,
 		db:          db,
@@ -355,6 +527,8 @@ func (r *router) MapRoutes() {
 	r.buildWebsocketRoutes()
 	r.buildReportRoutes()
 	r.buildBackgroundRoutes()
+	r.buildJournalRoutes()
+	r.buildAdminRoutes()
 	// TODO Add other builders here	and write their functions
 
 }
@@ -378,9 +552,12 @@ func (r *router) buildUserRoutes() {
 		userGroup.GET("/:id", userFirebaseHandler.HandlerGetById())
 		userGroup.GET("/checkSub", userFirebaseHandler.HandlerCheckSubscriptionExpDate())
 		userGroup.GET("/jwt", userFirebaseHandler.HandlerGetJwtInfo())
+		userGroup.GET("/locale", userFirebaseHandler.HandlerGetLocale())
+		userGroup.PUT("/locale", userFirebaseHandler.HandlerSetLocale())
 		userGroup.POST("/sendEmailVerification", userFirebaseHandler.HandlerSendEmailVerification())
 		userGroup.PUT("/:id", userFirebaseHandler.HandlerUpdate())
 		userGroup.PATCH("/", userFirebaseHandler.HandlerPatch())
+		userGroup.POST("/avatar", imageHandler.HandlerUserAvatar())
 		userGroup.DELETE("/:id", userFirebaseHandler.HandlerDelete())
 	}
 }
@@ -388,7 +565,7 @@ func (r *router) buildUserRoutes() {
 func (r *router) buildAttackEventRoutes() {
 	eventGroup := r.routerGroup.Group("/attackevent")
 	{
-		eventGroup.POST("", attackEventHandler.HandlerCreate())
+		eventGroup.POST("", characterHistoryHandler.Track("attack", handler.CharacterFromBody("event_protagonist_id")), attackEventHandler.HandlerCreate())
 		eventGroup.GET("", attackEventHandler.HandlerGetAll())
 		eventGroup.GET("/:id", attackEventHandler.HandlerGetById())
 		eventGroup.GET("/session/:id", attackEventHandler.HandlerGetBySessionId())
@@ -409,6 +586,9 @@ func (r *router) buildCampaignRoutes() {
 		campaignGroup.GET("/user", campaignHandler.HandlerGetByUserId())
 		campaignGroup.PUT("/:id", campaignHandler.HandlerUpdate())
 		campaignGroup.DELETE("/:id", campaignHandler.HandlerDelete())
+		campaignGroup.GET("/:id/rules", campaignRulesHandler.HandlerGet())
+		campaignGroup.PUT("/:id/rules", campaignRulesHandler.HandlerUpdate())
+		campaignGroup.POST("/:id/image", imageHandler.HandlerCampaignImage())
 	}
 }
 
@@ -427,11 +607,40 @@ func (r *router) buildSessionRoutes() {
 func (r *router) buildClassRoutes() {
 	classGroup := r.routerGroup.Group("/class")
 	{
+		classGroup.POST("/import", bulkHandler.HandlerImport("class"))
+		classGroup.GET("/export", bulkHandler.HandlerExport("class"))
 		classGroup.POST("", classHandler.HandlerCreate())
 		classGroup.GET("", classHandler.HandlerGetAll())
 		classGroup.GET("/:id", classHandler.HandlerGetById())
 		classGroup.PUT("/:id", classHandler.HandlerUpdate())
 		classGroup.DELETE("/:id", classHandler.HandlerDelete())
+		classGroup.GET("/:id/translation", translationHandler.HandlerGet("class"))
+		classGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("class"))
+		classGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("class"))
+		classGroup.GET("/:id/subclass", subclassHandler.HandlerGetByClassId())
+		classGroup.POST("/:id/subclass", subclassHandler.HandlerCreate())
+		classGroup.GET("/:id/progression", progressionHandler.HandlerGetByClassId())
+		classGroup.POST("/:id/progression", progressionHandler.HandlerCreate())
+		classGroup.GET("/:id/saving_throw", savingThrowHandler.HandlerGetByClassId())
+	}
+	savingThrowGroup := r.routerGroup.Group("/saving_throw")
+	{
+		savingThrowGroup.POST("", savingThrowHandler.HandlerCreate())
+		savingThrowGroup.GET("", savingThrowHandler.HandlerGetAll())
+		savingThrowGroup.GET("/:id", savingThrowHandler.HandlerGetById())
+		savingThrowGroup.PUT("/:id", savingThrowHandler.HandlerUpdate())
+		savingThrowGroup.DELETE("/:id", savingThrowHandler.HandlerDelete())
+	}
+	subclassGroup := r.routerGroup.Group("/subclass")
+	{
+		subclassGroup.GET("/:id", subclassHandler.HandlerGetById())
+		subclassGroup.PUT("/:id", subclassHandler.HandlerUpdate())
+		subclassGroup.DELETE("/:id", subclassHandler.HandlerDelete())
+	}
+	progressionGroup := r.routerGroup.Group("/progression")
+	{
+		progressionGroup.PUT("/:id", progressionHandler.HandlerUpdate())
+		progressionGroup.DELETE("/:id", progressionHandler.HandlerDelete())
 	}
 }
 
@@ -450,8 +659,24 @@ func (r *router) buildProficiencyRoutes() {
 func (r *router) buildBackgroundRoutes() {
 	backgroundGroup := r.routerGroup.Group("/background")
 	{
+		backgroundGroup.POST("/import", bulkHandler.HandlerImport("background"))
+		backgroundGroup.GET("/export", bulkHandler.HandlerExport("background"))
+		backgroundGroup.POST("", backgroundHandler.HandlerCreate())
 		backgroundGroup.GET("", backgroundHandler.HandlerGetAll())
 		backgroundGroup.GET("/:id", backgroundHandler.HandlerGetById())
+		backgroundGroup.PUT("/:id", backgroundHandler.HandlerUpdate())
+		backgroundGroup.DELETE("/:id", backgroundHandler.HandlerDelete())
+		backgroundGroup.GET("/:id/translation", translationHandler.HandlerGet("background"))
+		backgroundGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("background"))
+		backgroundGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("background"))
+		backgroundGroup.GET("/:id/grants", backgroundHandler.HandlerGetGrants())
+		backgroundGroup.POST("/:id/skill/:skillid", backgroundHandler.HandlerAddSkill())
+		backgroundGroup.DELETE("/:id/skill/:skillid", backgroundHandler.HandlerRemoveSkill())
+		backgroundGroup.POST("/:id/proficiency/:proficiencyid", backgroundHandler.HandlerAddProficiency())
+		backgroundGroup.DELETE("/:id/proficiency/:proficiencyid", backgroundHandler.HandlerRemoveProficiency())
+		backgroundGroup.PUT("/:id/feature", backgroundHandler.HandlerSetFeature())
+		backgroundGroup.POST("/:id/equipment", backgroundHandler.HandlerAddEquipment())
+		backgroundGroup.DELETE("/:id/equipment/:equipmentid", backgroundHandler.HandlerRemoveEquipment())
 	}
 }
 
@@ -492,18 +717,25 @@ func (r *router) buildFriendshipRoutes() {
 func (r *router) buildFeatureRoutes() {
 	featureGroup := r.routerGroup.Group("/feature")
 	{
-		featureGroup.POST("", featureHandler.HandlerCreate())
+		featureGroup.POST("/import", bulkHandler.HandlerImport("feature"))
+		featureGroup.GET("/export", bulkHandler.HandlerExport("feature"))
+		featureGroup.POST("", characterResourceHandler.Sync(handler.CharacterFromBody("character_id")), featureHandler.HandlerCreate())
 		featureGroup.GET("", featureHandler.HandlerGetAll())
 		featureGroup.GET("/character/:id", featureHandler.HandlerGetAllFeaturesByCharacterId())
 		featureGroup.GET("/:id", featureHandler.HandlerGetById())
-		featureGroup.PUT("/:id", featureHandler.HandlerUpdate())
-		featureGroup.DELETE("/:id", featureHandler.HandlerDelete())
+		featureGroup.PUT("/:id", characterResourceHandler.Sync(charactersWithFeature), featureHandler.HandlerUpdate())
+		featureGroup.DELETE("/:id", characterResourceHandler.Sync(charactersWithFeature), featureHandler.HandlerDelete())
+		featureGroup.GET("/:id/translation", translationHandler.HandlerGet("feature"))
+		featureGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("feature"))
+		featureGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("feature"))
 	}
 }
 
 func (r *router) buildSpellRoutes() {
 	spellGroup := r.routerGroup.Group("/spell")
 	{
+		spellGroup.POST("/import", bulkHandler.HandlerImport("spell"))
+		spellGroup.GET("/export", bulkHandler.HandlerExport("spell"))
 		spellGroup.POST("", spellHandler.HandlerCreate())
 		spellGroup.GET("", spellHandler.HandlergetAll())
 		spellGroup.GET("/:id", spellHandler.HandlerGetById())
@@ -511,6 +743,9 @@ func (r *router) buildSpellRoutes() {
 		spellGroup.GET("/class/:id", spellHandler.HandlerGetByClassId())
 		spellGroup.PUT("/:id", spellHandler.HandlerUpdate())
 		spellGroup.DELETE("/:id", spellHandler.HandlerDelete())
+		spellGroup.GET("/:id/translation", translationHandler.HandlerGet("spell"))
+		spellGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("spell"))
+		spellGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("spell"))
 	}
 }
 
@@ -541,28 +776,34 @@ func (r *router) buildBackgroundXProficiencyRoutes() {
 func (r *router) buildCharacterXSpellRoutes() {
 	characterXSpellGroup := r.routerGroup.Group("/characterXspell")
 	{
-		characterXSpellGroup.POST("", characterXSpellHandler.HandlerCreate())
-		characterXSpellGroup.DELETE("/:id", characterXSpellHandler.HandlerDelete())
-		characterXSpellGroup.DELETE("/delete", characterXSpellHandler.HandlerDeleteParams())
+		characterXSpellGroup.POST("", characterHistoryHandler.Track("spell added", handler.CharacterFromBody("character_id")), characterXSpellHandler.HandlerCreate())
+		characterXSpellGroup.PUT("/:id", characterHistoryHandler.Track("spell preparation changed", handler.CharacterFromLink("id", characterOfSpellLink)), characterXSpellHandler.HandlerUpdatePreparation())
+		characterXSpellGroup.DELETE("/:id", characterHistoryHandler.Track("spell removed", handler.CharacterFromLink("id", characterOfSpellLink)), characterXSpellHandler.HandlerDelete())
+		characterXSpellGroup.DELETE("/delete", characterHistoryHandler.Track("spell removed", handler.CharacterFromQuery("characterId")), characterXSpellHandler.HandlerDeleteParams())
 	}
 }
 
 func (r *router) buildCharacterFeatureRoutes() {
 	characterFeatureGroup := r.routerGroup.Group("/character_feature")
 	{
-		characterFeatureGroup.POST("", characterFeatureHandler.HandlerCreate())
+		characterFeatureGroup.POST("", characterHistoryHandler.Track("feature added", handler.CharacterFromBody("character_id")), characterResourceHandler.Sync(handler.CharacterFromBody("character_id")), characterFeatureHandler.HandlerCreate())
 		characterFeatureGroup.GET("", characterFeatureHandler.HandlerGetAll())
 		characterFeatureGroup.GET("/feature/:id", characterFeatureHandler.HandlerGetByFeatureId())
 		characterFeatureGroup.GET("/character/:id", characterFeatureHandler.HandlerGetByCharacterId())
-		characterFeatureGroup.DELETE("/", characterFeatureHandler.HandlerDeleteQueries())
+		characterFeatureGroup.DELETE("/", characterHistoryHandler.Track("feature removed", handler.CharacterFromQuery("idCharacter")), characterResourceHandler.Sync(handler.CharacterFromQuery("idCharacter")), characterFeatureHandler.HandlerDeleteQueries())
 	}
 }
 
 func (r *router) buildItemRoutes() {
 	itemGroup := r.routerGroup.Group("/item")
 	{
+		itemGroup.POST("/import", bulkHandler.HandlerImport("item"))
+		itemGroup.GET("/export", bulkHandler.HandlerExport("item"))
 		itemGroup.POST("", itemHandler.HandlerCreate())
 		itemGroup.DELETE("/:id", itemHandler.HandlerDelete())
+		itemGroup.GET("/:id/translation", translationHandler.HandlerGet("item"))
+		itemGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("item"))
+		itemGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("item"))
 		itemGroup.GET("", itemHandler.HandlerGetAll())
 		itemGroup.GET("/generic", itemHandler.HandlerGetAllGeneric())
 		itemGroup.GET("/:id", itemHandler.HandlerGetById())
@@ -574,22 +815,31 @@ func (r *router) buildItemRoutes() {
 func (r *router) buildItemXCharacterDataRoutes() {
 	itemXCharacterDataGroup := r.routerGroup.Group("/item_character")
 	{
-		itemXCharacterDataGroup.POST("", itemXCharacterDataHandler.HandlerCreate())
-		itemXCharacterDataGroup.DELETE("/:id", itemXCharacterDataHandler.HandlerDelete())
-		itemXCharacterDataGroup.DELETE("/character/:id", itemXCharacterDataHandler.HandlerDeleteByCharacterId())
+		itemXCharacterDataGroup.POST("", characterHistoryHandler.Track("item added", handler.CharacterFromBody("character_data_id")), itemXCharacterDataHandler.HandlerCreate())
+		itemXCharacterDataGroup.DELETE("/:id", characterHistoryHandler.Track("item removed", handler.CharacterFromLink("id", characterOfItemLink)), itemXCharacterDataHandler.HandlerDelete())
+		itemXCharacterDataGroup.DELETE("/character/:id", characterHistoryHandler.Track("items cleared", handler.CharacterFromParam("id")), itemXCharacterDataHandler.HandlerDeleteByCharacterId())
 		itemXCharacterDataGroup.GET("", itemXCharacterDataHandler.HandlerGetAll())
 		itemXCharacterDataGroup.GET("/:id", itemXCharacterDataHandler.HandlerGetById())
 		itemXCharacterDataGroup.GET("/character/:id", itemXCharacterDataHandler.HandlerGetByCharacterDataId())
-		itemXCharacterDataGroup.PUT("/:id", itemXCharacterDataHandler.HandlerUpdate())
+		itemXCharacterDataGroup.PUT("/:id", characterHistoryHandler.Track("item updated", handler.CharacterFromLink("id", characterOfItemLink)), itemXCharacterDataHandler.HandlerUpdate())
+		itemXCharacterDataGroup.POST("/:id/attune", characterHistoryHandler.Track("item attuned", handler.CharacterFromLink("id", characterOfItemLink)), itemXCharacterDataHandler.HandlerAttune())
+		itemXCharacterDataGroup.DELETE("/:id/attune", characterHistoryHandler.Track("item unattuned", handler.CharacterFromLink("id", characterOfItemLink)), itemXCharacterDataHandler.HandlerUnattune())
+		itemXCharacterDataGroup.POST("/:id/charges/spend", characterHistoryHandler.Track("item charges spent", handler.CharacterFromLink("id", characterOfItemLink)), itemXCharacterDataHandler.HandlerSpendCharges())
+		itemXCharacterDataGroup.POST("/:id/charges/restore", characterHistoryHandler.Track("item charges restored", handler.CharacterFromLink("id", characterOfItemLink)), itemXCharacterDataHandler.HandlerRestoreCharges())
 	}
 }
 
 func (r *router) buildWeaponRoutes() {
 	weaponGroup := r.routerGroup.Group("/weapon")
 	{
+		weaponGroup.POST("/import", bulkHandler.HandlerImport("weapon"))
+		weaponGroup.GET("/export", bulkHandler.HandlerExport("weapon"))
 		weaponGroup.POST("", weaponHandler.HandlerCreate())
 		weaponGroup.GET("/generic", weaponHandler.HandlerGetAllGeneric())
 		weaponGroup.DELETE("/:id", weaponHandler.HandlerDelete())
+		weaponGroup.GET("/:id/translation", translationHandler.HandlerGet("weapon"))
+		weaponGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("weapon"))
+		weaponGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("weapon"))
 		weaponGroup.GET("", weaponHandler.HandlerGetAll())
 		weaponGroup.GET("/:id", weaponHandler.HandlerGetById())
 		weaponGroup.GET("/campaign/:id", weaponHandler.HandlerGetByCampaignId())
@@ -600,21 +850,21 @@ func (r *router) buildWeaponRoutes() {
 func (r *router) buildWeaponXCharacterDataRoutes() {
 	weaponXCharacterDataGroup := r.routerGroup.Group("/weapon_character")
 	{
-		weaponXCharacterDataGroup.POST("", weaponXCharacterDataHandler.HandlerCreate())
-		weaponXCharacterDataGroup.DELETE("/:id", weaponXCharacterDataHandler.HandlerDelete())
-		weaponXCharacterDataGroup.DELETE("/character/:id", weaponXCharacterDataHandler.HandlerDeleteByCharacterDataId())
+		weaponXCharacterDataGroup.POST("", characterHistoryHandler.Track("weapon added", handler.CharacterFromBody("character_data_id")), weaponXCharacterDataHandler.HandlerCreate())
+		weaponXCharacterDataGroup.DELETE("/:id", characterHistoryHandler.Track("weapon removed", handler.CharacterFromLink("id", characterOfWeaponLink)), weaponXCharacterDataHandler.HandlerDelete())
+		weaponXCharacterDataGroup.DELETE("/character/:id", characterHistoryHandler.Track("weapons cleared", handler.CharacterFromParam("id")), weaponXCharacterDataHandler.HandlerDeleteByCharacterDataId())
 		weaponXCharacterDataGroup.GET("", weaponXCharacterDataHandler.HandlerGetAll())
 		weaponXCharacterDataGroup.GET("/:id", weaponXCharacterDataHandler.HandlerGetById())
 		weaponXCharacterDataGroup.GET("/character/:id", weaponXCharacterDataHandler.HandlerGetByCharacterDataId())
-		weaponXCharacterDataGroup.PUT("/:id", weaponXCharacterDataHandler.HandlerUpdate())
+		weaponXCharacterDataGroup.PUT("/:id", characterHistoryHandler.Track("weapon updated", handler.CharacterFromLink("id", characterOfWeaponLink)), weaponXCharacterDataHandler.HandlerUpdate())
 	}
 }
 
 func (r *router) buildCharacterXProficiencyRoutes() {
 	characterXProficiencyGroup := r.routerGroup.Group("/character_proficiency")
 	{
-		characterXProficiencyGroup.POST("", characterXProficiencyHandler.HandlerCreate())
-		characterXProficiencyGroup.DELETE("/:id", characterXProficiencyHandler.HandlerDelete())
+		characterXProficiencyGroup.POST("", characterHistoryHandler.Track("proficiency added", handler.CharacterFromBody("character_id")), characterXProficiencyHandler.HandlerCreate())
+		characterXProficiencyGroup.DELETE("/:id", characterHistoryHandler.Track("proficiency removed", handler.CharacterFromLink("id", characterOfProficiencyLink)), characterXProficiencyHandler.HandlerDelete())
 	}
 }
 
@@ -633,69 +883,122 @@ func (r *router) buildSkillRoutes() {
 func (r *router) buildRaceRoutes() {
 	raceGroup := r.routerGroup.Group("/race")
 	{
+		raceGroup.POST("/import", bulkHandler.HandlerImport("race"))
+		raceGroup.GET("/export", bulkHandler.HandlerExport("race"))
 		raceGroup.POST("", raceHandler.HandlerCreate())
 		raceGroup.GET("", raceHandler.HandlerGetAll())
 		raceGroup.GET("/:id", raceHandler.HandlerGetById())
 		raceGroup.PUT("/:id", raceHandler.HandlerUpdate())
 		raceGroup.DELETE("/:id", raceHandler.HandlerDelete())
+		raceGroup.GET("/:id/translation", translationHandler.HandlerGet("race"))
+		raceGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("race"))
+		raceGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("race"))
+		raceGroup.GET("/:id/subrace", subraceHandler.HandlerGetByRaceId())
+		raceGroup.POST("/:id/subrace", subraceHandler.HandlerCreate())
+	}
+	subraceGroup := r.routerGroup.Group("/subrace")
+	{
+		subraceGroup.GET("/:id", subraceHandler.HandlerGetById())
+		subraceGroup.PUT("/:id", subraceHandler.HandlerUpdate())
+		subraceGroup.DELETE("/:id", subraceHandler.HandlerDelete())
 	}
 }
 
 func (r *router) buildCharacterDataRoutes() {
 	characterDataGroup := r.routerGroup.Group("/character")
 	{
-		characterDataGroup.POST("", characterDataHandler.HandlerCreate())
+		characterDataGroup.POST("", characterHistoryHandler.Track("character created", handler.CharacterFromContext()), characterResourceHandler.Sync(handler.CharacterFromContext()), characterDataHandler.HandlerCreate())
 		characterDataGroup.GET("", characterDataHandler.HandlerGetAll())
 		characterDataGroup.GET("/filter", characterDataHandler.HandlerGetByCampaignIdAndUserId())
 		characterDataGroup.GET("/:id", characterDataHandler.HandlerGetById())
 		characterDataGroup.GET("/event/:eventid", characterDataHandler.HandlerGetByAttackEventId())
 		characterDataGroup.GET("/generic", characterDataHandler.HandlerGetGenerics())
+		characterDataGroup.POST("/generic/:id/clone", characterHistoryHandler.Track("character cloned", handler.CharacterFromContext()), characterResourceHandler.Sync(handler.CharacterFromContext()), characterDataHandler.HandlerCloneGeneric())
 		characterDataGroup.GET("/user", characterDataHandler.HandlerGetByUser())
-		characterDataGroup.PUT("/:id", characterDataHandler.HandlerUpdate())
+		characterDataGroup.GET("/:id/export", characterExportHandler.HandlerExport())
+		characterDataGroup.POST("/import", characterHistoryHandler.Track("character imported", handler.CharacterFromContext()), characterResourceHandler.Sync(handler.CharacterFromContext()), characterExportHandler.HandlerImport())
+		characterDataGroup.GET("/:id/history", characterHistoryHandler.HandlerGetHistory())
+		characterDataGroup.GET("/:id/history/:version", characterHistoryHandler.HandlerGetVersion())
+		characterDataGroup.GET("/:id/history/session/:sessionid", characterHistoryHandler.HandlerGetAtSessionStart())
+		characterDataGroup.POST("/:id/history/:version/restore", characterHistoryHandler.HandlerRestore())
+		characterDataGroup.PUT("/:id", characterHistoryHandler.Track("character updated", handler.CharacterFromParam("id")), characterResourceHandler.Sync(handler.CharacterFromParam("id")), characterDataHandler.HandlerUpdate())
 		characterDataGroup.DELETE("/:id", characterDataHandler.HandlerDelete())
+		characterDataGroup.POST("/:id/portrait", characterHistoryHandler.Track("portrait changed", handler.CharacterFromParam("id")), imageHandler.HandlerCharacterPortrait())
+		characterDataGroup.GET("/:id/status", characterStatusHandler.HandlerGetStatus())
+		characterDataGroup.PUT("/:id/hitpoints", characterHistoryHandler.Track("hitpoints changed", handler.CharacterFromParam("id")), characterStatusHandler.HandlerSetHitpoints())
+		characterDataGroup.POST("/:id/slots/expend", characterHistoryHandler.Track("spell slot expended", handler.CharacterFromParam("id")), characterStatusHandler.HandlerExpendSlot())
+		characterDataGroup.POST("/:id/slots/restore", characterHistoryHandler.Track("spell slot restored", handler.CharacterFromParam("id")), characterStatusHandler.HandlerRestoreSlot())
+		characterDataGroup.POST("/:id/rest", characterHistoryHandler.Track("rested", handler.CharacterFromParam("id")), characterStatusHandler.HandlerRest())
+		characterDataGroup.POST("/:id/deathsave", characterHistoryHandler.Track("death save rolled", handler.CharacterFromParam("id")), characterStatusHandler.HandlerRollDeathSave())
+		characterDataGroup.POST("/:id/stabilize", characterHistoryHandler.Track("stabilized", handler.CharacterFromParam("id")), characterStatusHandler.HandlerStabilize())
+		characterDataGroup.GET("/:id/journal", journalHandler.HandlerGetByCharacterId())
+		characterDataGroup.POST("/:id/journal", journalHandler.HandlerCreate())
+		characterDataGroup.GET("/:id/resources", characterResourceHandler.HandlerGetByCharacterId())
+		characterDataGroup.POST("/:id/resources", characterHistoryHandler.Track("resource added", handler.CharacterFromParam("id")), characterResourceHandler.HandlerCreate())
+		characterDataGroup.PUT("/:id/resources/:resourceId", characterHistoryHandler.Track("resource updated", handler.CharacterFromParam("id")), characterResourceHandler.HandlerUpdate())
+		characterDataGroup.DELETE("/:id/resources/:resourceId", characterHistoryHandler.Track("resource removed", handler.CharacterFromParam("id")), characterResourceHandler.HandlerDelete())
+		characterDataGroup.POST("/:id/resources/:resourceId/spend", characterHistoryHandler.Track("resource spent", handler.CharacterFromParam("id")), characterResourceHandler.HandlerSpend())
+		characterDataGroup.POST("/:id/resources/:resourceId/restore", characterHistoryHandler.Track("resource restored", handler.CharacterFromParam("id")), characterResourceHandler.HandlerRestore())
+		characterDataGroup.POST("/:id/resources/recharge", characterHistoryHandler.Track("resources recharged", handler.CharacterFromParam("id")), characterResourceHandler.HandlerRecharge())
+		characterDataGroup.GET("/:id/equipment", equipmentHandler.HandlerGet())
+		characterDataGroup.POST("/:id/equip", characterHistoryHandler.Track("item equipped", handler.CharacterFromParam("id")), equipmentHandler.HandlerEquip())
+		characterDataGroup.POST("/:id/unequip", characterHistoryHandler.Track("item unequipped", handler.CharacterFromParam("id")), equipmentHandler.HandlerUnequip())
+		characterDataGroup.GET("/:id/wallet", walletHandler.HandlerGet())
+		characterDataGroup.GET("/:id/wallet/transactions", walletHandler.HandlerGetTransactions())
+		characterDataGroup.POST("/:id/wallet", characterHistoryHandler.Track("wallet adjusted", handler.CharacterFromParam("id")), walletHandler.HandlerAdjust())
+		characterDataGroup.POST("/:id/class", characterHistoryHandler.Track("class added", handler.CharacterFromParam("id")), characterResourceHandler.Sync(handler.CharacterFromParam("id")), characterDataHandler.HandlerAddClass())
+		characterDataGroup.PUT("/:id/class/:classid", characterHistoryHandler.Track("class level changed", handler.CharacterFromParam("id")), characterResourceHandler.Sync(handler.CharacterFromParam("id")), characterDataHandler.HandlerSetClassLevel())
+		characterDataGroup.PUT("/:id/class/:classid/subclass", characterHistoryHandler.Track("subclass changed", handler.CharacterFromParam("id")), characterResourceHandler.Sync(handler.CharacterFromParam("id")), characterDataHandler.HandlerSetSubclass())
+		characterDataGroup.DELETE("/:id/class/:classid", characterHistoryHandler.Track("class removed", handler.CharacterFromParam("id")), characterResourceHandler.Sync(handler.CharacterFromParam("id")), characterDataHandler.HandlerRemoveClass())
 	}
 }
 
 func (r *router) buildArmorRoutes() {
 	armorGroup := r.routerGroup.Group("/armor")
 	{
+		armorGroup.POST("/import", bulkHandler.HandlerImport("armor"))
+		armorGroup.GET("/export", bulkHandler.HandlerExport("armor"))
 		armorGroup.POST("", armorHandler.HandlerCreate())
 		armorGroup.GET("", armorHandler.HandlerGetAll())
 		armorGroup.GET("/:id", armorHandler.HandlerGetById())
 		armorGroup.PUT("/:id", armorHandler.HandlerUpdate())
 		armorGroup.DELETE("/:id", armorHandler.HandlerDelete())
+		armorGroup.GET("/:id/translation", translationHandler.HandlerGet("armor"))
+		armorGroup.PUT("/:id/translation/:locale", translationHandler.HandlerSet("armor"))
+		armorGroup.DELETE("/:id/translation/:locale", translationHandler.HandlerDelete("armor"))
 	}
 }
 
 func (r *router) buildArmorXCharacterDataRoutes() {
 	armorXCharacterDataGroup := r.routerGroup.Group("/armor_character")
 	{
-		armorXCharacterDataGroup.POST("", armorXCharacterDataHandler.HandlerCreate())
-		armorXCharacterDataGroup.DELETE("/:id", armorXCharacterDataHandler.HandlerDelete())
-		armorXCharacterDataGroup.DELETE("/character/:id", armorXCharacterDataHandler.HandlerDeleteByCharacterId())
+		armorXCharacterDataGroup.POST("", characterHistoryHandler.Track("armor added", handler.CharacterFromBody("character_data_id")), armorXCharacterDataHandler.HandlerCreate())
+		armorXCharacterDataGroup.DELETE("/:id", characterHistoryHandler.Track("armor removed", handler.CharacterFromLink("id", characterOfArmorLink)), armorXCharacterDataHandler.HandlerDelete())
+		armorXCharacterDataGroup.DELETE("/character/:id", characterHistoryHandler.Track("armors cleared", handler.CharacterFromParam("id")), armorXCharacterDataHandler.HandlerDeleteByCharacterId())
 		armorXCharacterDataGroup.GET("", armorXCharacterDataHandler.HandlerGetAll())
 		armorXCharacterDataGroup.GET("/:id", armorXCharacterDataHandler.HandlerGetById())
 		armorXCharacterDataGroup.GET("/character/:id", armorXCharacterDataHandler.HandlerGetByCharacterDataId())
-		armorXCharacterDataGroup.PUT("/:id", armorXCharacterDataHandler.HandlerUpdate())
+		armorXCharacterDataGroup.PUT("/:id", characterHistoryHandler.Track("armor updated", handler.CharacterFromLink("id", characterOfArmorLink)), armorXCharacterDataHandler.HandlerUpdate())
 	}
 }
 
 func (r *router) buildCharacterXAttackEventRoutes() {
 	characterXAttackEventGroup := r.routerGroup.Group("/characterXattackevent")
 	{
-		characterXAttackEventGroup.POST("", characterXAttackEventHandler.HandlerCreate())
+		characterXAttackEventGroup.POST("", characterHistoryHandler.Track("attacked", handler.CharacterFromBody("character_id")), characterXAttackEventHandler.HandlerCreate())
 		characterXAttackEventGroup.GET("", characterXAttackEventHandler.HandlerGetAll())
 		characterXAttackEventGroup.GET("/:id", characterXAttackEventHandler.HandlerGetById())
 		characterXAttackEventGroup.GET("/character/:id", characterXAttackEventHandler.HandlerGetByCharacterId())
 		characterXAttackEventGroup.GET("/attackevent/:id", characterXAttackEventHandler.HandlerGetByEventId())
-		characterXAttackEventGroup.DELETE("/:id", characterXAttackEventHandler.HandlerDelete())
+		characterXAttackEventGroup.PUT("/:id", characterHistoryHandler.Track("attack changed", handler.CharacterFromLink("id", characterOfAttackTarget)), characterXAttackEventHandler.HandlerUpdate())
+		characterXAttackEventGroup.DELETE("/:id", characterHistoryHandler.Track("attack removed", handler.CharacterFromLink("id", characterOfAttackTarget)), characterXAttackEventHandler.HandlerDelete())
 	}
 }
 
 func (r *router) buildDiceEventRoutes() {
 	diceEventGroup := r.routerGroup.Group("/diceevent")
 	{
-		diceEventGroup.POST("", diceEventHandler.HandlerCreate())
+		diceEventGroup.POST("", characterHistoryHandler.Track("dice rolled", handler.CharacterFromBody("event_protagonist")), diceEventHandler.HandlerCreate())
 		diceEventGroup.GET("", diceEventHandler.HandlerGetAll())
 		diceEventGroup.GET("/:id", diceEventHandler.HandlerGetById())
 		diceEventGroup.PUT("/:id", diceEventHandler.HandlerUpdate())
@@ -706,8 +1009,8 @@ func (r *router) buildDiceEventRoutes() {
 func (r *router) buildSkillXCharacterDataRoutes() {
 	skillXCharacterDataGroup := r.routerGroup.Group("/skill_character")
 	{
-		skillXCharacterDataGroup.POST("", skillXCharacterDataHandler.HandlerCreate())
-		skillXCharacterDataGroup.DELETE("/:id", skillXCharacterDataHandler.HandlerDelete())
+		skillXCharacterDataGroup.POST("", characterHistoryHandler.Track("skill added", handler.CharacterFromBody("character_data_id")), skillXCharacterDataHandler.HandlerCreate())
+		skillXCharacterDataGroup.DELETE("/:id", characterHistoryHandler.Track("skill removed", handler.CharacterFromParam("id")), skillXCharacterDataHandler.HandlerDelete())
 
 	}
 }
@@ -715,7 +1018,7 @@ func (r *router) buildSkillXCharacterDataRoutes() {
 func (r *router) buildTradeEventRoutes() {
 	tradeEventGroup := r.routerGroup.Group("/tradeevent")
 	{
-		tradeEventGroup.POST("", tradeEventHandler.HandlerCreate())
+		tradeEventGroup.POST("", characterHistoryHandler.Track("trade", handler.CharacterFromBody("sender", "receiver")), tradeEventHandler.HandlerCreate())
 		tradeEventGroup.GET("/session/:id", tradeEventHandler.HandlerGetBySessionId())
 		tradeEventGroup.GET("/sender/:id", tradeEventHandler.HandlerGetBySender())
 		tradeEventGroup.GET("/receiver/:id", tradeEventHandler.HandlerGetByReceiver())
@@ -736,5 +1039,23 @@ func (r *router) buildReportRoutes() {
 	{
 		reportGroup.GET("/session/:id", reportHandler.HandlerGetSessionReport())
 		reportGroup.GET("/character/campaign/:id", reportHandler.HandlerGetCharacterCampaignReport())
+		reportGroup.GET("/character/:id/pdf", reportHandler.HandlerGetCharacterSheetPdf())
+	}
+}
+
+func (r *router) buildJournalRoutes() {
+	journalGroup := r.routerGroup.Group("/journal")
+	{
+		journalGroup.GET("/search", journalHandler.HandlerSearch())
+		journalGroup.GET("/:id", journalHandler.HandlerGetById())
+		journalGroup.PUT("/:id", journalHandler.HandlerUpdate())
+		journalGroup.DELETE("/:id", journalHandler.HandlerDelete())
+	}
+}
+
+func (r *router) buildAdminRoutes() {
+	adminGroup := r.routerGroup.Group("/admin")
+	{
+		adminGroup.POST("/seed", seedHandler.HandlerLoadSRD())
 	}
 }
@@ -18,6 +18,7 @@ require (
 	github.com/swaggo/files v1.0.1
 	github.com/swaggo/gin-swagger v1.6.0
 	github.com/swaggo/swag v1.16.3
+	golang.org/x/image v0.15.0
 	google.golang.org/api v0.114.0
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
 )
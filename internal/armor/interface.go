@@ -2,6 +2,7 @@ package armor
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -20,6 +21,7 @@ type ArmorService interface {
 	GetArmorByID(id int) (domain.Armor, error)
 	UpdateArmor(Armor dto.CreateArmorDto, id int) (domain.Armor, error)
 	DeleteArmor(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Armor], error)
 }
 
 type ArmorRepository interface {
@@ -28,4 +30,5 @@ type ArmorRepository interface {
 	GetArmorById(id int) (domain.Armor, error)
 	UpdateArmor(Armor domain.Armor, id int) (domain.Armor, error)
 	DeleteArmor(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Armor], error)
 }
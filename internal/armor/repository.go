@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -43,6 +45,8 @@ func (r *armorMySqlRepository) Create(armor domain.Armor) (domain.Armor, error)
 		armor.ArmorClass,
 		armor.DexBonus,
 		armor.CampaignId,
+		armor.MaxDexBonus,
+		armor.RequiresAttunement,
 	)
 	if err != nil {
 		return domain.Armor{}, err
@@ -81,6 +85,8 @@ func (r *armorMySqlRepository) GetAllArmors() ([]domain.Armor, error) {
 			&armor.ArmorClass,
 			&armor.DexBonus,
 			&armor.CampaignId,
+			&armor.MaxDexBonus,
+			&armor.RequiresAttunement,
 		); err != nil {
 			return nil, err
 		}
@@ -105,6 +111,8 @@ func (r *armorMySqlRepository) GetArmorById(id int) (domain.Armor, error) {
 		&armor.ArmorClass,
 		&armor.DexBonus,
 		&armor.CampaignId,
+		&armor.MaxDexBonus,
+		&armor.RequiresAttunement,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -135,6 +143,8 @@ func (r *armorMySqlRepository) UpdateArmor(armor domain.Armor, id int) (domain.A
 		armor.ArmorClass,
 		armor.DexBonus,
 		armor.CampaignId,
+		armor.MaxDexBonus,
+		armor.RequiresAttunement,
 		id,
 	)
 	if err != nil {
@@ -145,13 +155,47 @@ func (r *armorMySqlRepository) UpdateArmor(armor domain.Armor, id int) (domain.A
 	return armor, nil
 }
 
+// DeleteArmor implements ArmorRepository. The translations of the armor go
+// with it.
 func (r *armorMySqlRepository) DeleteArmor(id int) error {
-	statement, err := r.db.Prepare(QueryDeleteArmor)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return ErrPrepareStatement
+		return err
 	}
-	defer statement.Close()
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryDeleteArmor, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
 
-	_, err = statement.Exec(id)
-	return err
+// Search implements ArmorRepository.
+func (r *armorMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Armor], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanArmor)
+}
+
+func scanArmor(row catalog.Scannable) (domain.Armor, error) {
+	var armor domain.Armor
+	err := row.Scan(
+		&armor.ArmorId,
+		&armor.Material,
+		&armor.Name,
+		&armor.Weight,
+		&armor.Price,
+		&armor.Category,
+		&armor.ProtectionType,
+		&armor.Description,
+		&armor.Penalty,
+		&armor.Strength,
+		&armor.ArmorClass,
+		&armor.DexBonus,
+		&armor.CampaignId,
+		&armor.MaxDexBonus,
+		&armor.RequiresAttunement,
+	)
+	return armor, err
 }
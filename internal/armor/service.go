@@ -3,6 +3,7 @@ package armor
 import (
 	"fmt"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -17,18 +18,20 @@ func NewArmorService(armorRepo ArmorRepository) ArmorService {
 
 func (s *armorService) CreateArmor(armorDto dto.CreateArmorDto) (domain.Armor, error) {
 	armorDomain := domain.Armor{
-		Material:       armorDto.Material,
-		Name:           armorDto.Name,
-		Weight:         armorDto.Weight,
-		Price:          armorDto.Price,
-		Category:       armorDto.Category,
-		ProtectionType: armorDto.ProtectionType,
-		Description:    armorDto.Description,
-		Penalty:        armorDto.Penalty,
-		Strength:       armorDto.Strength,
-		ArmorClass:     armorDto.ArmorClass,
-		DexBonus:       armorDto.DexBonus,
-		CampaignId:     armorDto.CampaignId,
+		Material:           armorDto.Material,
+		Name:               armorDto.Name,
+		Weight:             armorDto.Weight,
+		Price:              armorDto.Price,
+		Category:           armorDto.Category,
+		ProtectionType:     armorDto.ProtectionType,
+		Description:        armorDto.Description,
+		Penalty:            armorDto.Penalty,
+		Strength:           armorDto.Strength,
+		ArmorClass:         armorDto.ArmorClass,
+		DexBonus:           armorDto.DexBonus,
+		CampaignId:         armorDto.CampaignId,
+		MaxDexBonus:        armorDto.MaxDexBonus,
+		RequiresAttunement: armorDto.RequiresAttunement,
 	}
 
 	createdArmor, err := s.armorRepo.Create(armorDomain)
@@ -59,18 +62,20 @@ func (s *armorService) GetArmorByID(id int) (domain.Armor, error) {
 
 func (s *armorService) UpdateArmor(armorDto dto.CreateArmorDto, id int) (domain.Armor, error) {
 	armorDomain := domain.Armor{
-		Material:       armorDto.Material,
-		Name:           armorDto.Name,
-		Weight:         armorDto.Weight,
-		Price:          armorDto.Price,
-		Category:       armorDto.Category,
-		ProtectionType: armorDto.ProtectionType,
-		Description:    armorDto.Description,
-		Penalty:        armorDto.Penalty,
-		Strength:       armorDto.Strength,
-		ArmorClass:     armorDto.ArmorClass,
-		DexBonus:       armorDto.DexBonus,
-		CampaignId:     armorDto.CampaignId,
+		Material:           armorDto.Material,
+		Name:               armorDto.Name,
+		Weight:             armorDto.Weight,
+		Price:              armorDto.Price,
+		Category:           armorDto.Category,
+		ProtectionType:     armorDto.ProtectionType,
+		Description:        armorDto.Description,
+		Penalty:            armorDto.Penalty,
+		Strength:           armorDto.Strength,
+		ArmorClass:         armorDto.ArmorClass,
+		DexBonus:           armorDto.DexBonus,
+		CampaignId:         armorDto.CampaignId,
+		MaxDexBonus:        armorDto.MaxDexBonus,
+		RequiresAttunement: armorDto.RequiresAttunement,
 	}
 
 	updatedArmor, err := s.armorRepo.UpdateArmor(armorDomain, id)
@@ -85,3 +90,8 @@ func (s *armorService) UpdateArmor(armorDto dto.CreateArmorDto, id int) (domain.
 func (s *armorService) DeleteArmor(id int) error {
 	return s.armorRepo.DeleteArmor(id)
 }
+
+// Search implements ArmorService.
+func (s *armorService) Search(params catalog.Params) (catalog.Page[domain.Armor], error) {
+	return s.armorRepo.Search(params)
+}
@@ -1,9 +1,11 @@
 package armor
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
 	QueryCreateArmor = `
-		INSERT INTO armor (material, name, weight, price, category, protection_type, description, penalty, strength, armor_class, dex_bonus, campaign_id)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+		INSERT INTO armor (material, name, weight, price, category, protection_type, description, penalty, strength, armor_class, dex_bonus, campaign_id, max_dex_bonus, requires_attunement)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 	`
 
 	QueryGetAllArmor = `
@@ -16,7 +18,7 @@ var (
 
 	QueryUpdateArmor = `
 		UPDATE armor
-		SET material = ?, name = ?, weight = ?, price = ?, category = ?, protection_type = ?, description = ?, penalty = ?, strength = ?, armor_class = ?, dex_bonus = ?, campaign_id = ?
+		SET material = ?, name = ?, weight = ?, price = ?, category = ?, protection_type = ?, description = ?, penalty = ?, strength = ?, armor_class = ?, dex_bonus = ?, campaign_id = ?, max_dex_bonus = ?, requires_attunement = ?
 		WHERE armor_id = ?;
 	`
 
@@ -24,3 +26,16 @@ var (
 		DELETE FROM armor WHERE armor_id = ?;
 	`
 )
+
+// CatalogTable describes how GET /armor searches, filters and sorts armor.
+var CatalogTable = catalog.Table{
+	Name:   "armor",
+	Id:     "armor_id",
+	Search: []string{"name", "description"},
+	Filters: map[string]catalog.Filter{
+		"category": {Condition: "category = ?", Kind: catalog.Text},
+	},
+	Sorts:          map[string]string{"name": "name", "category": "category", "price": "price", "weight": "weight", "armor_class": "armor_class"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
@@ -29,6 +29,8 @@ func (r *armorXCharacterDataSqlRepository) CreateArmorXCharacterData(data domain
 		data.CharacterData_Id,
 		data.Armor.ArmorId,
 		data.Equipped,
+		data.Slot,
+		data.Attuned,
 	)
 
 	if err != nil {
@@ -107,7 +109,11 @@ func (r *armorXCharacterDataSqlRepository) GetAllArmorXCharacterData() ([]domain
 			&data.Armor.ArmorClass,
 			&data.Armor.DexBonus,
 			&data.Armor.CampaignId,
+			&data.Armor.MaxDexBonus,
+			&data.Armor.RequiresAttunement,
 			&data.Equipped,
+			&data.Slot,
+			&data.Attuned,
 		)
 		if err != nil {
 			return []domain.ArmorXCharacterData{}, err
@@ -121,7 +127,7 @@ func (r *armorXCharacterDataSqlRepository) GetAllArmorXCharacterData() ([]domain
 }
 
 func (r *armorXCharacterDataSqlRepository) GetByIdArmorXCharacterData(id int) (domain.ArmorXCharacterData, error) {
-	row := r.db.QueryRow(QueryGetByCharacterId, id)
+	row := r.db.QueryRow(QueryGetByIdCharacterArmor, id)
 	var data domain.ArmorXCharacterData
 	err := row.Scan(
 		&data.ArmorXCharacterData_Id,
@@ -139,7 +145,11 @@ func (r *armorXCharacterDataSqlRepository) GetByIdArmorXCharacterData(id int) (d
 		&data.Armor.ArmorClass,
 		&data.Armor.DexBonus,
 		&data.Armor.CampaignId,
+		&data.Armor.MaxDexBonus,
+		&data.Armor.RequiresAttunement,
 		&data.Equipped,
+		&data.Slot,
+		&data.Attuned,
 	)
 	if err != nil {
 		return domain.ArmorXCharacterData{}, err
@@ -177,7 +187,11 @@ func (r *armorXCharacterDataSqlRepository) GetByCharacterDataIdArmor(id int) ([]
 			&data.Armor.ArmorClass,
 			&data.Armor.DexBonus,
 			&data.Armor.CampaignId,
+			&data.Armor.MaxDexBonus,
+			&data.Armor.RequiresAttunement,
 			&data.Equipped,
+			&data.Slot,
+			&data.Attuned,
 		)
 		if err != nil {
 			fmt.Println("death2 ", err.Error())
@@ -203,6 +217,8 @@ func (r *armorXCharacterDataSqlRepository) UpdateArmorXCharacterData(data domain
 		data.CharacterData_Id,
 		data.Armor.ArmorId,
 		data.Equipped,
+		data.Slot,
+		data.Attuned,
 		data.ArmorXCharacterData_Id,
 	)
 
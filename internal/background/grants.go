@@ -0,0 +1,215 @@
+package background
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+const (
+	EquipmentItem   = "item"
+	EquipmentWeapon = "weapon"
+	EquipmentArmor  = "armor"
+)
+
+var (
+	ErrUnknownSkill       = errors.New("unknown skill")
+	ErrUnknownProficiency = errors.New("unknown proficiency")
+	ErrUnknownFeature     = errors.New("unknown feature")
+	ErrUnknownEquipment   = errors.New("unknown equipment")
+	ErrInvalidKind        = errors.New("kind must be item, weapon or armor")
+	ErrInvalidQuantity    = errors.New("quantity must be greater than 0")
+	ErrNotGranted         = errors.New("the background does not grant it")
+)
+
+// GetGrants implements BackgroundService.
+func (s *backgroundService) GetGrants(id int) (dto.BackgroundGrantsDto, error) {
+	background, err := s.backgroundRepo.GetBackgroundById(id)
+	if err != nil {
+		return dto.BackgroundGrantsDto{}, err
+	}
+	grants := dto.BackgroundGrantsDto{Skills: []domain.Skill{}, Proficiencies: []domain.Proficiency{}}
+
+	skills, err := s.skillsService.GetByBackgroundId(id)
+	if err != nil {
+		return dto.BackgroundGrantsDto{}, err
+	}
+	for _, granted := range skills {
+		skill, err := s.skillService.GetById(granted.Skill_Id)
+		if err != nil {
+			return dto.BackgroundGrantsDto{}, err
+		}
+		grants.Skills = append(grants.Skills, skill)
+	}
+
+	proficiencies, err := s.proficienciesService.GetByBackgroundId(id)
+	if err != nil {
+		return dto.BackgroundGrantsDto{}, err
+	}
+	for _, granted := range proficiencies {
+		proficiency, err := s.proficiencyService.GetById(granted.ProficiencyID)
+		if err != nil {
+			return dto.BackgroundGrantsDto{}, err
+		}
+		grants.Proficiencies = append(grants.Proficiencies, proficiency)
+	}
+
+	if background.FeatureId != nil {
+		feature, err := s.featureService.GetFeatureById(*background.FeatureId)
+		if err != nil {
+			return dto.BackgroundGrantsDto{}, err
+		}
+		grants.Feature = &feature
+	}
+
+	grants.Equipment, err = s.backgroundRepo.GetEquipment(id)
+	if err != nil {
+		return dto.BackgroundGrantsDto{}, err
+	}
+	for i := range grants.Equipment {
+		grants.Equipment[i].Name, err = s.equipmentName(grants.Equipment[i])
+		if err != nil {
+			return dto.BackgroundGrantsDto{}, err
+		}
+	}
+	return grants, nil
+}
+
+// AddSkill implements BackgroundService. Granting a skill twice does nothing.
+func (s *backgroundService) AddSkill(id int, skillId int) error {
+	if _, err := s.backgroundRepo.GetBackgroundById(id); err != nil {
+		return err
+	}
+	if _, err := s.skillService.GetById(skillId); err != nil {
+		return ErrUnknownSkill
+	}
+	skills, err := s.skillsService.GetByBackgroundId(id)
+	if err != nil {
+		return err
+	}
+	for _, granted := range skills {
+		if granted.Skill_Id == skillId {
+			return nil
+		}
+	}
+	_, err = s.skillsService.CreateBackgroundXSkills(domain.BackgroundXSkills{Background_Id: id, Skill_Id: skillId})
+	return err
+}
+
+// RemoveSkill implements BackgroundService.
+func (s *backgroundService) RemoveSkill(id int, skillId int) error {
+	skills, err := s.skillsService.GetByBackgroundId(id)
+	if err != nil {
+		return err
+	}
+	for _, granted := range skills {
+		if granted.Skill_Id == skillId {
+			return s.skillsService.DeleteBackgroundXSkills(granted.BackgroundXSkills_Id)
+		}
+	}
+	return ErrNotGranted
+}
+
+// AddProficiency implements BackgroundService. Granting a proficiency twice
+// does nothing.
+func (s *backgroundService) AddProficiency(id int, proficiencyId int) error {
+	if _, err := s.backgroundRepo.GetBackgroundById(id); err != nil {
+		return err
+	}
+	if _, err := s.proficiencyService.GetById(proficiencyId); err != nil {
+		return ErrUnknownProficiency
+	}
+	proficiencies, err := s.proficienciesService.GetByBackgroundId(id)
+	if err != nil {
+		return err
+	}
+	for _, granted := range proficiencies {
+		if granted.ProficiencyID == proficiencyId {
+			return nil
+		}
+	}
+	_, err = s.proficienciesService.Create(domain.BackgroundXProficiency{BackgroundID: id, ProficiencyID: proficiencyId})
+	return err
+}
+
+// RemoveProficiency implements BackgroundService.
+func (s *backgroundService) RemoveProficiency(id int, proficiencyId int) error {
+	proficiencies, err := s.proficienciesService.GetByBackgroundId(id)
+	if err != nil {
+		return err
+	}
+	for _, granted := range proficiencies {
+		if granted.ProficiencyID == proficiencyId {
+			return s.proficienciesService.Delete(granted)
+		}
+	}
+	return ErrNotGranted
+}
+
+// SetFeature implements BackgroundService. A nil feature removes it.
+func (s *backgroundService) SetFeature(id int, featureId *int) error {
+	if _, err := s.backgroundRepo.GetBackgroundById(id); err != nil {
+		return err
+	}
+	if featureId != nil {
+		if _, err := s.featureService.GetFeatureById(*featureId); err != nil {
+			return ErrUnknownFeature
+		}
+	}
+	return s.backgroundRepo.SetFeature(id, featureId)
+}
+
+// AddEquipment implements BackgroundService. Quantity defaults to one.
+func (s *backgroundService) AddEquipment(id int, equipment domain.BackgroundEquipment) (domain.BackgroundEquipment, error) {
+	if _, err := s.backgroundRepo.GetBackgroundById(id); err != nil {
+		return domain.BackgroundEquipment{}, err
+	}
+	if equipment.Quantity == 0 {
+		equipment.Quantity = 1
+	}
+	if equipment.Quantity < 0 {
+		return domain.BackgroundEquipment{}, ErrInvalidQuantity
+	}
+	equipment.BackgroundId = id
+	name, err := s.equipmentName(equipment)
+	if err != nil {
+		return domain.BackgroundEquipment{}, err
+	}
+	created, err := s.backgroundRepo.AddEquipment(equipment)
+	if err != nil {
+		return domain.BackgroundEquipment{}, err
+	}
+	created.Name = name
+	return created, nil
+}
+
+// RemoveEquipment implements BackgroundService.
+func (s *backgroundService) RemoveEquipment(id int, equipmentId int) error {
+	return s.backgroundRepo.RemoveEquipment(id, equipmentId)
+}
+
+// equipmentName looks the equipment up in the catalog of its kind.
+func (s *backgroundService) equipmentName(equipment domain.BackgroundEquipment) (string, error) {
+	switch equipment.Kind {
+	case EquipmentItem:
+		item, err := s.itemService.GetById(equipment.EquipmentId)
+		if err != nil {
+			return "", ErrUnknownEquipment
+		}
+		return item.Name, nil
+	case EquipmentWeapon:
+		weapon, err := s.weaponService.GetById(equipment.EquipmentId)
+		if err != nil {
+			return "", ErrUnknownEquipment
+		}
+		return weapon.Name, nil
+	case EquipmentArmor:
+		armor, err := s.armorService.GetArmorByID(equipment.EquipmentId)
+		if err != nil {
+			return "", ErrUnknownEquipment
+		}
+		return armor.Name, nil
+	}
+	return "", ErrInvalidKind
+}
@@ -2,6 +2,7 @@ package background
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -20,6 +21,15 @@ type BackgroundService interface {
 	GetBackgroundByID(id int) (domain.Background, error)
 	UpdateBackground(background dto.CreateBackgroundDto, id int) (domain.Background, error)
 	DeleteBackground(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Background], error)
+	GetGrants(id int) (dto.BackgroundGrantsDto, error)
+	AddSkill(id int, skillId int) error
+	RemoveSkill(id int, skillId int) error
+	AddProficiency(id int, proficiencyId int) error
+	RemoveProficiency(id int, proficiencyId int) error
+	SetFeature(id int, featureId *int) error
+	AddEquipment(id int, equipment domain.BackgroundEquipment) (domain.BackgroundEquipment, error)
+	RemoveEquipment(id int, equipmentId int) error
 }
 
 type BackgroundRepository interface {
@@ -28,4 +38,9 @@ type BackgroundRepository interface {
 	GetBackgroundById(id int) (domain.Background, error)
 	UpdateBackground(background domain.Background, id int) (domain.Background, error)
 	DeleteBackground(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Background], error)
+	SetFeature(id int, featureId *int) error
+	GetEquipment(id int) ([]domain.BackgroundEquipment, error)
+	AddEquipment(equipment domain.BackgroundEquipment) (domain.BackgroundEquipment, error)
+	RemoveEquipment(id int, equipmentId int) error
 }
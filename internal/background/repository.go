@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
 	ErrPrepareStatementBackground    = errors.New("error preparing statement for background")
 	ErrGettingLastInsertIdBackground = errors.New("error getting last insert id for background")
+	ErrNotFound                      = errors.New("background not found")
 )
 
 type backgroundMySqlRepository struct {
@@ -39,6 +42,7 @@ func (r *backgroundMySqlRepository) Create(background domain.Background) (domain
 		background.Flaws,
 		background.Trait,
 		background.ToolProficiencies,
+		background.CampaignId,
 	)
 	if err != nil {
 		return domain.Background{}, err
@@ -74,6 +78,8 @@ func (r *backgroundMySqlRepository) GetAllBackgrounds() ([]domain.Background, er
 			&background.Flaws,
 			&background.Trait,
 			&background.ToolProficiencies,
+			&background.CampaignId,
+			&background.FeatureId,
 			); err != nil {
 			log.Println(2, err)
 			return nil, err
@@ -96,10 +102,12 @@ func (r *backgroundMySqlRepository) GetBackgroundById(id int) (domain.Background
 		&background.Flaws,
 		&background.Trait,
 		&background.ToolProficiencies,
+		&background.CampaignId,
+		&background.FeatureId,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return domain.Background{}, errors.New("background not found")
+			return domain.Background{}, ErrNotFound
 		}
 		return domain.Background{}, err
 	}
@@ -123,6 +131,7 @@ func (r *backgroundMySqlRepository) UpdateBackground(background domain.Backgroun
 		background.Flaws,
 		background.Trait,
 		background.ToolProficiencies,
+		background.CampaignId,
 		id,
 	)
 	if err != nil {
@@ -133,13 +142,100 @@ func (r *backgroundMySqlRepository) UpdateBackground(background domain.Backgroun
 	return background, nil
 }
 
+// DeleteBackground implements BackgroundRepository. What the background
+// grants goes with it.
 func (r *backgroundMySqlRepository) DeleteBackground(id int) error {
-	statement, err := r.db.Prepare(QueryDeleteBackground)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return ErrPrepareStatementBackground
+		return err
 	}
-	defer statement.Close()
+	defer tx.Rollback()
+
+	for _, query := range []string{QueryDeleteSkills, QueryDeleteProficiencies, QueryDeleteAllEquipment, QueryDeleteBackground} {
+		if _, err := tx.Exec(query, id); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
 
-	_, err = statement.Exec(id)
+// SetFeature implements BackgroundRepository.
+func (r *backgroundMySqlRepository) SetFeature(id int, featureId *int) error {
+	_, err := r.db.Exec(QuerySetFeature, featureId, id)
 	return err
 }
+
+// GetEquipment implements BackgroundRepository.
+func (r *backgroundMySqlRepository) GetEquipment(id int) ([]domain.BackgroundEquipment, error) {
+	rows, err := r.db.Query(QueryGetEquipment, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	equipment := []domain.BackgroundEquipment{}
+	for rows.Next() {
+		var entry domain.BackgroundEquipment
+		if err := rows.Scan(&entry.BackgroundEquipmentId, &entry.BackgroundId, &entry.Kind, &entry.EquipmentId, &entry.Quantity); err != nil {
+			return nil, err
+		}
+		equipment = append(equipment, entry)
+	}
+	return equipment, rows.Err()
+}
+
+// AddEquipment implements BackgroundRepository.
+func (r *backgroundMySqlRepository) AddEquipment(equipment domain.BackgroundEquipment) (domain.BackgroundEquipment, error) {
+	result, err := r.db.Exec(QueryInsertEquipment, equipment.BackgroundId, equipment.Kind, equipment.EquipmentId, equipment.Quantity)
+	if err != nil {
+		return domain.BackgroundEquipment{}, err
+	}
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return domain.BackgroundEquipment{}, ErrGettingLastInsertIdBackground
+	}
+	equipment.BackgroundEquipmentId = int(lastId)
+	return equipment, nil
+}
+
+// RemoveEquipment implements BackgroundRepository.
+func (r *backgroundMySqlRepository) RemoveEquipment(id int, equipmentId int) error {
+	result, err := r.db.Exec(QueryDeleteEquipment, equipmentId, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotGranted
+	}
+	return nil
+}
+
+// Search implements BackgroundRepository.
+func (r *backgroundMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Background], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanBackground)
+}
+
+func scanBackground(row catalog.Scannable) (domain.Background, error) {
+	var background domain.Background
+	err := row.Scan(
+		&background.BackgroundID,
+		&background.Name,
+		&background.Languages,
+		&background.PersonalityTraits,
+		&background.Ideals,
+		&background.Bond,
+		&background.Flaws,
+		&background.Trait,
+		&background.ToolProficiencies,
+		&background.CampaignId,
+		&background.FeatureId,
+	)
+	return background, err
+}
@@ -2,16 +2,34 @@ package background
 
 import (
 	"fmt"
+
+	"github.com/proyecto-dnd/backend/internal/armor"
+	backgroundXproficiency "github.com/proyecto-dnd/backend/internal/backgroundXProficiency"
+	"github.com/proyecto-dnd/backend/internal/backgroundxSkill"
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/item"
+	"github.com/proyecto-dnd/backend/internal/proficiency"
+	"github.com/proyecto-dnd/backend/internal/skill"
+	"github.com/proyecto-dnd/backend/internal/weapon"
 )
 
 type backgroundService struct {
-	backgroundRepo BackgroundRepository
+	backgroundRepo       BackgroundRepository
+	skillsService        backgroundxSkill.ServiceBackgroundXSkills
+	proficienciesService backgroundXproficiency.BackgroundXProficiencyService
+	skillService         skill.ServiceSkill
+	proficiencyService   proficiency.ProficiencyService
+	featureService       feature.FeatureService
+	itemService          item.ServiceItem
+	weaponService        weapon.ServiceWeapon
+	armorService         armor.ArmorService
 }
 
-func NewBackgroundService(backgroundRepo BackgroundRepository) BackgroundService {
-	return &backgroundService{backgroundRepo: backgroundRepo}
+func NewBackgroundService(backgroundRepo BackgroundRepository, skillsService backgroundxSkill.ServiceBackgroundXSkills, proficienciesService backgroundXproficiency.BackgroundXProficiencyService, skillService skill.ServiceSkill, proficiencyService proficiency.ProficiencyService, featureService feature.FeatureService, itemService item.ServiceItem, weaponService weapon.ServiceWeapon, armorService armor.ArmorService) BackgroundService {
+	return &backgroundService{backgroundRepo: backgroundRepo, skillsService: skillsService, proficienciesService: proficienciesService, skillService: skillService, proficiencyService: proficiencyService, featureService: featureService, itemService: itemService, weaponService: weaponService, armorService: armorService}
 }
 
 func (s *backgroundService) CreateBackground(backgroundDto dto.CreateBackgroundDto) (domain.Background, error) {
@@ -25,6 +43,7 @@ func (s *backgroundService) CreateBackground(backgroundDto dto.CreateBackgroundD
 		Flaws:             backgroundDto.Flaws,
 		Trait:             backgroundDto.Trait,
 		ToolProficiencies: backgroundDto.ToolProficiencies,
+		CampaignId:        backgroundDto.CampaignId,
 	}
 
 	createdBackground, err := s.backgroundRepo.Create(backgroundDomain)
@@ -63,17 +82,22 @@ func (s *backgroundService) UpdateBackground(backgroundDto dto.CreateBackgroundD
 		Flaws:             backgroundDto.Flaws,
 		Trait:             backgroundDto.Trait,
 		ToolProficiencies: backgroundDto.ToolProficiencies,
+		CampaignId:        backgroundDto.CampaignId,
 	}
 
-	updatedBackground, err := s.backgroundRepo.UpdateBackground(backgroundDomain, id)
-	if err != nil {
+	if _, err := s.backgroundRepo.UpdateBackground(backgroundDomain, id); err != nil {
 		fmt.Println(err)
 		return domain.Background{}, err
 	}
 
-	return updatedBackground, nil
+	return s.backgroundRepo.GetBackgroundById(id)
 }
 
 func (s *backgroundService) DeleteBackground(id int) error {
 	return s.backgroundRepo.DeleteBackground(id)
 }
+
+// Search implements BackgroundService.
+func (s *backgroundService) Search(params catalog.Params) (catalog.Page[domain.Background], error) {
+	return s.backgroundRepo.Search(params)
+}
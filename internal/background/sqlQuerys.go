@@ -1,9 +1,11 @@
 package background
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
 	QueryCreateBackground = `
-		INSERT INTO background (name, languages, personality_traits, ideals, bond, flaws, trait, tool_proficiencies)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+		INSERT INTO background (name, languages, personality_traits, ideals, bond, flaws, trait, tool_proficiencies, campaign_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
 	`
 
 	QueryGetAllBackgrounds = `
@@ -16,11 +18,31 @@ var (
 
 	QueryUpdateBackground = `
 		UPDATE background
-		SET name = ?, languages = ?, personality_traits = ?, ideals = ?, bond = ?, flaws = ?, trait = ?, tool_proficiencies = ?
+		SET name = ?, languages = ?, personality_traits = ?, ideals = ?, bond = ?, flaws = ?, trait = ?, tool_proficiencies = ?, campaign_id = ?
 		WHERE background_id = ?;
 	`
 
 	QueryDeleteBackground = `
 		DELETE FROM background WHERE background_id = ?;
 	`
+
+	QuerySetFeature = `UPDATE background SET feature_id = ? WHERE background_id = ?;`
+
+	QueryGetEquipment       = `SELECT background_equipment_id, background_id, kind, equipment_id, quantity FROM background_equipment WHERE background_id = ? ORDER BY background_equipment_id;`
+	QueryInsertEquipment    = `INSERT INTO background_equipment (background_id, kind, equipment_id, quantity) VALUES (?, ?, ?, ?);`
+	QueryDeleteEquipment    = `DELETE FROM background_equipment WHERE background_equipment_id = ? AND background_id = ?;`
+	QueryDeleteAllEquipment = `DELETE FROM background_equipment WHERE background_id = ?;`
+
+	QueryDeleteSkills        = `DELETE FROM background_skills WHERE background_id = ?;`
+	QueryDeleteProficiencies = `DELETE FROM background_proficiency WHERE background_id = ?;`
 )
+
+// CatalogTable describes how GET /background searches and sorts backgrounds.
+var CatalogTable = catalog.Table{
+	Name:           "background",
+	Id:             "background_id",
+	Search:         []string{"name", "trait"},
+	Sorts:          map[string]string{"name": "name"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
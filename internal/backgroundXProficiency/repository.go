@@ -53,3 +53,21 @@ func (r *backgroundXProficiency) Delete(backgroundXProficiency domain.Background
 	}
 	return nil
 }
+
+func (r *backgroundXProficiency) GetByBackgroundId(backgroundId int) ([]domain.BackgroundXProficiency, error) {
+	rows, err := r.db.Query(QueryGetByBackgroundId, backgroundId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	backgroundXProficiencies := []domain.BackgroundXProficiency{}
+	for rows.Next() {
+		var backgroundXProficiency domain.BackgroundXProficiency
+		if err := rows.Scan(&backgroundXProficiency.BackgroundID, &backgroundXProficiency.ProficiencyID); err != nil {
+			return nil, err
+		}
+		backgroundXProficiencies = append(backgroundXProficiencies, backgroundXProficiency)
+	}
+	return backgroundXProficiencies, rows.Err()
+}
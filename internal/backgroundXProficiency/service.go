@@ -21,3 +21,7 @@ func (s *service) Create(backgroundXProficiency domain.BackgroundXProficiency) (
 func (s *service) Delete(backgroundXProficiency domain.BackgroundXProficiency) error {
 	return s.repository.Delete(backgroundXProficiency)
 }
+
+func (s *service) GetByBackgroundId(backgroundId int) ([]domain.BackgroundXProficiency, error) {
+	return s.repository.GetByBackgroundId(backgroundId)
+}
@@ -3,4 +3,5 @@ package backgroundXproficiency
 var(
 	QueryInsert = "INSERT INTO background_proficiency (background_id, proficiency_id) values(?,?);"
 	QueryDelete = "DELETE FROM background_proficiency WHERE background_id=? AND proficiency_id=?;"
+	QueryGetByBackgroundId = "SELECT background_id, proficiency_id FROM background_proficiency WHERE background_id=?;"
 )
\ No newline at end of file
@@ -16,6 +16,10 @@ type backgroundSkillsSqlRepository struct {
 	db *sql.DB
 }
 
+func NewBackgroundXSkillsRepository(db *sql.DB) RepositoryBackgroundXSkills {
+	return &backgroundSkillsSqlRepository{db: db}
+}
+
 func (r *backgroundSkillsSqlRepository) CreateBackgroundXSkills(data domain.BackgroundXSkills) (domain.BackgroundXSkills, error) {
 	statement, err := r.db.Prepare(QueryCreateBackgroundXSkills)
 	if err != nil {
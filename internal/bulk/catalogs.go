@@ -0,0 +1,375 @@
+package bulk
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/armor"
+	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/item"
+	"github.com/proyecto-dnd/backend/internal/race"
+	"github.com/proyecto-dnd/backend/internal/resource"
+	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/weapon"
+)
+
+var (
+	errMissingName   = errors.New("name is required")
+	errSpellLevel    = errors.New("level must be between 0 and 9")
+	errNegativeValue = errors.New("weight and price can not be negative")
+)
+
+// entries is a catalog type that can be imported and exported.
+type entries interface {
+	table() catalog.Table
+	columns() []column
+	// parse decodes and validates a row, returning the entry ready to be
+	// stored in the campaign.
+	parse(values map[string]json.RawMessage, campaignId *int) (entry, error)
+	list(params catalog.Params) ([]listed, error)
+	// queries are the statements that insert and update an entry. Both take
+	// the values of a parsed entry, the update followed by the entry id.
+	queries() (insert string, update string)
+}
+
+// entry is a validated row. Translations are nil when the row leaves them
+// out, so the ones stored are kept.
+type entry struct {
+	name         string
+	translations dto.TranslationsDto
+	values       []any
+	owned        func(id int) []catalog.Statement
+}
+
+// listed is an entry of a catalog as the request that would create it.
+type listed struct {
+	id      int
+	request any
+}
+
+// catalogEntries adapts a catalog type. T is what the catalog lists and R the
+// request its create and update endpoints accept; files hold the fields of R.
+// Entries are stored with the insert and update queries of the catalog, which
+// take the same values in the same order.
+type catalogEntries[T any, R any] struct {
+	catalogTable catalog.Table
+	search       func(catalog.Params) (catalog.Page[T], error)
+	id           func(T) int
+	request      func(T) R
+	name         func(R) string
+	campaign     func(*R) **int
+	validate     func(R) error
+	insert       string
+	update       string
+	values       func(R) []any
+	// owned replaces the rows an entry owns, such as the resources of a
+	// feature.
+	owned func(R, int) []catalog.Statement
+}
+
+func (c catalogEntries[T, R]) table() catalog.Table {
+	return c.catalogTable
+}
+
+func (c catalogEntries[T, R]) queries() (string, string) {
+	return c.insert, c.update
+}
+
+func (c catalogEntries[T, R]) columns() []column {
+	return append(columns(reflect.TypeOf((*R)(nil)).Elem()), translationsColumn)
+}
+
+func (c catalogEntries[T, R]) parse(values map[string]json.RawMessage, campaignId *int) (entry, error) {
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return entry{}, err
+	}
+	var request R
+	if err := json.Unmarshal(encoded, &request); err != nil {
+		return entry{}, err
+	}
+	*c.campaign(&request) = campaignId
+	name := strings.TrimSpace(c.name(request))
+	if name == "" {
+		return entry{}, errMissingName
+	}
+	translations, err := readTranslations(values)
+	if err != nil {
+		return entry{name: name}, err
+	}
+	if c.validate != nil {
+		if err := c.validate(request); err != nil {
+			return entry{name: name}, err
+		}
+	}
+	parsed := entry{name: name, translations: translations, values: c.values(request)}
+	if c.owned != nil {
+		parsed.owned = func(id int) []catalog.Statement { return c.owned(request, id) }
+	}
+	return parsed, nil
+}
+
+func (c catalogEntries[T, R]) list(params catalog.Params) ([]listed, error) {
+	requests := []listed{}
+	for {
+		page, err := c.search(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			requests = append(requests, listed{id: c.id(item), request: c.request(item)})
+		}
+		params.Offset += len(page.Items)
+		if len(page.Items) == 0 || params.Offset >= page.Total {
+			return requests, nil
+		}
+	}
+}
+
+func spellEntries(service spell.ServiceSpell) entries {
+	return catalogEntries[domain.Spell, dto.SpellDto]{
+		catalogTable: spell.CatalogTable,
+		search:       service.Search,
+		id:           func(s domain.Spell) int { return s.SpellId },
+		request: func(s domain.Spell) dto.SpellDto {
+			return dto.SpellDto{
+				Name:            s.Name,
+				Description:     s.Description,
+				Range:           s.Range,
+				Ritual:          s.Ritual,
+				Duration:        s.Duration,
+				Concentration:   s.Concentration,
+				CastingTime:     s.CastingTime,
+				Level:           s.Level,
+				DamageType:      s.DamageType,
+				DifficultyClass: s.DifficultyClass,
+				Aoe:             s.Aoe,
+				School:          s.School,
+			}
+		},
+		name:     func(r dto.SpellDto) string { return r.Name },
+		campaign: func(r *dto.SpellDto) **int { return &r.CampaignId },
+		validate: func(r dto.SpellDto) error {
+			if r.Level < 0 || r.Level > 9 {
+				return errSpellLevel
+			}
+			return nil
+		},
+		insert: spell.QueryCreateSpell,
+		update: spell.QueryUpdate,
+		values: func(r dto.SpellDto) []any {
+			return []any{r.Name, r.Description, r.Range, r.Ritual, r.Duration, r.Concentration, r.CastingTime, r.Level, r.DamageType, r.DifficultyClass, r.Aoe, r.School, r.CampaignId}
+		},
+	}
+}
+
+func raceEntries(service race.RaceService) entries {
+	return catalogEntries[domain.Race, dto.CreateRaceDto]{
+		catalogTable: race.CatalogTable,
+		search:       service.Search,
+		id:           func(r domain.Race) int { return r.RaceID },
+		request: func(r domain.Race) dto.CreateRaceDto {
+			return dto.CreateRaceDto{
+				Name:        r.Name,
+				Description: r.Description,
+				Speed:       r.Speed,
+				Str:         r.Str,
+				Dex:         r.Dex,
+				Int:         r.Int,
+				Con:         r.Con,
+				Wiz:         r.Wiz,
+				Cha:         r.Cha,
+			}
+		},
+		name:     func(r dto.CreateRaceDto) string { return r.Name },
+		campaign: func(r *dto.CreateRaceDto) **int { return &r.CampaignId },
+		insert:   race.QueryCreateRace,
+		update:   race.QueryUpdateRace,
+		values: func(r dto.CreateRaceDto) []any {
+			return []any{r.Name, r.Description, r.Speed, r.Str, r.Dex, r.Int, r.Con, r.Wiz, r.Cha, r.CampaignId}
+		},
+	}
+}
+
+func classEntries(service class.ClassService) entries {
+	return catalogEntries[domain.Class, dto.ClassDto]{
+		catalogTable: class.CatalogTable,
+		search:       service.Search,
+		id:           func(c domain.Class) int { return c.ClassId },
+		request: func(c domain.Class) dto.ClassDto {
+			return dto.ClassDto{
+				Name:                c.Name,
+				Description:         c.Description,
+				ProficiencyBonus:    c.ProficiencyBonus,
+				HitDice:             c.HitDice,
+				ArmorProficiencies:  c.ArmorProficiencies,
+				WeaponProficiencies: c.WeaponProficiencies,
+				ToolProficiencies:   c.ToolProficiencies,
+				SpellcastingAbility: c.SpellcastingAbility,
+			}
+		},
+		name:     func(r dto.ClassDto) string { return r.Name },
+		campaign: func(r *dto.ClassDto) **int { return &r.CampaignId },
+		insert:   class.QueryInsertClass,
+		update:   class.QueryUpdateClass,
+		values: func(r dto.ClassDto) []any {
+			return []any{r.Name, r.Description, r.ProficiencyBonus, r.HitDice, r.ArmorProficiencies, r.WeaponProficiencies, r.ToolProficiencies, r.SpellcastingAbility, r.CampaignId}
+		},
+	}
+}
+
+func featureEntries(service feature.FeatureService) entries {
+	return catalogEntries[domain.Feature, dto.CreateFeatureDto]{
+		catalogTable: feature.CatalogTable,
+		search:       service.Search,
+		id:           func(f domain.Feature) int { return f.FeatureId },
+		request: func(f domain.Feature) dto.CreateFeatureDto {
+			return dto.CreateFeatureDto{Name: f.Name, Description: f.Description, Resources: f.Resources}
+		},
+		name:     func(r dto.CreateFeatureDto) string { return r.Name },
+		campaign: func(r *dto.CreateFeatureDto) **int { return &r.CampaignId },
+		validate: func(r dto.CreateFeatureDto) error {
+			for _, definition := range r.Resources {
+				if err := resource.Validate(definition); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		insert: feature.QueryCreateFeature,
+		update: feature.QueryUpdate,
+		values: func(r dto.CreateFeatureDto) []any {
+			return []any{r.Name, r.Description, r.CampaignId}
+		},
+		owned: func(r dto.CreateFeatureDto, id int) []catalog.Statement {
+			// Rows without resources keep the ones stored.
+			if r.Resources == nil {
+				return nil
+			}
+			statements := []catalog.Statement{{Query: feature.QueryDeleteResources, Args: []any{id}}}
+			for _, definition := range r.Resources {
+				statements = append(statements, catalog.Statement{Query: feature.QueryCreateResource, Args: []any{id, definition.Name, definition.Maximum, definition.Recharge}})
+			}
+			return statements
+		},
+	}
+}
+
+func backgroundEntries(service background.BackgroundService) entries {
+	return catalogEntries[domain.Background, dto.CreateBackgroundDto]{
+		catalogTable: background.CatalogTable,
+		search:       service.Search,
+		id:           func(b domain.Background) int { return b.BackgroundID },
+		request: func(b domain.Background) dto.CreateBackgroundDto {
+			return dto.CreateBackgroundDto{
+				Name:              b.Name,
+				Languages:         b.Languages,
+				PersonalityTraits: b.PersonalityTraits,
+				Ideals:            b.Ideals,
+				Bond:              b.Bond,
+				Flaws:             b.Flaws,
+				Trait:             b.Trait,
+				ToolProficiencies: b.ToolProficiencies,
+			}
+		},
+		name:     func(r dto.CreateBackgroundDto) string { return r.Name },
+		campaign: func(r *dto.CreateBackgroundDto) **int { return &r.CampaignId },
+		insert:   background.QueryCreateBackground,
+		update:   background.QueryUpdateBackground,
+		values: func(r dto.CreateBackgroundDto) []any {
+			return []any{r.Name, r.Languages, r.PersonalityTraits, r.Ideals, r.Bond, r.Flaws, r.Trait, r.ToolProficiencies, r.CampaignId}
+		},
+	}
+}
+
+func armorEntries(service armor.ArmorService) entries {
+	return catalogEntries[domain.Armor, dto.CreateArmorDto]{
+		catalogTable: armor.CatalogTable,
+		search:       service.Search,
+		id:           func(a domain.Armor) int { return a.ArmorId },
+		request: func(a domain.Armor) dto.CreateArmorDto {
+			return dto.CreateArmorDto{
+				Material:           a.Material,
+				Name:               a.Name,
+				Weight:             a.Weight,
+				Price:              a.Price,
+				Category:           a.Category,
+				ProtectionType:     a.ProtectionType,
+				Description:        a.Description,
+				Penalty:            a.Penalty,
+				Strength:           a.Strength,
+				ArmorClass:         a.ArmorClass,
+				DexBonus:           a.DexBonus,
+				MaxDexBonus:        a.MaxDexBonus,
+				RequiresAttunement: a.RequiresAttunement,
+			}
+		},
+		name:     func(r dto.CreateArmorDto) string { return r.Name },
+		campaign: func(r *dto.CreateArmorDto) **int { return &r.CampaignId },
+		validate: func(r dto.CreateArmorDto) error { return checkWeightAndPrice(r.Weight, r.Price) },
+		insert:   armor.QueryCreateArmor,
+		update:   armor.QueryUpdateArmor,
+		values: func(r dto.CreateArmorDto) []any {
+			return []any{r.Material, r.Name, r.Weight, r.Price, r.Category, r.ProtectionType, r.Description, r.Penalty, r.Strength, r.ArmorClass, r.DexBonus, r.CampaignId, r.MaxDexBonus, r.RequiresAttunement}
+		},
+	}
+}
+
+func weaponEntries(service weapon.ServiceWeapon) entries {
+	return catalogEntries[domain.Weapon, domain.Weapon]{
+		catalogTable: weapon.CatalogTable,
+		search:       service.Search,
+		id:           func(w domain.Weapon) int { return w.Weapon_Id },
+		request: func(w domain.Weapon) domain.Weapon {
+			w.Weapon_Id, w.Campaign_Id = 0, nil
+			return w
+		},
+		name:     func(r domain.Weapon) string { return r.Name },
+		campaign: func(r *domain.Weapon) **int { return &r.Campaign_Id },
+		validate: func(r domain.Weapon) error { return checkWeightAndPrice(r.Weight, r.Price) },
+		insert:   weapon.QueryCreateWeapon,
+		update:   weapon.QueryUpdate,
+		values: func(r domain.Weapon) []any {
+			return []any{r.Weapon_Type, r.Name, r.Weight, r.Price, r.Category, r.Reach, r.Description, r.Damage, r.Versatile_Damage, r.Ammunition, r.Damage_Type, r.Campaign_Id, r.TwoHanded, r.Finesse, r.Ranged, r.RequiresAttunement}
+		},
+	}
+}
+
+func itemEntries(service item.ServiceItem) entries {
+	return catalogEntries[domain.Item, domain.Item]{
+		catalogTable: item.CatalogTable,
+		search:       service.Search,
+		id:           func(i domain.Item) int { return i.Item_Id },
+		request: func(i domain.Item) domain.Item {
+			i.Item_Id, i.Campaign_Id = 0, nil
+			return i
+		},
+		name:     func(r domain.Item) string { return r.Name },
+		campaign: func(r *domain.Item) **int { return &r.Campaign_Id },
+		validate: func(r domain.Item) error {
+			if err := checkWeightAndPrice(r.Weight, r.Price); err != nil {
+				return err
+			}
+			return item.Validate(r)
+		},
+		insert: item.QueryCreateItem,
+		update: item.QueryUpdate,
+		values: func(r domain.Item) []any {
+			return []any{r.Name, r.Weight, r.Price, r.Description, r.Campaign_Id, strings.ToLower(r.Rarity), r.RequiresAttunement, r.AttunementClasses, r.AttunementAlignments, r.Charges, r.Recharge, r.RechargeDice, r.ArmorClassBonus, r.SavingThrowBonus, r.AttackBonus, r.DamageBonus}
+		},
+	}
+}
+
+func checkWeightAndPrice(weight int, price int) error {
+	if weight < 0 || price < 0 {
+		return errNegativeValue
+	}
+	return nil
+}
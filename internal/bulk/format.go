@@ -0,0 +1,281 @@
+package bulk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+	FormatXLSX = "xlsx"
+)
+
+// column is one field of a create request as it appears in a file.
+type column struct {
+	name string
+	kind reflect.Kind
+}
+
+// record is one row of a file as JSON values by column, so every format is
+// decoded into a request the same way.
+type record struct {
+	line   int
+	values map[string]json.RawMessage
+	errors []string
+}
+
+// columns lists the fields of a request type that are read from and written to
+// files. Ids and the campaign are set by the import itself, so they are left out.
+func columns(request reflect.Type) []column {
+	list := []column{}
+	for i := 0; i < request.NumField(); i++ {
+		field := request.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "" || name == "-" || ignoredColumn(name) {
+			continue
+		}
+		kind := field.Type.Kind()
+		if kind == reflect.Pointer {
+			kind = field.Type.Elem().Kind()
+		}
+		list = append(list, column{name: name, kind: kind})
+	}
+	return list
+}
+
+func ignoredColumn(name string) bool {
+	return strings.HasSuffix(name, "_id")
+}
+
+// readRecords reads the rows of a file. Errors in a single row are kept on its
+// record; only a file that can not be read at all fails.
+func readRecords(format string, reader io.Reader, columns []column) ([]record, error) {
+	switch format {
+	case FormatJSON:
+		return readJSON(reader, columns)
+	case FormatCSV:
+		rows, err := csv.NewReader(reader).ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFile, err)
+		}
+		return readTable(rows, columns)
+	case FormatXLSX:
+		file, err := excelize.OpenReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFile, err)
+		}
+		return readTable(file.GetRows(file.GetSheetName(1)), columns)
+	}
+	return nil, ErrUnknownFormat
+}
+
+func readJSON(reader io.Reader, columns []column) ([]record, error) {
+	var rows []json.RawMessage
+	if err := json.NewDecoder(reader).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFile, err)
+	}
+	records := make([]record, 0, len(rows))
+	for i, row := range rows {
+		current := record{line: i + 1, values: map[string]json.RawMessage{}}
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(row, &values); err != nil {
+			current.errors = append(current.errors, "the row is not a JSON object")
+			records = append(records, current)
+			continue
+		}
+		for name, value := range values {
+			switch {
+			case ignoredColumn(name):
+			case slices.ContainsFunc(columns, func(c column) bool { return c.name == name }):
+				current.values[name] = value
+			default:
+				current.errors = append(current.errors, fmt.Sprintf("unknown column %s", name))
+			}
+		}
+		slices.Sort(current.errors)
+		records = append(records, current)
+	}
+	return records, nil
+}
+
+// readTable reads CSV or XLSX rows, whose first row names the columns. Blank
+// rows are skipped.
+func readTable(rows [][]string, columns []column) ([]record, error) {
+	if len(rows) == 0 {
+		return nil, ErrEmptyFile
+	}
+	header := make([]column, len(rows[0]))
+	for i, name := range rows[0] {
+		name = strings.TrimSpace(name)
+		index := slices.IndexFunc(columns, func(c column) bool { return c.name == name })
+		switch {
+		case index >= 0:
+			header[i] = columns[index]
+		case name == "" || ignoredColumn(name):
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownColumn, name)
+		}
+	}
+
+	records := []record{}
+	for i, row := range rows[1:] {
+		if !slices.ContainsFunc(row, func(cell string) bool { return strings.TrimSpace(cell) != "" }) {
+			continue
+		}
+		current := record{line: i + 2, values: map[string]json.RawMessage{}}
+		for j, cell := range row {
+			if j >= len(header) || header[j].name == "" {
+				continue
+			}
+			value, err := cellValue(header[j], cell)
+			if err != nil {
+				current.errors = append(current.errors, fmt.Sprintf("%s: %q is not a valid value", header[j].name, cell))
+				continue
+			}
+			if value != nil {
+				current.values[header[j].name] = value
+			}
+		}
+		records = append(records, current)
+	}
+	return records, nil
+}
+
+// cellValue turns a cell into the JSON value of its column. Empty cells of
+// columns that are not text are left out, so they take their zero value.
+func cellValue(c column, cell string) (json.RawMessage, error) {
+	if c.kind == reflect.String {
+		return json.Marshal(cell)
+	}
+	cell = strings.TrimSpace(cell)
+	if cell == "" {
+		return nil, nil
+	}
+	switch c.kind {
+	case reflect.Bool:
+		value, err := strconv.ParseBool(cell)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.Atoi(cell)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	}
+	// Lists and objects, such as feature resources, are written as JSON.
+	if !json.Valid([]byte(cell)) {
+		return nil, ErrInvalidFile
+	}
+	return json.RawMessage(cell), nil
+}
+
+// writeRecords encodes requests in a file that imports back unchanged. Only
+// the columns are written, leaving ids and the campaign out.
+func writeRecords(format string, sheet string, columns []column, requests []any) ([]byte, error) {
+	records := make([]map[string]json.RawMessage, len(requests))
+	for i, request := range requests {
+		encoded, err := json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(encoded, &values); err != nil {
+			return nil, err
+		}
+		records[i] = map[string]json.RawMessage{}
+		for _, c := range columns {
+			records[i][c.name] = values[c.name]
+		}
+	}
+	if format == FormatJSON {
+		return json.MarshalIndent(records, "", "  ")
+	}
+
+	rows := [][]any{make([]any, len(columns))}
+	for i, c := range columns {
+		rows[0][i] = c.name
+	}
+	for _, values := range records {
+		var err error
+		row := make([]any, len(columns))
+		for i, c := range columns {
+			if row[i], err = cellContent(c, values[c.name]); err != nil {
+				return nil, err
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	switch format {
+	case FormatCSV:
+		var buffer bytes.Buffer
+		writer := csv.NewWriter(&buffer)
+		for _, row := range rows {
+			cells := make([]string, len(row))
+			for i, cell := range row {
+				cells[i] = fmt.Sprint(cell)
+			}
+			if err := writer.Write(cells); err != nil {
+				return nil, err
+			}
+		}
+		writer.Flush()
+		return buffer.Bytes(), writer.Error()
+	case FormatXLSX:
+		file := excelize.NewFile()
+		file.SetSheetName("Sheet1", sheet)
+		for i, row := range rows {
+			for j, cell := range row {
+				file.SetCellValue(sheet, excelize.ToAlphaString(j)+strconv.Itoa(i+1), cell)
+			}
+		}
+		buffer, err := file.WriteToBuffer()
+		if err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+// cellContent is the inverse of cellValue. Numbers and booleans keep their
+// type so spreadsheets show them as such.
+func cellContent(c column, value json.RawMessage) (any, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return "", nil
+	}
+	switch c.kind {
+	case reflect.String:
+		var text string
+		err := json.Unmarshal(value, &text)
+		return text, err
+	case reflect.Bool:
+		var flag bool
+		err := json.Unmarshal(value, &flag)
+		return flag, err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var number int
+		err := json.Unmarshal(value, &number)
+		return number, err
+	}
+	return string(value), nil
+}
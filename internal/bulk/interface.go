@@ -0,0 +1,34 @@
+package bulk
+
+import (
+	"io"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryBulk interface {
+	GetIdByName(table catalog.Table, name string, campaignId *int) (int, error)
+	// Store runs every write inside one transaction, or nothing at all.
+	Store(catalogName string, writes []Write) error
+}
+
+// Write stores one imported row: it updates the entry with Id, or inserts a
+// new one when Id is 0. Insert and Update take Values, Update followed by the
+// id. Owned statements then replace the rows the entry owns, and Translations,
+// unless nil, replace the ones stored.
+type Write struct {
+	Row          int
+	Id           int
+	Insert       string
+	Update       string
+	Values       []any
+	Owned        func(id int) []catalog.Statement
+	Translations []domain.Translation
+}
+
+type ServiceBulk interface {
+	Import(catalogName string, format string, file io.Reader, campaignId *int, dryRun bool) (dto.CatalogImportReportDto, error)
+	Export(catalogName string, format string, campaignId *int, viewer string) ([]byte, error)
+}
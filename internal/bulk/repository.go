@@ -0,0 +1,86 @@
+package bulk
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/translation"
+)
+
+var (
+	ErrNotFound = errors.New("catalog entry not found")
+)
+
+type bulkMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewBulkRepository(db *sql.DB) RepositoryBulk {
+	return &bulkMySqlRepository{db: db}
+}
+
+// GetIdByName implements RepositoryBulk.
+func (r *bulkMySqlRepository) GetIdByName(table catalog.Table, name string, campaignId *int) (int, error) {
+	var id int
+	err := r.db.QueryRow(fmt.Sprintf(QueryGetIdByName, table.Id, table.Name), name, campaignId).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrNotFound
+	}
+	return id, err
+}
+
+// Store implements RepositoryBulk.
+func (r *bulkMySqlRepository) Store(catalogName string, writes []Write) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, write := range writes {
+		if err := store(tx, catalogName, write); err != nil {
+			return fmt.Errorf("row %d: %w", write.Row, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func store(tx *sql.Tx, catalogName string, write Write) error {
+	id := write.Id
+	if id == 0 {
+		result, err := tx.Exec(write.Insert, write.Values...)
+		if err != nil {
+			return err
+		}
+		lastId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		id = int(lastId)
+	} else if _, err := tx.Exec(write.Update, append(slices.Clone(write.Values), id)...); err != nil {
+		return err
+	}
+
+	if write.Owned != nil {
+		for _, statement := range write.Owned(id) {
+			if _, err := tx.Exec(statement.Query, statement.Args...); err != nil {
+				return err
+			}
+		}
+	}
+	if write.Translations == nil {
+		return nil
+	}
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, catalogName, id); err != nil {
+		return err
+	}
+	for _, entryTranslation := range write.Translations {
+		if _, err := tx.Exec(translation.QuerySave, catalogName, id, entryTranslation.Locale, entryTranslation.Name, entryTranslation.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
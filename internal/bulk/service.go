@@ -0,0 +1,177 @@
+package bulk
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/armor"
+	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/item"
+	"github.com/proyecto-dnd/backend/internal/race"
+	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/internal/weapon"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+)
+
+var (
+	ErrUnknownCatalog = errors.New("unknown catalog")
+	ErrUnknownFormat  = errors.New("format must be csv, json or xlsx")
+	ErrInvalidFile    = errors.New("the file could not be read")
+	ErrUnknownColumn  = errors.New("unknown column")
+	ErrEmptyFile      = errors.New("the file has no rows")
+	ErrInvalidRows    = errors.New("some rows are invalid, nothing was imported")
+)
+
+type service struct {
+	repository   RepositoryBulk
+	translations translation.ServiceTranslation
+	catalogs     map[string]entries
+}
+
+func NewBulkService(repository RepositoryBulk, translationService translation.ServiceTranslation, spellService spell.ServiceSpell, raceService race.RaceService, classService class.ClassService, featureService feature.FeatureService, backgroundService background.BackgroundService, armorService armor.ArmorService, weaponService weapon.ServiceWeapon, itemService item.ServiceItem) ServiceBulk {
+	return &service{
+		repository:   repository,
+		translations: translationService,
+		catalogs: map[string]entries{
+			"spell":      spellEntries(spellService),
+			"race":       raceEntries(raceService),
+			"class":      classEntries(classService),
+			"feature":    featureEntries(featureService),
+			"background": backgroundEntries(backgroundService),
+			"armor":      armorEntries(armorService),
+			"weapon":     weaponEntries(weaponService),
+			"item":       itemEntries(itemService),
+		},
+	}
+}
+
+// Import implements ServiceBulk. Rows are matched by name within the scope,
+// the campaign or generic content when campaignId is nil, and update the
+// entry they match or create a new one. Rows with a translations column also
+// replace the translations of their entry. Nothing is stored unless every row
+// is valid, and the rows are stored in one transaction.
+func (s *service) Import(catalogName string, format string, file io.Reader, campaignId *int, dryRun bool) (dto.CatalogImportReportDto, error) {
+	entries, ok := s.catalogs[catalogName]
+	if !ok {
+		return dto.CatalogImportReportDto{}, ErrUnknownCatalog
+	}
+	records, err := readRecords(format, file, entries.columns())
+	if err != nil {
+		return dto.CatalogImportReportDto{}, err
+	}
+	if len(records) == 0 {
+		return dto.CatalogImportReportDto{}, ErrEmptyFile
+	}
+
+	report := dto.CatalogImportReportDto{
+		Catalog:    catalogName,
+		CampaignId: campaignId,
+		DryRun:     dryRun,
+		Rows:       make([]dto.CatalogImportRowDto, len(records)),
+	}
+	parsed := make([]entry, len(records))
+	seen := map[string]int{}
+	for i, record := range records {
+		row := &report.Rows[i]
+		row.Row = record.line
+		row.Errors = record.errors
+		if len(row.Errors) == 0 {
+			parsed[i], err = entries.parse(record.values, campaignId)
+			row.Name = parsed[i].name
+			if err != nil {
+				row.Errors = append(row.Errors, err.Error())
+			}
+		}
+		if len(row.Errors) == 0 {
+			// MySQL compares names without case, so the file must too.
+			key := strings.ToLower(row.Name)
+			if line, ok := seen[key]; ok {
+				row.Errors = append(row.Errors, "the name is repeated in row "+strconv.Itoa(line))
+			}
+			seen[key] = row.Row
+		}
+		if len(row.Errors) > 0 {
+			report.Invalid++
+			continue
+		}
+
+		id, err := s.repository.GetIdByName(entries.table(), row.Name, campaignId)
+		switch {
+		case err == nil:
+			row.Action, row.Id = ActionUpdate, id
+			report.Updated++
+		case errors.Is(err, ErrNotFound):
+			row.Action = ActionCreate
+			report.Created++
+		default:
+			return dto.CatalogImportReportDto{}, err
+		}
+	}
+	if report.Invalid > 0 {
+		return report, ErrInvalidRows
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	insert, update := entries.queries()
+	writes := make([]Write, len(report.Rows))
+	for i, row := range report.Rows {
+		writes[i] = Write{Row: row.Row, Id: row.Id, Insert: insert, Update: update, Values: parsed[i].values, Owned: parsed[i].owned}
+		if parsed[i].translations != nil {
+			writes[i].Translations = translation.List(parsed[i].translations)
+		}
+	}
+	if err := s.repository.Store(catalogName, writes); err != nil {
+		return report, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+// Export implements ServiceBulk. It writes every entry of one scope, the
+// campaign or generic content when campaignId is nil, that the viewer can see,
+// with all of its translations.
+func (s *service) Export(catalogName string, format string, campaignId *int, viewer string) ([]byte, error) {
+	entries, ok := s.catalogs[catalogName]
+	if !ok {
+		return nil, ErrUnknownCatalog
+	}
+	switch format {
+	case FormatCSV, FormatJSON, FormatXLSX:
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	// Params without a limit list the whole catalog in one query.
+	params := catalog.Params{Filters: map[string][]string{}, Viewer: viewer}
+	if campaignId == nil {
+		params.Filters["generic"] = []string{"true"}
+	} else {
+		params.Filters["campaign_id"] = []string{strconv.Itoa(*campaignId)}
+	}
+	listed, err := entries.list(params)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := s.translations.GetByCatalog(catalogName)
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]any, len(listed))
+	for i, entry := range listed {
+		requests[i] = translatedRequest{request: entry.request, translations: translations[entry.id]}
+	}
+	return writeRecords(format, catalogName, entries.columns(), requests)
+}
@@ -0,0 +1,7 @@
+package bulk
+
+var (
+	// QueryGetIdByName is completed with the id column and table of a catalog.
+	// The null safe comparison matches generic entries when no campaign is given.
+	QueryGetIdByName = `SELECT %s FROM %s WHERE name = ? AND campaign_id <=> ? LIMIT 1;`
+)
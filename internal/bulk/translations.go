@@ -0,0 +1,57 @@
+package bulk
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
+)
+
+var errInvalidTranslations = errors.New(`translations must be an object such as {"en": {"name": "...", "description": "..."}}`)
+
+// translationsColumn holds every translation of an entry by locale. CSV and
+// XLSX files write it as JSON, like other objects.
+var translationsColumn = column{name: "translations", kind: reflect.Map}
+
+// readTranslations decodes the translations of a row, which are nil when the
+// row has none.
+func readTranslations(values map[string]json.RawMessage) (dto.TranslationsDto, error) {
+	value, ok := values[translationsColumn.name]
+	if !ok || string(value) == "null" {
+		return nil, nil
+	}
+	var translations dto.TranslationsDto
+	if err := json.Unmarshal(value, &translations); err != nil {
+		return nil, errInvalidTranslations
+	}
+	if translations == nil {
+		translations = dto.TranslationsDto{}
+	}
+	return translations, translation.Validate(translations)
+}
+
+// translatedRequest is an exported entry, with its translations next to the
+// fields of its request.
+type translatedRequest struct {
+	request      any
+	translations dto.TranslationsDto
+}
+
+func (t translatedRequest) MarshalJSON() ([]byte, error) {
+	encoded, err := json.Marshal(t.request)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &values); err != nil {
+		return nil, err
+	}
+	if len(t.translations) > 0 {
+		if values[translationsColumn.name], err = json.Marshal(t.translations); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(values)
+}
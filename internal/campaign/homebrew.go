@@ -0,0 +1,43 @@
+package campaign
+
+import "errors"
+
+var (
+	ErrNotHomebrewDungeonMaster = errors.New("only the campaign dungeon master can manage its homebrew content")
+	ErrNotCampaignMember        = errors.New("only the campaign members can use its homebrew content")
+)
+
+// CheckDungeonMaster implements CampaignService. Homebrew content of a
+// campaign can only be created, changed or deleted by its dungeon master.
+func (s *service) CheckDungeonMaster(campaignId int, userId string) error {
+	campaign, err := s.campaignRepository.GetById(campaignId)
+	if err != nil {
+		return err
+	}
+	if campaign.DungeonMaster != userId {
+		return ErrNotHomebrewDungeonMaster
+	}
+	return nil
+}
+
+// CheckMember implements CampaignService. Homebrew content of a campaign can
+// only be seen and used by its dungeon master and its players.
+func (s *service) CheckMember(campaignId int, userId string) error {
+	campaign, err := s.campaignRepository.GetById(campaignId)
+	if err != nil {
+		return err
+	}
+	if campaign.DungeonMaster == userId {
+		return nil
+	}
+	users, err := s.campaignRepository.GetUsersData(campaignId)
+	if err != nil {
+		return err
+	}
+	for _, member := range users {
+		if member.Id == userId {
+			return nil
+		}
+	}
+	return ErrNotCampaignMember
+}
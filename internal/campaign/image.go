@@ -0,0 +1,25 @@
+package campaign
+
+import "errors"
+
+var ErrNotDungeonMaster = errors.New("only the campaign dungeon master can change its image")
+
+// SetImage implements CampaignService. It returns the image URL that was
+// replaced so the caller can remove the old image.
+func (s *service) SetImage(id int, url string, cookie string) (string, error) {
+	user, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return "", err
+	}
+	campaign, err := s.campaignRepository.GetById(id)
+	if err != nil {
+		return "", err
+	}
+	if campaign.DungeonMaster != user.Id {
+		return "", ErrNotDungeonMaster
+	}
+	if err := s.campaignRepository.UpdateImage(id, url); err != nil {
+		return "", err
+	}
+	return campaign.Image, nil
+}
@@ -22,6 +22,9 @@ type CampaignService interface {
 	GetCampaignsByUserId(cookie string) ([]dto.ResponseCampaignDto, error)
 	UpdateCampaign(Campaign dto.CreateCampaignDto, id int) (dto.ResponseCampaignDto, error)
 	DeleteCampaign(id int) error
+	SetImage(id int, url string, cookie string) (string, error)
+	CheckDungeonMaster(campaignId int, userId string) error
+	CheckMember(campaignId int, userId string) error
 }
 
 type CampaignRepository interface {
@@ -31,5 +34,6 @@ type CampaignRepository interface {
 	GetCampaignsByUserId(id string) ([]domain.Campaign, error)
 	GetUsersData(id int) ([]domain.UserResponse, error)
 	Update(Campaign domain.Campaign, id int) (domain.Campaign, error)
+	UpdateImage(id int, url string) error
 	Delete(id int) error
 }
@@ -12,6 +12,7 @@ import (
 var (
 	ErrPrepareStatement    = errors.New("error preparing statement")
 	ErrGettingLastInsertId = errors.New("error getting last insert id")
+	ErrNotFound            = errors.New("campaign not found")
 )
 
 type campaignMySqlRepository struct {
@@ -77,7 +78,7 @@ func (r *campaignMySqlRepository) GetById(id int) (domain.Campaign, error) {
 	err := r.db.QueryRow(QueryGetById, id).Scan(&campaign.CampaignId, &campaign.DungeonMaster, &campaign.Name, &campaign.Description, &campaign.Image, &campaign.Notes, &campaign.Status, &campaign.Images)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return domain.Campaign{}, errors.New("campaign not found")
+			return domain.Campaign{}, ErrNotFound
 		}
 		return domain.Campaign{}, err
 	}
@@ -140,6 +141,11 @@ func (r *campaignMySqlRepository) Update(campaign domain.Campaign, id int) (doma
 	return campaign, nil
 }
 
+func (r *campaignMySqlRepository) UpdateImage(id int, url string) error {
+	_, err := r.db.Exec(QueryUpdateImage, url, id)
+	return err
+}
+
 func (r *campaignMySqlRepository) Delete(id int) error {
 	statement, err := r.db.Prepare(QueryDelete)
 	if err != nil {
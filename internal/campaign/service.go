@@ -169,6 +169,7 @@ func (s *service) GetCampaignByID(id int) (dto.ResponseCampaignDto, error) {
 		Name:          campaign.Name,
 		Description:   campaign.Description,
 		Image:         campaign.Image,
+		ImageUrls:     dto.NewImageUrlsDto(campaign.Image),
 		Notes:         campaign.Notes,
 		Status:        campaign.Status,
 		Sessions:      <-sessionsChan,
@@ -213,6 +214,7 @@ func (s *service) GetCampaignsByUserId(cookie string) ([]dto.ResponseCampaignDto
 			Name:          campaign.Name,
 			Description:   campaign.Description,
 			Image:         campaign.Image,
+			ImageUrls:     dto.NewImageUrlsDto(campaign.Image),
 			Notes:         campaign.Notes,
 			Status:        campaign.Status,
 			Sessions:      sessions,
@@ -252,6 +254,7 @@ func (s *service) UpdateCampaign(campaignDto dto.CreateCampaignDto, id int) (dto
 		Name:          updatedCampaign.Name,
 		Description:   updatedCampaign.Description,
 		Image:         updatedCampaign.Image,
+		ImageUrls:     dto.NewImageUrlsDto(updatedCampaign.Image),
 		Notes:         updatedCampaign.Notes,
 		Status:        updatedCampaign.Status,
 		Images:        updatedCampaign.Images,
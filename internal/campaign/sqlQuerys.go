@@ -34,6 +34,10 @@ var (
 		WHERE campaign_id = ?;
 	`
 
+	QueryUpdateImage = `
+		UPDATE campaign SET image = ? WHERE campaign_id = ?;
+	`
+
 	QueryDelete = `
 		DELETE FROM campaign WHERE campaign_id = ?;
 	`
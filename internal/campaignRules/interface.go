@@ -0,0 +1,14 @@
+package campaignrules
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+type RepositoryCampaignRules interface {
+	GetByCampaignId(campaignId int) (domain.CampaignRules, error)
+	Upsert(rules domain.CampaignRules) (domain.CampaignRules, error)
+	GetDungeonMaster(campaignId int) (string, error)
+}
+
+type ServiceCampaignRules interface {
+	GetByCampaignId(campaignId int) (domain.CampaignRules, error)
+	Update(rules domain.CampaignRules, cookie string) (domain.CampaignRules, error)
+}
@@ -0,0 +1,53 @@
+package campaignrules
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrNotFound         = errors.New("campaign rules not found")
+	ErrCampaignNotFound = errors.New("campaign not found")
+)
+
+type campaignRulesMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewCampaignRulesRepository(db *sql.DB) RepositoryCampaignRules {
+	return &campaignRulesMySqlRepository{db: db}
+}
+
+// GetByCampaignId implements RepositoryCampaignRules.
+func (r *campaignRulesMySqlRepository) GetByCampaignId(campaignId int) (domain.CampaignRules, error) {
+	var rules domain.CampaignRules
+	err := r.db.QueryRow(QueryGetByCampaignId, campaignId).Scan(&rules.CampaignId, &rules.FreeSpellSelection, &rules.VariantEncumbrance)
+	if err == sql.ErrNoRows {
+		return domain.CampaignRules{}, ErrNotFound
+	}
+	if err != nil {
+		return domain.CampaignRules{}, err
+	}
+	return rules, nil
+}
+
+// Upsert implements RepositoryCampaignRules.
+func (r *campaignRulesMySqlRepository) Upsert(rules domain.CampaignRules) (domain.CampaignRules, error) {
+	_, err := r.db.Exec(QueryUpsert, rules.CampaignId, rules.FreeSpellSelection, rules.VariantEncumbrance)
+	if err != nil {
+		return domain.CampaignRules{}, err
+	}
+	return rules, nil
+}
+
+// GetDungeonMaster implements RepositoryCampaignRules.
+func (r *campaignRulesMySqlRepository) GetDungeonMaster(campaignId int) (string, error) {
+	var dungeonMaster string
+	err := r.db.QueryRow(QueryGetDungeonMaster, campaignId).Scan(&dungeonMaster)
+	if err == sql.ErrNoRows {
+		return "", ErrCampaignNotFound
+	}
+	return dungeonMaster, err
+}
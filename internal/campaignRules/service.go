@@ -0,0 +1,49 @@
+package campaignrules
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/user"
+)
+
+var ErrNotDungeonMaster = errors.New("only the campaign dungeon master can change its rules")
+
+type service struct {
+	repository  RepositoryCampaignRules
+	userService user.ServiceUsers
+}
+
+func NewCampaignRulesService(repository RepositoryCampaignRules, userService user.ServiceUsers) ServiceCampaignRules {
+	return &service{repository: repository, userService: userService}
+}
+
+// GetByCampaignId implements ServiceCampaignRules. Campaigns that never
+// changed their rules play by the defaults.
+func (s *service) GetByCampaignId(campaignId int) (domain.CampaignRules, error) {
+	rules, err := s.repository.GetByCampaignId(campaignId)
+	if err == ErrNotFound {
+		return defaultRules(campaignId), nil
+	}
+	return rules, err
+}
+
+// Update implements ServiceCampaignRules.
+func (s *service) Update(rules domain.CampaignRules, cookie string) (domain.CampaignRules, error) {
+	user, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return domain.CampaignRules{}, err
+	}
+	dungeonMaster, err := s.repository.GetDungeonMaster(rules.CampaignId)
+	if err != nil {
+		return domain.CampaignRules{}, err
+	}
+	if dungeonMaster != user.Id {
+		return domain.CampaignRules{}, ErrNotDungeonMaster
+	}
+	return s.repository.Upsert(rules)
+}
+
+func defaultRules(campaignId int) domain.CampaignRules {
+	return domain.CampaignRules{CampaignId: campaignId}
+}
@@ -0,0 +1,7 @@
+package campaignrules
+
+var (
+	QueryGetByCampaignId  = `SELECT campaign_id, free_spell_selection, variant_encumbrance FROM campaign_rules WHERE campaign_id = ?;`
+	QueryUpsert           = `INSERT INTO campaign_rules (campaign_id, free_spell_selection, variant_encumbrance) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE free_spell_selection = VALUES(free_spell_selection), variant_encumbrance = VALUES(variant_encumbrance);`
+	QueryGetDungeonMaster = `SELECT dungeon_master FROM campaign WHERE campaign_id = ?;`
+)
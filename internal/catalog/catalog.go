@@ -0,0 +1,266 @@
+package catalog
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+var (
+	ErrUnknownFilter = errors.New("unknown filter")
+	ErrInvalidFilter = errors.New("invalid filter value")
+	ErrUnknownSort   = errors.New("unknown sort field")
+	ErrInvalidPage   = errors.New("limit must be between 1 and 200 and offset can not be negative")
+)
+
+// likeEscaper keeps wildcards typed by users from acting as LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// reservedParams are the query parameters that are not filters.
+var reservedParams = []string{"q", "sort", "limit", "offset"}
+
+// scopeFilters are available on every campaign scoped table.
+var scopeFilters = map[string]Filter{
+	"campaign_id": {Condition: "campaign_id = ?", Kind: Integer},
+	"generic":     {Condition: "(campaign_id IS NULL) = ?", Kind: Boolean},
+}
+
+// Kind is the type a filter value is parsed as.
+type Kind int
+
+const (
+	Text Kind = iota
+	Integer
+	Boolean
+)
+
+// Filter is a condition with a single placeholder, such as "level = ?".
+type Filter struct {
+	Condition string
+	Kind      Kind
+}
+
+// Table describes how a catalog table can be searched, filtered and sorted.
+type Table struct {
+	Name string
+	// Id breaks ties between equal sort values so pages do not overlap.
+	Id          string
+	Search      []string
+	Filters     map[string]Filter
+	Sorts       map[string]string
+	DefaultSort string
+	// CampaignScoped tables hold generic rows, with a NULL campaign_id, and
+	// homebrew rows that only the members of their campaign can see.
+	CampaignScoped bool
+}
+
+// Params are the catalog options of a list request. Filters may hold several
+// values for one name, any of which matches. A Limit of 0 lists every matching
+// row. Viewer is the id of the user listing a campaign scoped table; without
+// one only generic rows are listed.
+type Params struct {
+	Search  string
+	Filters map[string][]string
+	Sort    string
+	Limit   int
+	Offset  int
+	Viewer  string
+}
+
+// Page is one page of a catalog with the total number of matching rows. Lists
+// that were not paged hold every row, with a Limit of 0.
+type Page[T any] struct {
+	Items  []T `json:"items"`
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+type Statement struct {
+	Query string
+	Args  []any
+}
+
+type Scannable interface {
+	Scan(dest ...any) error
+}
+
+// ParseParams reads q, sort, limit and offset from a query string; any other
+// parameter is a filter. Comma separated values match any of them. Paging is
+// opt in: without limit and offset every matching row is listed, and an offset
+// alone pages by DefaultLimit.
+func ParseParams(values url.Values) (Params, error) {
+	params := Params{
+		Search:  strings.TrimSpace(values.Get("q")),
+		Filters: map[string][]string{},
+		Sort:    values.Get("sort"),
+	}
+	limit, offset := values.Get("limit"), values.Get("offset")
+	paged := limit != "" || offset != ""
+	if paged {
+		params.Limit = DefaultLimit
+	}
+	var err error
+	if limit != "" {
+		if params.Limit, err = strconv.Atoi(limit); err != nil {
+			return Params{}, ErrInvalidPage
+		}
+	}
+	if offset != "" {
+		if params.Offset, err = strconv.Atoi(offset); err != nil {
+			return Params{}, ErrInvalidPage
+		}
+	}
+	if paged && (params.Limit < 1 || params.Limit > MaxLimit || params.Offset < 0) {
+		return Params{}, ErrInvalidPage
+	}
+	for name, list := range values {
+		if slices.Contains(reservedParams, name) {
+			continue
+		}
+		for _, value := range list {
+			for _, part := range strings.Split(value, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					params.Filters[name] = append(params.Filters[name], part)
+				}
+			}
+		}
+	}
+	return params, nil
+}
+
+// Paged reports whether the request asked for a page of the catalog rather
+// than the whole list.
+func (p Params) Paged() bool {
+	return p.Limit != 0 || p.Offset != 0
+}
+
+// Build returns the statements that list a page of the table and count every
+// matching row.
+func (t Table) Build(params Params) (list Statement, count Statement, err error) {
+	conditions := []string{}
+	args := []any{}
+	if t.CampaignScoped {
+		if params.Viewer == "" {
+			conditions = append(conditions, "campaign_id IS NULL")
+		} else {
+			conditions = append(conditions, "(campaign_id IS NULL"+
+				" OR campaign_id IN (SELECT campaign_id FROM user_campaign WHERE user_id = ?)"+
+				" OR campaign_id IN (SELECT campaign_id FROM campaign WHERE dungeon_master = ?))")
+			args = append(args, params.Viewer, params.Viewer)
+		}
+	}
+	if params.Search != "" {
+		pattern := "%" + likeEscaper.Replace(params.Search) + "%"
+		matches := make([]string, len(t.Search))
+		for i, column := range t.Search {
+			matches[i] = column + " LIKE ?"
+			args = append(args, pattern)
+		}
+		conditions = append(conditions, "("+strings.Join(matches, " OR ")+")")
+	}
+
+	names := make([]string, 0, len(params.Filters))
+	for name := range params.Filters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		filter, ok := t.Filters[name]
+		if !ok && t.CampaignScoped {
+			filter, ok = scopeFilters[name]
+		}
+		if !ok {
+			return Statement{}, Statement{}, fmt.Errorf("%w: %s", ErrUnknownFilter, name)
+		}
+		matches := make([]string, len(params.Filters[name]))
+		for i, value := range params.Filters[name] {
+			parsed, err := filter.Kind.parse(value)
+			if err != nil {
+				return Statement{}, Statement{}, fmt.Errorf("%w: %s=%s", ErrInvalidFilter, name, value)
+			}
+			matches[i] = filter.Condition
+			args = append(args, parsed)
+		}
+		conditions = append(conditions, "("+strings.Join(matches, " OR ")+")")
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	sort, direction := params.Sort, " ASC"
+	if strings.HasPrefix(sort, "-") {
+		sort, direction = sort[1:], " DESC"
+	}
+	if sort == "" {
+		sort = t.DefaultSort
+	}
+	column, ok := t.Sorts[sort]
+	if !ok {
+		return Statement{}, Statement{}, fmt.Errorf("%w: %s", ErrUnknownSort, sort)
+	}
+
+	count = Statement{Query: "SELECT COUNT(*) FROM " + t.Name + where, Args: args}
+	list = Statement{
+		Query: "SELECT * FROM " + t.Name + where + " ORDER BY " + column + direction + ", " + t.Id,
+		Args:  slices.Clone(args),
+	}
+	if params.Paged() {
+		list.Query += " LIMIT ? OFFSET ?"
+		list.Args = append(list.Args, params.Limit, params.Offset)
+	}
+	return list, count, nil
+}
+
+// Search runs a catalog query, scanning each row with scan. Rows are only
+// counted apart when the request is paged.
+func Search[T any](db *sql.DB, table Table, params Params, scan func(Scannable) (T, error)) (Page[T], error) {
+	list, count, err := table.Build(params)
+	if err != nil {
+		return Page[T]{}, err
+	}
+	page := Page[T]{Items: []T{}, Limit: params.Limit, Offset: params.Offset}
+	if params.Paged() {
+		if err := db.QueryRow(count.Query, count.Args...).Scan(&page.Total); err != nil {
+			return Page[T]{}, err
+		}
+	}
+
+	rows, err := db.Query(list.Query, list.Args...)
+	if err != nil {
+		return Page[T]{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return Page[T]{}, err
+		}
+		page.Items = append(page.Items, item)
+	}
+	if !params.Paged() {
+		page.Total = len(page.Items)
+	}
+	return page, rows.Err()
+}
+
+func (k Kind) parse(value string) (any, error) {
+	switch k {
+	case Integer:
+		return strconv.Atoi(value)
+	case Boolean:
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
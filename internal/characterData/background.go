@@ -0,0 +1,101 @@
+package characterdata
+
+import (
+	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+// grantBackground gives a new character the skills, proficiencies, feature
+// and starting equipment of its background. It only runs on creation, so
+// changing the background later does not hand out more equipment.
+func (s *service) grantBackground(character dto.FullCharacterData) (dto.FullCharacterData, error) {
+	if character.Background.BackgroundID == 0 {
+		return character, nil
+	}
+	grants, err := s.backgroundService.GetGrants(character.Background.BackgroundID)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	characterId := character.Character_Id
+	granted := false
+
+	skilled := map[int]bool{}
+	for _, skill := range character.Skills {
+		skilled[skill.SkillId] = true
+	}
+	for _, skill := range grants.Skills {
+		if skilled[skill.SkillId] {
+			continue
+		}
+		if _, err := s.skillXCharacterService.Create(domain.SkillXCharacterData{SkillID: int64(skill.SkillId), CharacterID: int64(characterId)}); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		granted = true
+	}
+
+	proficient := map[int]bool{}
+	for _, proficiency := range character.Proficiencies {
+		proficient[proficiency.ProficiencyId] = true
+	}
+	for _, proficiency := range grants.Proficiencies {
+		if proficient[proficiency.ProficiencyId] {
+			continue
+		}
+		if _, err := s.proficiencyXCharacterService.Create(domain.CharacterXProficiency{CharacterId: characterId, ProficiencyId: proficiency.ProficiencyId}); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		granted = true
+	}
+
+	if grants.Feature != nil && !hasFeature(character.Features, grants.Feature.FeatureId) {
+		if _, err := s.featureXCharacterService.CreateCharacterFeature(dto.CreateCharacterFeatureDto{CharacterId: characterId, FeatureId: grants.Feature.FeatureId}); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		granted = true
+	}
+
+	for _, equipment := range grants.Equipment {
+		if err := s.grantEquipment(characterId, equipment); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		granted = true
+	}
+
+	if !granted {
+		return character, nil
+	}
+	return s.GetById(characterId)
+}
+
+// grantEquipment adds starting equipment. Items stack in one entry, while
+// every weapon and armor is its own entry so it can be equipped on its own.
+func (s *service) grantEquipment(characterId int, equipment domain.BackgroundEquipment) error {
+	switch equipment.Kind {
+	case background.EquipmentItem:
+		_, err := s.itemService.Create(domain.ItemXCharacterData{CharacterData_Id: characterId, Item: domain.Item{Item_Id: equipment.EquipmentId}, Quantity: equipment.Quantity})
+		return err
+	case background.EquipmentWeapon:
+		for i := 0; i < equipment.Quantity; i++ {
+			if _, err := s.weaponService.Create(domain.WeaponXCharacterData{CharacterData_Id: characterId, Weapon: domain.Weapon{Weapon_Id: equipment.EquipmentId}}); err != nil {
+				return err
+			}
+		}
+	case background.EquipmentArmor:
+		for i := 0; i < equipment.Quantity; i++ {
+			if _, err := s.armorService.CreateArmorXCharacterData(domain.ArmorXCharacterData{CharacterData_Id: characterId, Armor: domain.Armor{ArmorId: equipment.EquipmentId}}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func hasFeature(features []domain.Feature, featureId int) bool {
+	for _, feature := range features {
+		if feature.FeatureId == featureId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package characterdata
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/wallet"
+)
+
+var ErrNotGeneric = errors.New("only generic characters can be cloned")
+
+// CloneGeneric implements ServiceCharacterData. The template's sheet and
+// everything it carries is copied into a new character owned by the caller; if
+// any part fails the partial copy is deleted.
+func (s *service) CloneGeneric(id int, cookie string) (dto.FullCharacterData, error) {
+	user, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	template, err := s.characterRepo.GetById(id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if template.User_Id != nil || template.Campaign_Id != 0 {
+		return dto.FullCharacterData{}, ErrNotGeneric
+	}
+	full, err := s.fetchAndConvertToFullCharacterData(&template)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.characterClassService.GetByCharacterId(id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	spells, err := s.spellXCharacterService.GetByCharacterId(id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+
+	clone := template
+	clone.Character_Id = 0
+	clone.User_Id = &user.Id
+	clone.Classes = classes
+	created, err := s.CreateCopy(clone)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if err := s.copyInventory(created.Character_Id, full, spells); err != nil {
+		s.Delete(created.Character_Id)
+		return dto.FullCharacterData{}, err
+	}
+	return s.GetById(created.Character_Id)
+}
+
+func (s *service) copyInventory(characterId int, template dto.FullCharacterData, spells []domain.CharacterXSpell) error {
+	for _, item := range template.Items {
+		if _, err := s.itemService.Create(domain.ItemXCharacterData{CharacterData_Id: characterId, Item: item.Item, Quantity: item.Quantity}); err != nil {
+			return fmt.Errorf("item %s: %w", item.Item.Name, err)
+		}
+	}
+	for _, weapon := range template.Weapons {
+		if _, err := s.weaponService.Create(domain.WeaponXCharacterData{CharacterData_Id: characterId, Weapon: weapon.Weapon, Equipped: weapon.Equipped, Slot: weapon.Slot, Attuned: weapon.Attuned}); err != nil {
+			return fmt.Errorf("weapon %s: %w", weapon.Weapon.Name, err)
+		}
+	}
+	for _, armor := range template.Armor {
+		if _, err := s.armorService.CreateArmorXCharacterData(domain.ArmorXCharacterData{CharacterData_Id: characterId, Armor: armor.Armor, Equipped: armor.Equipped, Slot: armor.Slot, Attuned: armor.Attuned}); err != nil {
+			return fmt.Errorf("armor %s: %w", armor.Armor.Name, err)
+		}
+	}
+	for _, skill := range template.Skills {
+		if _, err := s.skillXCharacterService.Create(domain.SkillXCharacterData{SkillID: int64(skill.SkillId), CharacterID: int64(characterId)}); err != nil {
+			return fmt.Errorf("skill %s: %w", skill.Name, err)
+		}
+	}
+	for _, feature := range template.Features {
+		if _, err := s.featureXCharacterService.CreateCharacterFeature(dto.CreateCharacterFeatureDto{FeatureId: feature.FeatureId, CharacterId: characterId}); err != nil {
+			return fmt.Errorf("feature %s: %w", feature.Name, err)
+		}
+	}
+	for _, spell := range spells {
+		if _, err := s.spellXCharacterService.Create(domain.CharacterXSpell{CharacterId: characterId, SpellId: spell.SpellId, Preparation: spell.Preparation}); err != nil {
+			return fmt.Errorf("spell %d: %w", spell.SpellId, err)
+		}
+	}
+	for _, proficiency := range template.Proficiencies {
+		if _, err := s.proficiencyXCharacterService.Create(domain.CharacterXProficiency{CharacterId: characterId, ProficiencyId: proficiency.ProficiencyId}); err != nil {
+			return fmt.Errorf("proficiency %s: %w", proficiency.Name, err)
+		}
+	}
+	if !wallet.IsZero(template.Wallet) {
+		reason := fmt.Sprintf("starting coins from character #%d", template.Character_Id)
+		if _, err := s.walletService.Credit(characterId, template.Wallet, reason); err != nil {
+			return fmt.Errorf("wallet: %w", err)
+		}
+	}
+	return nil
+}
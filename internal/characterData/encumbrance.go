@@ -0,0 +1,80 @@
+package characterdata
+
+import (
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/wallet"
+)
+
+// Carrying capacity multipliers applied to Strength. The variant rule makes a
+// character encumbered past 5 times its Strength and heavily encumbered past 10.
+const (
+	capacityMultiplier          = 15
+	encumberedMultiplier        = 5
+	heavilyEncumberedMultiplier = 10
+	encumberedSpeedPenalty      = 10
+	heavilyEncumberedPenalty    = 20
+	armorStrengthSpeedPenalty   = 10
+	coinsPerPound               = 50
+)
+
+// GetEncumbrance implements ServiceCharacterData.
+func (s *service) GetEncumbrance(characterId int) (dto.EncumbranceDto, error) {
+	character, err := s.GetById(characterId)
+	if err != nil {
+		return dto.EncumbranceDto{}, err
+	}
+	return character.Encumbrance, nil
+}
+
+func (s *service) variantEncumbrance(campaignId int) (bool, error) {
+	if campaignId == 0 {
+		return false, nil
+	}
+	rules, err := s.campaignRulesService.GetByCampaignId(campaignId)
+	if err != nil {
+		return false, err
+	}
+	return rules.VariantEncumbrance, nil
+}
+
+func encumbrance(character dto.FullCharacterData, variant bool) dto.EncumbranceDto {
+	result := dto.EncumbranceDto{
+		CarryingCapacity:        character.Str * capacityMultiplier,
+		VariantRules:            variant,
+		ArmorStrengthViolations: []dto.ArmorStrengthViolationDto{},
+	}
+	for _, item := range character.Items {
+		result.CarriedWeight += item.Item.Weight * item.Quantity
+	}
+	result.CarriedWeight += wallet.Coins(character.Wallet) / coinsPerPound
+	for _, weapon := range character.Weapons {
+		result.CarriedWeight += weapon.Weapon.Weight
+	}
+	for _, armor := range character.Armor {
+		result.CarriedWeight += armor.Armor.Weight
+		if armor.Equipped && armor.Armor.Strength > character.Str {
+			result.ArmorStrengthViolations = append(result.ArmorStrengthViolations, dto.ArmorStrengthViolationDto{
+				ArmorId:      armor.Armor.ArmorId,
+				Name:         armor.Armor.Name,
+				Strength:     armor.Armor.Strength,
+				SpeedPenalty: armorStrengthSpeedPenalty,
+			})
+			result.SpeedPenalty = armorStrengthSpeedPenalty
+		}
+	}
+
+	result.OverCapacity = result.CarriedWeight > result.CarryingCapacity
+	if variant {
+		result.Encumbered = result.CarriedWeight > character.Str*encumberedMultiplier
+		result.HeavilyEncumbered = result.CarriedWeight > character.Str*heavilyEncumberedMultiplier
+	} else {
+		result.Encumbered = result.OverCapacity
+	}
+	switch {
+	case result.HeavilyEncumbered:
+		result.SpeedPenalty += heavilyEncumberedPenalty
+	case result.Encumbered && variant:
+		result.SpeedPenalty += encumberedSpeedPenalty
+	}
+	return result
+}
@@ -15,11 +15,13 @@ type RepositoryCharacterData interface {
 	GetByCampaignId(campaignid int)([]dto.CharacterCardDto, error)
 	GetByAttackEventId(attackeventid int)([]dto.CharacterCardDto, error)
 	Update(character domain.CharacterData) (domain.CharacterData, error)
+	UpdateImage(id int, url string) error
 	Delete(id int)error
 }
 
 type ServiceCharacterData interface {
 	Create(character domain.CharacterData) (dto.FullCharacterData, error)
+	CreateCopy(character domain.CharacterData) (dto.FullCharacterData, error)
 	GetAll()([]dto.CharacterCardDto, error)
 	GetById(id int)(dto.FullCharacterData, error)
 	GetByUserId(userid string)([]dto.CharacterCardDto, error)
@@ -30,4 +32,12 @@ type ServiceCharacterData interface {
 	GetByAttackEventId(attackeventid int)([]dto.CharacterCardDto, error)
 	Update(character domain.CharacterData) (dto.FullCharacterData, error)
 	Delete(id int)error
+	AddClass(characterId int, classId int, level int) (dto.FullCharacterData, error)
+	SetClassLevel(characterId int, classId int, level int) (dto.FullCharacterData, error)
+	RemoveClass(characterId int, classId int) (dto.FullCharacterData, error)
+	SetSubclass(characterId int, classId int, subclassId *int) (dto.FullCharacterData, error)
+	GetEncumbrance(characterId int) (dto.EncumbranceDto, error)
+	CloneGeneric(id int, cookie string) (dto.FullCharacterData, error)
+	SetPortrait(id int, url string, cookie string) (string, error)
+	StatModifier(characterId int, stat string) (int, bool, error)
 }
\ No newline at end of file
@@ -0,0 +1,362 @@
+package characterdata
+
+import (
+	"errors"
+
+	characterXclass "github.com/proyecto-dnd/backend/internal/characterXClass"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+)
+
+// MaxCharacterLevel is the highest level a character reaches across its classes.
+const MaxCharacterLevel = 20
+
+var (
+	ErrInvalidClassLevel = errors.New("class level must be at least 1 and the character level at most 20")
+	ErrClassAlreadyAdded = errors.New("character already has this class")
+	ErrLastClass         = errors.New("a character must keep at least one class")
+	ErrSubclassLevel     = errors.New("the class level is too low to choose this subclass")
+)
+
+// AddClass implements ServiceCharacterData.
+func (s *service) AddClass(characterId int, classId int, level int) (dto.FullCharacterData, error) {
+	character, err := s.characterRepo.GetById(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	previous, err := s.grantsFor(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.classesOf(character)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	for _, characterClass := range classes {
+		if characterClass.Class.ClassId == classId {
+			return dto.FullCharacterData{}, ErrClassAlreadyAdded
+		}
+	}
+	newClass, err := s.classService.GetById(classId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if level < 1 || characterXclass.TotalLevel(classes)+level > MaxCharacterLevel {
+		return dto.FullCharacterData{}, ErrInvalidClassLevel
+	}
+	// Multiclassing requires meeting the prerequisites of every class, old and new.
+	for _, characterClass := range append(classes, domain.CharacterClass{Class: newClass}) {
+		if err := characterXclass.CheckPrerequisite(character, characterClass.Class); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+	}
+
+	// The first class entry is created the moment a single class character multiclasses.
+	for _, characterClass := range classes {
+		if characterClass.CharacterClassId != 0 {
+			continue
+		}
+		if _, err := s.characterClassService.Create(characterClass); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+	}
+	_, err = s.characterClassService.Create(domain.CharacterClass{CharacterId: characterId, Class: newClass, Level: level})
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.update(character, previous)
+}
+
+// SetClassLevel implements ServiceCharacterData.
+func (s *service) SetClassLevel(characterId int, classId int, level int) (dto.FullCharacterData, error) {
+	character, err := s.characterRepo.GetById(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	previous, err := s.grantsFor(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.classesOf(character)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	total := 0
+	found := false
+	for _, characterClass := range classes {
+		if characterClass.Class.ClassId == classId {
+			found = true
+			continue
+		}
+		total += characterClass.Level
+	}
+	if !found {
+		return dto.FullCharacterData{}, characterXclass.ErrNotFound
+	}
+	if level < 1 || total+level > MaxCharacterLevel {
+		return dto.FullCharacterData{}, ErrInvalidClassLevel
+	}
+
+	if classes[0].CharacterClassId == 0 {
+		character.Level = level
+		return s.update(character, previous)
+	}
+	if err := s.characterClassService.UpdateLevel(characterId, classId, level); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.update(character, previous)
+}
+
+// RemoveClass implements ServiceCharacterData.
+func (s *service) RemoveClass(characterId int, classId int) (dto.FullCharacterData, error) {
+	character, err := s.characterRepo.GetById(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	previous, err := s.grantsFor(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.classesOf(character)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if len(classes) < 2 {
+		return dto.FullCharacterData{}, ErrLastClass
+	}
+	if err := s.characterClassService.Delete(characterId, classId); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if err := s.subclassService.DeleteForCharacter(characterId, classId); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.update(character, previous)
+}
+
+// SetSubclass implements ServiceCharacterData. A nil subclass clears the
+// choice and takes away the features it granted.
+func (s *service) SetSubclass(characterId int, classId int, subclassId *int) (dto.FullCharacterData, error) {
+	character, err := s.characterRepo.GetById(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	previous, err := s.grantsFor(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.classesOf(character)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	index := -1
+	for i, characterClass := range classes {
+		if characterClass.Class.ClassId == classId {
+			index = i
+		}
+	}
+	if index < 0 {
+		return dto.FullCharacterData{}, characterXclass.ErrNotFound
+	}
+
+	if subclassId == nil {
+		if err := s.subclassService.DeleteForCharacter(characterId, classId); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		return s.update(character, previous)
+	}
+	chosen, err := s.subclassService.GetById(*subclassId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if err := checkSubclass(chosen, classes[index]); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if err := s.subclassService.SetForCharacter(characterId, classId, chosen.SubclassId); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.update(character, previous)
+}
+
+// prepareSubclasses validates the subclasses chosen in the classes sent on
+// creation and returns them to store once the character exists.
+func (s *service) prepareSubclasses(classes []domain.CharacterClass) ([]domain.Subclass, error) {
+	chosen := []domain.Subclass{}
+	for _, characterClass := range classes {
+		if characterClass.Subclass == nil {
+			continue
+		}
+		stored, err := s.subclassService.GetById(characterClass.Subclass.SubclassId)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSubclass(stored, characterClass); err != nil {
+			return nil, err
+		}
+		chosen = append(chosen, stored)
+	}
+	return chosen, nil
+}
+
+func checkSubclass(chosen domain.Subclass, characterClass domain.CharacterClass) error {
+	if chosen.ClassId != characterClass.Class.ClassId {
+		return subclass.ErrOtherClass
+	}
+	if characterClass.Level < chosen.Level {
+		return ErrSubclassLevel
+	}
+	return nil
+}
+
+// grants are the features and proficiencies a character is given by its
+// class levels, subclasses and subrace.
+type grants struct {
+	featureIds     map[int]bool
+	proficiencyIds map[int]bool
+}
+
+// grantsOf returns what the class progression grants at the character's
+// levels, and the racial traits and proficiencies of its subrace.
+func (s *service) grantsOf(character dto.FullCharacterData) (grants, error) {
+	progression, err := s.progressionService.ForCharacter(character.Classes)
+	if err != nil {
+		return grants{}, err
+	}
+	given := grants{featureIds: map[int]bool{}, proficiencyIds: map[int]bool{}}
+	for _, featureId := range progression.FeatureIds {
+		given.featureIds[featureId] = true
+	}
+	if character.Subrace != nil {
+		for _, featureId := range character.Subrace.FeatureIds {
+			given.featureIds[featureId] = true
+		}
+		for _, proficiencyId := range character.Subrace.ProficiencyIds {
+			given.proficiencyIds[proficiencyId] = true
+		}
+	}
+	return given, nil
+}
+
+// grantsFor returns the grants of a stored character.
+func (s *service) grantsFor(characterId int) (grants, error) {
+	character, err := s.GetById(characterId)
+	if err != nil {
+		return grants{}, err
+	}
+	return s.grantsOf(character)
+}
+
+// regrant gives the character what it is granted now and was not granted
+// before, and takes away what it was granted before and is not anymore, so
+// a lowered level or a former subrace leaves nothing behind. Grants that did
+// not change are left alone: a granted feature the player deleted does not
+// come back on the next edit.
+func (s *service) regrant(character dto.FullCharacterData, previous grants) (dto.FullCharacterData, error) {
+	current, err := s.grantsOf(character)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+
+	owned := map[int]bool{}
+	for _, feature := range character.Features {
+		owned[feature.FeatureId] = true
+	}
+	proficient := map[int]bool{}
+	for _, proficiency := range character.Proficiencies {
+		proficient[proficiency.ProficiencyId] = true
+	}
+	changed := false
+	for featureId := range current.featureIds {
+		if previous.featureIds[featureId] || owned[featureId] {
+			continue
+		}
+		_, err := s.featureXCharacterService.CreateCharacterFeature(dto.CreateCharacterFeatureDto{CharacterId: character.Character_Id, FeatureId: featureId})
+		if err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		changed = true
+	}
+	for featureId := range previous.featureIds {
+		if current.featureIds[featureId] || !owned[featureId] {
+			continue
+		}
+		if err := s.featureXCharacterService.DeleteCharacterFeature(featureId, character.Character_Id); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		changed = true
+	}
+	for proficiencyId := range current.proficiencyIds {
+		if previous.proficiencyIds[proficiencyId] || proficient[proficiencyId] {
+			continue
+		}
+		_, err := s.proficiencyXCharacterService.Create(domain.CharacterXProficiency{CharacterId: character.Character_Id, ProficiencyId: proficiencyId})
+		if err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		changed = true
+	}
+	for proficiencyId := range previous.proficiencyIds {
+		if current.proficiencyIds[proficiencyId] || !proficient[proficiencyId] {
+			continue
+		}
+		if err := s.proficiencyXCharacterService.DeleteParams(character.Character_Id, proficiencyId); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		changed = true
+	}
+	if !changed {
+		return character, nil
+	}
+	return s.GetById(character.Character_Id)
+}
+
+// classesOf returns the class entries of a character, synthesizing the entry
+// of a single class character that has never multiclassed.
+func (s *service) classesOf(character domain.CharacterData) ([]domain.CharacterClass, error) {
+	classes, err := s.characterClassService.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(classes) == 0 && character.Class.ClassId != 0 {
+		classes = []domain.CharacterClass{{CharacterId: character.Character_Id, Class: character.Class, Level: character.Level}}
+	}
+	return classes, nil
+}
+
+// prepareClasses validates the classes sent on creation and returns the entries
+// to store. Single class characters keep using the class and level columns only.
+func (s *service) prepareClasses(character *domain.CharacterData) ([]domain.CharacterClass, error) {
+	if len(character.Classes) == 0 {
+		return nil, nil
+	}
+	classes := []domain.CharacterClass{}
+	for _, characterClass := range character.Classes {
+		if characterClass.Level < 1 {
+			return nil, ErrInvalidClassLevel
+		}
+		for _, added := range classes {
+			if added.Class.ClassId == characterClass.Class.ClassId {
+				return nil, ErrClassAlreadyAdded
+			}
+		}
+		fullClass, err := s.classService.GetById(characterClass.Class.ClassId)
+		if err != nil {
+			return nil, err
+		}
+		classes = append(classes, domain.CharacterClass{Class: fullClass, Level: characterClass.Level})
+	}
+	if characterXclass.TotalLevel(classes) > MaxCharacterLevel {
+		return nil, ErrInvalidClassLevel
+	}
+	character.Class = classes[0].Class
+	character.Level = characterXclass.TotalLevel(classes)
+	if len(classes) == 1 {
+		return nil, nil
+	}
+	for _, characterClass := range classes {
+		if err := characterXclass.CheckPrerequisite(*character, characterClass.Class); err != nil {
+			return nil, err
+		}
+	}
+	return classes, nil
+}
@@ -0,0 +1,25 @@
+package characterdata
+
+import "errors"
+
+var ErrNotCharacterOwner = errors.New("only the owner of the character can change its portrait")
+
+// SetPortrait implements ServiceCharacterData. It returns the portrait URL
+// that was replaced so the caller can remove the old image.
+func (s *service) SetPortrait(id int, url string, cookie string) (string, error) {
+	user, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return "", err
+	}
+	character, err := s.characterRepo.GetById(id)
+	if err != nil {
+		return "", err
+	}
+	if character.User_Id == nil || *character.User_Id != user.Id {
+		return "", ErrNotCharacterOwner
+	}
+	if err := s.characterRepo.UpdateImage(id, url); err != nil {
+		return "", err
+	}
+	return character.ImgUrl, nil
+}
@@ -61,6 +61,7 @@ func (r *CharacterDataMySqlRepository) Create(character domain.CharacterData) (d
 		character.User_Id,
 		1,
 		character.Race.RaceID,
+		subraceId(character),
 		character.Class.ClassId,	
 		character.Background.BackgroundID,
 		character.Name,
@@ -100,6 +101,22 @@ func (r *CharacterDataMySqlRepository) Create(character domain.CharacterData) (d
 	return character, nil
 }
 
+// UpdateImage implements RepositoryCharacterData.
+func (r *CharacterDataMySqlRepository) UpdateImage(id int, url string) error {
+	result, err := r.db.Exec(QueryUpdateImage, url, id)
+	if err != nil {
+		return err
+	}
+	rowAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowAffected < 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 // Delete implements RepositoryCharacterData.
 func (r *CharacterDataMySqlRepository) Delete(id int) error {
 	result, err := r.db.Exec(QueryDelete, id)
@@ -270,6 +287,7 @@ func (r *CharacterDataMySqlRepository) Update(character domain.CharacterData) (d
 		character.User_Id,
 		character.Campaign_Id,
 		character.Race.RaceID,
+		subraceId(character),
 		character.Class.ClassId,
 		character.Background.BackgroundID,
 		character.Name,
@@ -318,10 +336,12 @@ func ScanCharacterCardDto(rows scannable, characterCardDto *dto.CharacterCardDto
 		&characterCardDto.Level,
 		&characterCardDto.HitPoints,
 	)
+	characterCardDto.ImageUrls = dto.NewImageUrlsDto(characterCardDto.ImageUrl)
 	return err
 }
 
 func ScanCharacterData(rows scannable, characterData *domain.CharacterData) error {
+	var subraceId sql.NullInt64
 	err := rows.Scan(
 		&characterData.Character_Id,
 		&characterData.User_Id,
@@ -336,6 +356,7 @@ func ScanCharacterData(rows scannable, characterData *domain.CharacterData) erro
 		&characterData.Race.Con,
 		&characterData.Race.Wiz,
 		&characterData.Race.Cha,
+		&subraceId,
 		&characterData.Class.ClassId,
 		&characterData.Class.Name,
 		&characterData.Class.Description,
@@ -377,5 +398,17 @@ func ScanCharacterData(rows scannable, characterData *domain.CharacterData) erro
 		&characterData.Level,
 		&characterData.Exp,
 	)
+	// Only the id is read here; the service loads the rest of the subrace.
+	if subraceId.Valid {
+		characterData.Subrace = &domain.Subrace{SubraceId: int(subraceId.Int64)}
+	}
 	return err
 }
+
+// subraceId is the subrace_id column of a character, NULL when it has none.
+func subraceId(character domain.CharacterData) any {
+	if character.Subrace == nil {
+		return nil
+	}
+	return character.Subrace.SubraceId
+}
@@ -0,0 +1,25 @@
+package characterdata
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/saving_throws"
+)
+
+// StatModifier implements ServiceCharacterData. Saving throws are the only
+// stats it knows, and rolls of unknown characters report false.
+func (s *service) StatModifier(characterId int, stat string) (int, bool, error) {
+	ability, ok := saving_throws.Ability(stat)
+	if !ok {
+		return 0, false, nil
+	}
+	character, err := s.GetById(characterId)
+	if errors.Is(err, ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	bonus, ok := saving_throws.Bonus(character, ability)
+	return bonus, ok, nil
+}
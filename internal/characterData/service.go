@@ -1,26 +1,38 @@
 package characterdata
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"sync"
 
 	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
 	"github.com/proyecto-dnd/backend/internal/attackEvent"
+	"github.com/proyecto-dnd/backend/internal/background"
+	campaignrules "github.com/proyecto-dnd/backend/internal/campaignRules"
+	characterXclass "github.com/proyecto-dnd/backend/internal/characterXClass"
 	characterXproficiency "github.com/proyecto-dnd/backend/internal/characterXProficiency"
 	characterXspell "github.com/proyecto-dnd/backend/internal/characterXSpell"
 	"github.com/proyecto-dnd/backend/internal/character_feature"
+	"github.com/proyecto-dnd/backend/internal/class"
 	"github.com/proyecto-dnd/backend/internal/dice_event"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/equipment"
 	"github.com/proyecto-dnd/backend/internal/feature"
 	"github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/journal"
 	"github.com/proyecto-dnd/backend/internal/proficiency"
+	"github.com/proyecto-dnd/backend/internal/progression"
+	"github.com/proyecto-dnd/backend/internal/saving_throws"
 	"github.com/proyecto-dnd/backend/internal/skill"
 	skillxcharacterdata "github.com/proyecto-dnd/backend/internal/skillXCharacterData"
 	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+	"github.com/proyecto-dnd/backend/internal/subrace"
 	tradeevent "github.com/proyecto-dnd/backend/internal/tradeEvent"
 	"github.com/proyecto-dnd/backend/internal/user"
+	"github.com/proyecto-dnd/backend/internal/wallet"
 	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
 )
 
@@ -43,10 +55,20 @@ type service struct {
 	attackEventService           attackEvent.AttackEventService
 	diceEventService             dice_event.DiceEventService
 	userService                  user.ServiceUsers
+	characterClassService        characterXclass.ServiceCharacterXClass
+	classService                 class.ClassService
+	campaignRulesService         campaignrules.ServiceCampaignRules
+	walletService                wallet.ServiceWallet
+	journalService               journal.ServiceJournal
+	subclassService              subclass.ServiceSubclass
+	progressionService           progression.ServiceProgression
+	subraceService               subrace.ServiceSubrace
+	backgroundService            background.BackgroundService
+	savingThrowsService          saving_throws.SavingThrowsService
 }
 
-func NewServiceCharacterData(characterRepo RepositoryCharacterData, itemService itemxcharacterdata.ServiceItemXCharacterData, weaponService weaponxcharacterdata.ServiceWeaponXCharacterData, armorService armorXCharacterData.ServiceArmorXCharacterData, skillService skill.ServiceSkill, skillXCharacterService skillxcharacterdata.ServiceSkillXCharacter, featureService feature.FeatureService, featureXCharacterService character_feature.CharacterFeatureService, spellService spell.ServiceSpell, spellXCharacterService characterXspell.ServiceCharacterXSpell, proficiencyService proficiency.ProficiencyService, proficiencyXCharacterService characterXproficiency.CharacterXProficiencyService, tradeEventService tradeevent.ServiceTradeEvent, attackEventService attackEvent.AttackEventService, diceEventService dice_event.DiceEventService, userService user.ServiceUsers) ServiceCharacterData {
-	return &service{characterRepo: characterRepo, itemService: itemService, weaponService: weaponService, armorService: armorService, skillService: skillService, skillXCharacterService: skillXCharacterService, featureService: featureService, featureXCharacterService: featureXCharacterService, spellService: spellService, spellXCharacterService: spellXCharacterService, proficiencyService: proficiencyService, proficiencyXCharacterService: proficiencyXCharacterService, tradeEventService: tradeEventService, attackEventService: attackEventService, diceEventService: diceEventService, userService: userService}
+func NewServiceCharacterData(characterRepo RepositoryCharacterData, itemService itemxcharacterdata.ServiceItemXCharacterData, weaponService weaponxcharacterdata.ServiceWeaponXCharacterData, armorService armorXCharacterData.ServiceArmorXCharacterData, skillService skill.ServiceSkill, skillXCharacterService skillxcharacterdata.ServiceSkillXCharacter, featureService feature.FeatureService, featureXCharacterService character_feature.CharacterFeatureService, spellService spell.ServiceSpell, spellXCharacterService characterXspell.ServiceCharacterXSpell, proficiencyService proficiency.ProficiencyService, proficiencyXCharacterService characterXproficiency.CharacterXProficiencyService, tradeEventService tradeevent.ServiceTradeEvent, attackEventService attackEvent.AttackEventService, diceEventService dice_event.DiceEventService, userService user.ServiceUsers, characterClassService characterXclass.ServiceCharacterXClass, classService class.ClassService, campaignRulesService campaignrules.ServiceCampaignRules, walletService wallet.ServiceWallet, journalService journal.ServiceJournal, subclassService subclass.ServiceSubclass, progressionService progression.ServiceProgression, subraceService subrace.ServiceSubrace, backgroundService background.BackgroundService, savingThrowsService saving_throws.SavingThrowsService) ServiceCharacterData {
+	return &service{characterRepo: characterRepo, itemService: itemService, weaponService: weaponService, armorService: armorService, skillService: skillService, skillXCharacterService: skillXCharacterService, featureService: featureService, featureXCharacterService: featureXCharacterService, spellService: spellService, spellXCharacterService: spellXCharacterService, proficiencyService: proficiencyService, proficiencyXCharacterService: proficiencyXCharacterService, tradeEventService: tradeEventService, attackEventService: attackEventService, diceEventService: diceEventService, userService: userService, characterClassService: characterClassService, classService: classService, campaignRulesService: campaignRulesService, walletService: walletService, journalService: journalService, subclassService: subclassService, progressionService: progressionService, subraceService: subraceService, backgroundService: backgroundService, savingThrowsService: savingThrowsService}
 }
 
 // GetGenerics implements ServiceCharacterData.
@@ -56,25 +78,81 @@ func (s *service) GetGenerics() ([]dto.CharacterCardDto, error) {
 
 // Create implements ServiceCharacterData.
 func (s *service) Create(character domain.CharacterData) (dto.FullCharacterData, error) {
+	return s.create(character, true)
+}
+
+// CreateCopy implements ServiceCharacterData. The character is stored like in
+// Create, and placed in the campaign it names, but nothing is granted: copies
+// and imports bring the features, proficiencies, skills and equipment of
+// their source themselves.
+func (s *service) CreateCopy(character domain.CharacterData) (dto.FullCharacterData, error) {
+	created, err := s.create(character, false)
+	if err != nil || character.Campaign_Id == 0 {
+		return created, err
+	}
+	stored, err := s.characterRepo.GetById(created.Character_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	stored.Campaign_Id = character.Campaign_Id
+	if _, err := s.characterRepo.Update(stored); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.GetById(created.Character_Id)
+}
+
+// create stores a new character with its classes and subclasses, granting
+// what its progression, subrace and background give when grant is set.
+func (s *service) create(character domain.CharacterData, grant bool) (dto.FullCharacterData, error) {
+	if err := s.checkSubrace(character); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	subclasses, err := s.prepareSubclasses(character.Classes)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.prepareClasses(&character)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
 	newCharacter, err := s.characterRepo.Create(character)
 	if err != nil {
 		log.Println("characterrepo", err)
 		return dto.FullCharacterData{}, err
 	}
+	for _, characterClass := range classes {
+		characterClass.CharacterId = newCharacter.Character_Id
+		if _, err := s.characterClassService.Create(characterClass); err != nil {
+			log.Println("characterclass", err)
+			return dto.FullCharacterData{}, err
+		}
+	}
+	for _, chosen := range subclasses {
+		if err := s.subclassService.SetForCharacter(newCharacter.Character_Id, chosen.ClassId, chosen.SubclassId); err != nil {
+			return dto.FullCharacterData{}, err
+		}
+	}
 	newCharacterDto, err := s.GetById(newCharacter.Character_Id)
 	if err != nil {
 		log.Println("get", err)
 		return dto.FullCharacterData{}, err
 	}
-	return newCharacterDto, nil
+	if !grant {
+		return newCharacterDto, nil
+	}
+	newCharacterDto, err = s.regrant(newCharacterDto, grants{})
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.grantBackground(newCharacterDto)
 }
 
 // Delete implements ServiceCharacterData.
 func (s *service) Delete(id int) error {
-	errChan := make(chan error, 10)
+	errChan := make(chan error, 14)
 	maxWorkers := make(chan bool, 3)
 	var wg sync.WaitGroup
-	wg.Add(10)
+	wg.Add(14)
 	go func() {
 		maxWorkers <- true
 		defer func() {
@@ -179,6 +257,46 @@ func (s *service) Delete(id int) error {
 		errChan <- err
 	}()
 
+	go func() {
+		maxWorkers <- true
+		defer func() {
+			<-maxWorkers
+			wg.Done()
+		}()
+		err := s.characterClassService.DeleteByCharacterDataId(id)
+		errChan <- err
+	}()
+
+	go func() {
+		maxWorkers <- true
+		defer func() {
+			<-maxWorkers
+			wg.Done()
+		}()
+		err := s.walletService.DeleteByCharacterId(id)
+		errChan <- err
+	}()
+
+	go func() {
+		maxWorkers <- true
+		defer func() {
+			<-maxWorkers
+			wg.Done()
+		}()
+		err := s.journalService.DeleteByCharacterId(id)
+		errChan <- err
+	}()
+
+	go func() {
+		maxWorkers <- true
+		defer func() {
+			<-maxWorkers
+			wg.Done()
+		}()
+		err := s.subclassService.DeleteByCharacterId(id)
+		errChan <- err
+	}()
+
 	go func() {
 		wg.Wait()
 		close(errChan)
@@ -236,6 +354,28 @@ func (s *service) GetByAttackEventId(attackeventid int) ([]dto.CharacterCardDto,
 
 // Update implements ServiceCharacterData.
 func (s *service) Update(character domain.CharacterData) (dto.FullCharacterData, error) {
+	previous, err := s.grantsFor(character.Character_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.update(character, previous)
+}
+
+// update stores the character and brings what it is granted in line with
+// previous, the grants it had before its classes or subrace changed.
+func (s *service) update(character domain.CharacterData, previous grants) (dto.FullCharacterData, error) {
+	if err := s.checkSubrace(character); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	classes, err := s.characterClassService.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	// Multiclass characters derive their level and primary class from their class entries.
+	if len(classes) > 0 {
+		character.Level = characterXclass.TotalLevel(classes)
+		character.Class = classes[0].Class
+	}
 	updatedCharacter, err := s.characterRepo.Update(character)
 	if err != nil {
 		return dto.FullCharacterData{}, err
@@ -245,7 +385,8 @@ func (s *service) Update(character domain.CharacterData) (dto.FullCharacterData,
 	if err != nil {
 		return dto.FullCharacterData{}, err
 	}
-	return updatedFullCharacter, nil
+	// Level, class, subclass and subrace changes all go through here.
+	return s.regrant(updatedFullCharacter, previous)
 }
 
 // GetByUser implements ServiceCharacterData.
@@ -278,6 +419,7 @@ func characterDataToFullCharacterData(character domain.CharacterData, items []do
 		Height:        character.Height,
 		Weight:        character.Weight,
 		ImgUrl:        character.ImgUrl,
+		ImgUrls:       dto.NewImageUrlsDto(character.ImgUrl),
 		Str:           character.Str,
 		Dex:           character.Dex,
 		Int:           character.Int,
@@ -411,5 +553,62 @@ func (s *service) fetchAndConvertToFullCharacterData(character *domain.Character
 		}
 	}
 
-	return characterDataToFullCharacterData(*character, <-itemChan, <-weaponChan, <-armorChan, <-skillChan, <-featureChan, <-spellChan, <-proficiencyChan), nil
+	fullCharacter := characterDataToFullCharacterData(*character, <-itemChan, <-weaponChan, <-armorChan, <-skillChan, <-featureChan, <-spellChan, <-proficiencyChan)
+	if character.Subrace != nil {
+		characterSubrace, err := s.subraceService.GetById(character.Subrace.SubraceId)
+		if err != nil {
+			return dto.FullCharacterData{}, err
+		}
+		fullCharacter.Subrace = &characterSubrace
+	}
+
+	classes, err := s.characterClassService.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	// Single class characters have no class entries, their class and level live on the character.
+	if len(classes) == 0 && character.Class.ClassId != 0 {
+		classes = []domain.CharacterClass{{CharacterId: character.Character_Id, Class: character.Class, Level: character.Level}}
+	}
+	subclasses, err := s.subclassService.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	for i := range classes {
+		if chosen, ok := subclasses[classes[i].Class.ClassId]; ok {
+			classes[i].Subclass = &chosen
+		}
+	}
+	fullCharacter.Classes = classes
+	fullCharacter.HitDicePool = characterXclass.HitDicePool(classes)
+	fullCharacter.SpellSlots = characterXclass.SpellSlots(classes)
+	grants, err := s.progressionService.ForCharacter(classes)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if grants.SpellSlots != nil {
+		fullCharacter.SpellSlots.Slots = grants.SpellSlots
+	}
+	fullCharacter.ResourceMaxima = grants.ResourceMaxima
+
+	characterWallet, err := s.walletService.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	fullCharacter.Wallet = characterWallet.Currency
+
+	variant, err := s.variantEncumbrance(character.Campaign_Id)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	fullCharacter.Encumbrance = encumbrance(fullCharacter, variant)
+	fullCharacter.Equipment = equipment.Loadout(fullCharacter)
+
+	// Only the starting class grants saving throw proficiencies.
+	proficient, err := s.savingThrowsService.GetByClassId(fullCharacter.Class.ClassId)
+	if err != nil && !errors.Is(err, saving_throws.ErrNotFound) {
+		return dto.FullCharacterData{}, err
+	}
+	fullCharacter.SavingThrows = saving_throws.Bonuses(fullCharacter, proficient)
+	return fullCharacter, nil
 }
@@ -0,0 +1,21 @@
+package characterdata
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/subrace"
+)
+
+// checkSubrace makes sure the subrace of a character, if any, refines its race.
+func (s *service) checkSubrace(character domain.CharacterData) error {
+	if character.Subrace == nil {
+		return nil
+	}
+	chosen, err := s.subraceService.GetById(character.Subrace.SubraceId)
+	if err != nil {
+		return err
+	}
+	if chosen.RaceId != character.Race.RaceID {
+		return subrace.ErrOtherRace
+	}
+	return nil
+}
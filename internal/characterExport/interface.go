@@ -0,0 +1,8 @@
+package characterexport
+
+import "github.com/proyecto-dnd/backend/internal/dto"
+
+type ServiceCharacterExport interface {
+	Export(characterId int, cookie string) (dto.CharacterExportDto, error)
+	Import(export dto.CharacterExportDto, cookie string, campaignId *int, dungeonMaster bool) (dto.CharacterImportReportDto, error)
+}
@@ -0,0 +1,1139 @@
+package characterexport
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/proyecto-dnd/backend/internal/armor"
+	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/background"
+	"github.com/proyecto-dnd/backend/internal/campaign"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	characterresource "github.com/proyecto-dnd/backend/internal/characterResource"
+	characterXproficiency "github.com/proyecto-dnd/backend/internal/characterXProficiency"
+	characterXspell "github.com/proyecto-dnd/backend/internal/characterXSpell"
+	"github.com/proyecto-dnd/backend/internal/character_feature"
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/item"
+	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/journal"
+	"github.com/proyecto-dnd/backend/internal/proficiency"
+	"github.com/proyecto-dnd/backend/internal/race"
+	"github.com/proyecto-dnd/backend/internal/skill"
+	skillxcharacterdata "github.com/proyecto-dnd/backend/internal/skillXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/spell"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+	"github.com/proyecto-dnd/backend/internal/subrace"
+	"github.com/proyecto-dnd/backend/internal/user"
+	"github.com/proyecto-dnd/backend/internal/wallet"
+	"github.com/proyecto-dnd/backend/internal/weapon"
+	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
+)
+
+const (
+	ExportFormat  = "dicelogger-character"
+	ExportVersion = 2
+)
+
+var (
+	ErrUnknownFormat      = errors.New("file is not a dicelogger character export")
+	ErrUnsupportedVersion = errors.New("character export version is not supported")
+	ErrMissingName        = errors.New("character name is required")
+	ErrNoCampaign         = errors.New("the catalog is missing an entry the character needs and homebrew entries need a campaign to be scoped to")
+
+	reasonNoCampaign      = "homebrew entries need a campaign to be scoped to"
+	reasonNotDM           = "not found in catalog and only the campaign dungeon master can create homebrew entries"
+	reasonNotInCatalog    = "not found in catalog"
+	reasonGenericParent   = "not found in catalog and its parent entry is not homebrew"
+	reasonLevelTooLow     = "the class level is too low for it"
+	reasonFeatureNotFound = "the feature declaring it was not imported"
+)
+
+type service struct {
+	characterDataService         characterdata.ServiceCharacterData
+	raceService                  race.RaceService
+	classService                 class.ClassService
+	backgroundService            background.BackgroundService
+	itemService                  item.ServiceItem
+	weaponService                weapon.ServiceWeapon
+	armorService                 armor.ArmorService
+	spellService                 spell.ServiceSpell
+	featureService               feature.FeatureService
+	proficiencyService           proficiency.ProficiencyService
+	skillService                 skill.ServiceSkill
+	itemXCharacterService        itemxcharacterdata.ServiceItemXCharacterData
+	weaponXCharacterService      weaponxcharacterdata.ServiceWeaponXCharacterData
+	armorXCharacterService       armorXCharacterData.ServiceArmorXCharacterData
+	spellXCharacterService       characterXspell.ServiceCharacterXSpell
+	featureXCharacterService     character_feature.CharacterFeatureService
+	proficiencyXCharacterService characterXproficiency.CharacterXProficiencyService
+	skillXCharacterService       skillxcharacterdata.ServiceSkillXCharacter
+	userService                  user.ServiceUsers
+	journalService               journal.ServiceJournal
+	subraceService               subrace.ServiceSubrace
+	subclassService              subclass.ServiceSubclass
+	walletService                wallet.ServiceWallet
+	characterResourceService     characterresource.ServiceCharacterResource
+}
+
+func NewCharacterExportService(characterDataService characterdata.ServiceCharacterData, raceService race.RaceService, classService class.ClassService, backgroundService background.BackgroundService, itemService item.ServiceItem, weaponService weapon.ServiceWeapon, armorService armor.ArmorService, spellService spell.ServiceSpell, featureService feature.FeatureService, proficiencyService proficiency.ProficiencyService, skillService skill.ServiceSkill, itemXCharacterService itemxcharacterdata.ServiceItemXCharacterData, weaponXCharacterService weaponxcharacterdata.ServiceWeaponXCharacterData, armorXCharacterService armorXCharacterData.ServiceArmorXCharacterData, spellXCharacterService characterXspell.ServiceCharacterXSpell, featureXCharacterService character_feature.CharacterFeatureService, proficiencyXCharacterService characterXproficiency.CharacterXProficiencyService, skillXCharacterService skillxcharacterdata.ServiceSkillXCharacter, userService user.ServiceUsers, journalService journal.ServiceJournal, subraceService subrace.ServiceSubrace, subclassService subclass.ServiceSubclass, walletService wallet.ServiceWallet, characterResourceService characterresource.ServiceCharacterResource) ServiceCharacterExport {
+	return &service{characterDataService: characterDataService, raceService: raceService, classService: classService, backgroundService: backgroundService, itemService: itemService, weaponService: weaponService, armorService: armorService, spellService: spellService, featureService: featureService, proficiencyService: proficiencyService, skillService: skillService, itemXCharacterService: itemXCharacterService, weaponXCharacterService: weaponXCharacterService, armorXCharacterService: armorXCharacterService, spellXCharacterService: spellXCharacterService, featureXCharacterService: featureXCharacterService, proficiencyXCharacterService: proficiencyXCharacterService, skillXCharacterService: skillXCharacterService, userService: userService, journalService: journalService, subraceService: subraceService, subclassService: subclassService, walletService: walletService, characterResourceService: characterResourceService}
+}
+
+// Slug normalizes a catalog name so entries can be matched across servers
+// regardless of case, spacing or punctuation.
+func Slug(name string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && builder.Len() > 0 {
+			builder.WriteRune('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(builder.String(), "-")
+}
+
+// Export implements ServiceCharacterExport. The journal entries included are
+// the ones the user exporting may read; anonymous exports carry none.
+func (s *service) Export(characterId int, cookie string) (dto.CharacterExportDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterExportDto{}, err
+	}
+
+	export := dto.CharacterExportDto{
+		Format:     ExportFormat,
+		Version:    ExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Character: dto.CharacterExportSheet{
+			Name:       character.Name,
+			Story:      character.Story,
+			Alignment:  character.Alignment,
+			Age:        character.Age,
+			Hair:       character.Hair,
+			Eyes:       character.Eyes,
+			Skin:       character.Skin,
+			Height:     character.Height,
+			Weight:     character.Weight,
+			ImgUrl:     character.ImgUrl,
+			Str:        character.Str,
+			Dex:        character.Dex,
+			Int:        character.Int,
+			Con:        character.Con,
+			Wiz:        character.Wiz,
+			Cha:        character.Cha,
+			Hitpoints:  character.Hitpoints,
+			HitDice:    character.HitDice,
+			Speed:      character.Speed,
+			ArmorClass: character.Armor_Class,
+			Level:      character.Level,
+			Exp:        character.Exp,
+		},
+		Race: dto.CharacterExportRace{
+			Slug: Slug(character.Race.Name),
+			CreateRaceDto: dto.CreateRaceDto{
+				Name:        character.Race.Name,
+				Description: character.Race.Description,
+				Speed:       character.Race.Speed,
+				Str:         character.Race.Str,
+				Dex:         character.Race.Dex,
+				Int:         character.Race.Int,
+				Con:         character.Race.Con,
+				Wiz:         character.Race.Wiz,
+				Cha:         character.Race.Cha,
+			},
+		},
+		Class:  exportClass(character.Class),
+		Wallet: character.Wallet,
+		Background: dto.CharacterExportBackground{
+			Slug:              Slug(character.Background.Name),
+			Name:              character.Background.Name,
+			Languages:         character.Background.Languages,
+			PersonalityTraits: character.Background.PersonalityTraits,
+			Ideals:            character.Background.Ideals,
+			Bond:              character.Background.Bond,
+			Flaws:             character.Background.Flaws,
+			Trait:             character.Background.Trait,
+			ToolProficiencies: character.Background.ToolProficiencies,
+		},
+	}
+
+	if character.Subrace != nil {
+		export.Subrace = &dto.CharacterExportSubrace{
+			Slug:        Slug(character.Subrace.Name),
+			Name:        character.Subrace.Name,
+			Description: character.Subrace.Description,
+			Speed:       character.Subrace.Speed,
+			Str:         character.Subrace.Str,
+			Dex:         character.Subrace.Dex,
+			Int:         character.Subrace.Int,
+			Con:         character.Subrace.Con,
+			Wiz:         character.Subrace.Wiz,
+			Cha:         character.Subrace.Cha,
+		}
+	}
+	for _, characterClass := range character.Classes {
+		exported := dto.CharacterExportClassLevel{CharacterExportClass: exportClass(characterClass.Class), Level: characterClass.Level}
+		if characterClass.Subclass != nil {
+			exported.Subclass = &dto.CharacterExportSubclass{
+				Slug:        Slug(characterClass.Subclass.Name),
+				SubclassDto: dto.SubclassDto{Name: characterClass.Subclass.Name, Description: characterClass.Subclass.Description, Level: characterClass.Subclass.Level},
+			}
+		}
+		export.Classes = append(export.Classes, exported)
+	}
+	for _, characterItem := range character.Items {
+		export.Items = append(export.Items, dto.CharacterExportItem{
+			Slug:                 Slug(characterItem.Item.Name),
+			Name:                 characterItem.Item.Name,
+			Weight:               characterItem.Item.Weight,
+			Price:                characterItem.Item.Price,
+			Description:          characterItem.Item.Description,
+			Rarity:               characterItem.Item.Rarity,
+			RequiresAttunement:   characterItem.Item.RequiresAttunement,
+			AttunementClasses:    characterItem.Item.AttunementClasses,
+			AttunementAlignments: characterItem.Item.AttunementAlignments,
+			Charges:              characterItem.Item.Charges,
+			Recharge:             characterItem.Item.Recharge,
+			RechargeDice:         characterItem.Item.RechargeDice,
+			ArmorClassBonus:      characterItem.Item.ArmorClassBonus,
+			SavingThrowBonus:     characterItem.Item.SavingThrowBonus,
+			AttackBonus:          characterItem.Item.AttackBonus,
+			DamageBonus:          characterItem.Item.DamageBonus,
+			Quantity:             characterItem.Quantity,
+			Attuned:              characterItem.Attuned,
+			ChargesUsed:          characterItem.ChargesUsed,
+		})
+	}
+	for _, characterWeapon := range character.Weapons {
+		export.Weapons = append(export.Weapons, dto.CharacterExportWeapon{
+			Slug:               Slug(characterWeapon.Weapon.Name),
+			WeaponType:         characterWeapon.Weapon.Weapon_Type,
+			Name:               characterWeapon.Weapon.Name,
+			Weight:             characterWeapon.Weapon.Weight,
+			Price:              characterWeapon.Weapon.Price,
+			Category:           characterWeapon.Weapon.Category,
+			Reach:              characterWeapon.Weapon.Reach,
+			Description:        characterWeapon.Weapon.Description,
+			Damage:             characterWeapon.Weapon.Damage,
+			VersatileDamage:    characterWeapon.Weapon.Versatile_Damage,
+			Ammunition:         characterWeapon.Weapon.Ammunition,
+			DamageType:         characterWeapon.Weapon.Damage_Type,
+			TwoHanded:          characterWeapon.Weapon.TwoHanded,
+			Finesse:            characterWeapon.Weapon.Finesse,
+			Ranged:             characterWeapon.Weapon.Ranged,
+			RequiresAttunement: characterWeapon.Weapon.RequiresAttunement,
+			Equipped:           characterWeapon.Equipped,
+			Slot:               characterWeapon.Slot,
+			Attuned:            characterWeapon.Attuned,
+		})
+	}
+	for _, characterArmor := range character.Armor {
+		export.Armor = append(export.Armor, dto.CharacterExportArmor{
+			Slug:               Slug(characterArmor.Armor.Name),
+			Material:           characterArmor.Armor.Material,
+			Name:               characterArmor.Armor.Name,
+			Weight:             characterArmor.Armor.Weight,
+			Price:              characterArmor.Armor.Price,
+			Category:           characterArmor.Armor.Category,
+			ProtectionType:     characterArmor.Armor.ProtectionType,
+			Description:        characterArmor.Armor.Description,
+			Penalty:            characterArmor.Armor.Penalty,
+			Strength:           characterArmor.Armor.Strength,
+			ArmorClass:         characterArmor.Armor.ArmorClass,
+			DexBonus:           characterArmor.Armor.DexBonus,
+			MaxDexBonus:        characterArmor.Armor.MaxDexBonus,
+			RequiresAttunement: characterArmor.Armor.RequiresAttunement,
+			Equipped:           characterArmor.Equipped,
+			Slot:               characterArmor.Slot,
+			Attuned:            characterArmor.Attuned,
+		})
+	}
+	for _, characterSpell := range character.Spells {
+		export.Spells = append(export.Spells, dto.CharacterExportSpell{
+			Slug: Slug(characterSpell.Name),
+			SpellDto: dto.SpellDto{
+				Name:            characterSpell.Name,
+				Description:     characterSpell.Description,
+				Range:           characterSpell.Range,
+				Ritual:          characterSpell.Ritual,
+				Duration:        characterSpell.Duration,
+				Concentration:   characterSpell.Concentration,
+				CastingTime:     characterSpell.CastingTime,
+				Level:           characterSpell.Level,
+				DamageType:      characterSpell.DamageType,
+				DifficultyClass: characterSpell.DifficultyClass,
+				Aoe:             characterSpell.Aoe,
+				School:          characterSpell.School,
+			},
+		})
+	}
+	for _, characterFeature := range character.Features {
+		export.Features = append(export.Features, dto.CharacterExportFeature{
+			Slug:        Slug(characterFeature.Name),
+			Name:        characterFeature.Name,
+			Description: characterFeature.Description,
+		})
+	}
+	resources, err := s.characterResourceService.GetByCharacterId(characterId)
+	if err != nil {
+		return dto.CharacterExportDto{}, err
+	}
+	for _, characterResource := range resources {
+		exported := dto.CharacterExportResource{Name: characterResource.Name, Maximum: characterResource.Formula, Recharge: characterResource.Recharge, Current: characterResource.Current}
+		for _, characterFeature := range character.Features {
+			if characterResource.FeatureId != nil && characterFeature.FeatureId == *characterResource.FeatureId {
+				exported.Feature = Slug(characterFeature.Name)
+			}
+		}
+		export.Resources = append(export.Resources, exported)
+	}
+	for _, characterProficiency := range character.Proficiencies {
+		export.Proficiencies = append(export.Proficiencies, dto.CharacterExportProficiency{
+			Slug:           Slug(characterProficiency.Name),
+			ProficiencyDto: dto.ProficiencyDto{Name: characterProficiency.Name, Type: characterProficiency.Type},
+		})
+	}
+	for _, characterSkill := range character.Skills {
+		export.Skills = append(export.Skills, dto.CharacterExportSkill{
+			Slug: Slug(characterSkill.Name),
+			Name: characterSkill.Name,
+			Stat: characterSkill.Stat,
+		})
+	}
+	if cookie != "" {
+		entries, err := s.journalService.GetByCharacterId(characterId, cookie)
+		if err != nil {
+			return dto.CharacterExportDto{}, err
+		}
+		for _, entry := range entries {
+			export.Journal = append(export.Journal, dto.CharacterExportJournal{
+				Title:       entry.Title,
+				Content:     entry.Content,
+				Visibility:  entry.Visibility,
+				Attachments: entry.Attachments,
+				CreatedAt:   entry.CreatedAt,
+			})
+		}
+	}
+
+	return export, nil
+}
+
+// Import implements ServiceCharacterExport. Catalog entries the file needs
+// are matched among the generic ones and the homebrew of the campaign, and
+// the missing ones are created as homebrew of that campaign when the user is
+// its dungeon master. Otherwise missing entries the character cannot do
+// without fail the import and the rest are dropped. When the import fails,
+// the character and the entries created for it are removed again.
+func (s *service) Import(export dto.CharacterExportDto, cookie string, campaignId *int, dungeonMaster bool) (dto.CharacterImportReportDto, error) {
+	if export.Format != ExportFormat {
+		return dto.CharacterImportReportDto{}, ErrUnknownFormat
+	}
+	if export.Version < 1 || export.Version > ExportVersion {
+		return dto.CharacterImportReportDto{}, ErrUnsupportedVersion
+	}
+	if strings.TrimSpace(export.Character.Name) == "" {
+		return dto.CharacterImportReportDto{}, ErrMissingName
+	}
+
+	owner, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return dto.CharacterImportReportDto{}, err
+	}
+
+	report := dto.CharacterImportReportDto{
+		Matched: []dto.CharacterImportEntryDto{},
+		Created: []dto.CharacterImportEntryDto{},
+		Dropped: []dto.CharacterImportEntryDto{},
+	}
+	characterId := 0
+	fail := func(err error) (dto.CharacterImportReportDto, error) {
+		s.rollback(characterId, report.Created)
+		return dto.CharacterImportReportDto{}, err
+	}
+
+	characterRace, err := s.resolveRace(export.Race, campaignId, dungeonMaster, &report)
+	if err != nil {
+		return fail(err)
+	}
+	characterSubrace, err := s.resolveSubrace(characterRace, export.Subrace, dungeonMaster, &report)
+	if err != nil {
+		return fail(err)
+	}
+	classes, err := s.resolveClasses(export, campaignId, dungeonMaster, &report)
+	if err != nil {
+		return fail(err)
+	}
+	characterBackground, err := s.resolveBackground(export.Background, campaignId, dungeonMaster, &report)
+	if err != nil {
+		return fail(err)
+	}
+
+	sheet := export.Character
+	character := domain.CharacterData{
+		User_Id:     &owner.Id,
+		Race:        characterRace,
+		Subrace:     characterSubrace,
+		Class:       classes[0].Class,
+		Classes:     classes,
+		Background:  characterBackground,
+		Name:        sheet.Name,
+		Story:       sheet.Story,
+		Alignment:   sheet.Alignment,
+		Age:         sheet.Age,
+		Hair:        sheet.Hair,
+		Eyes:        sheet.Eyes,
+		Skin:        sheet.Skin,
+		Height:      sheet.Height,
+		Weight:      sheet.Weight,
+		ImgUrl:      sheet.ImgUrl,
+		Str:         sheet.Str,
+		Dex:         sheet.Dex,
+		Int:         sheet.Int,
+		Con:         sheet.Con,
+		Wiz:         sheet.Wiz,
+		Cha:         sheet.Cha,
+		Hitpoints:   sheet.Hitpoints,
+		HitDice:     sheet.HitDice,
+		Speed:       sheet.Speed,
+		Armor_Class: sheet.ArmorClass,
+		Level:       sheet.Level,
+		Exp:         sheet.Exp,
+	}
+	if campaignId != nil {
+		character.Campaign_Id = *campaignId
+	}
+	// The exported rows are imported below, so the character is not granted
+	// its progression, subrace and background again.
+	createdCharacter, err := s.characterDataService.CreateCopy(character)
+	if err != nil {
+		return fail(err)
+	}
+	characterId = createdCharacter.Character_Id
+
+	if !wallet.IsZero(export.Wallet) {
+		if _, err := s.walletService.Credit(characterId, export.Wallet, "imported"); err != nil {
+			return fail(err)
+		}
+	}
+	s.importItems(characterId, export.Items, campaignId, dungeonMaster, &report)
+	s.importWeapons(characterId, export.Weapons, campaignId, dungeonMaster, &report)
+	s.importArmor(characterId, export.Armor, campaignId, dungeonMaster, &report)
+	s.importSpells(characterId, export.Spells, campaignId, dungeonMaster, &report)
+	s.importFeatures(characterId, export.Features, campaignId, dungeonMaster, &report)
+	s.importResources(characterId, export.Resources, &report)
+	s.importProficiencies(characterId, export.Proficiencies, &report)
+	s.importSkills(characterId, export.Skills, &report)
+	s.importJournal(characterId, export.Journal, cookie, &report)
+
+	report.Character, err = s.characterDataService.GetById(characterId)
+	if err != nil {
+		return fail(err)
+	}
+	return report, nil
+}
+
+// rollback deletes the character of a failed import, with its links and
+// journal, and the catalog entries created for it, newest first.
+func (s *service) rollback(characterId int, created []dto.CharacterImportEntryDto) {
+	if characterId != 0 {
+		if err := s.characterDataService.Delete(characterId); err != nil {
+			log.Println("character import rollback", characterId, err)
+		}
+	}
+	for i := len(created) - 1; i >= 0; i-- {
+		var err error
+		switch entry := created[i]; entry.Type {
+		case "race":
+			err = s.raceService.DeleteRace(entry.Id)
+		case "subrace":
+			err = s.subraceService.Delete(entry.Id)
+		case "class":
+			err = s.classService.Delete(entry.Id)
+		case "subclass":
+			err = s.subclassService.Delete(entry.Id)
+		case "background":
+			err = s.backgroundService.DeleteBackground(entry.Id)
+		case "item":
+			err = s.itemService.Delete(entry.Id)
+		case "weapon":
+			err = s.weaponService.Delete(entry.Id)
+		case "armor":
+			err = s.armorService.DeleteArmor(entry.Id)
+		case "spell":
+			err = s.spellService.Delete(entry.Id)
+		case "feature":
+			err = s.featureService.DeleteFeature(entry.Id)
+		}
+		if err != nil {
+			log.Println("character import rollback", created[i].Type, created[i].Id, err)
+		}
+	}
+}
+
+// homebrewDenied tells why a missing entry cannot be created as homebrew of
+// campaignId, or returns nil when it can.
+func homebrewDenied(campaignId *int, dungeonMaster bool) error {
+	switch {
+	case campaignId == nil:
+		return ErrNoCampaign
+	case !dungeonMaster:
+		return campaign.ErrNotHomebrewDungeonMaster
+	}
+	return nil
+}
+
+// droppedReason is the report reason of an entry homebrewDenied refused.
+func droppedReason(err error) string {
+	if errors.Is(err, ErrNoCampaign) {
+		return reasonNoCampaign
+	}
+	return reasonNotDM
+}
+
+// inScope reports whether a catalog entry may be used by a character imported
+// into campaignId: generic entries always, homebrew only of that campaign.
+func inScope(entryCampaignId *int, campaignId *int) bool {
+	return entryCampaignId == nil || (campaignId != nil && *entryCampaignId == *campaignId)
+}
+
+func (s *service) resolveRace(exported dto.CharacterExportRace, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) (domain.Race, error) {
+	races, err := s.raceService.GetAllRaces()
+	if err != nil {
+		return domain.Race{}, err
+	}
+	slug := entrySlug(exported.Slug, exported.Name)
+	for _, candidate := range races {
+		if inScope(candidate.CampaignId, campaignId) && Slug(candidate.Name) == slug {
+			report.Matched = append(report.Matched, importEntry("race", candidate.Name, candidate.RaceID, ""))
+			return candidate, nil
+		}
+	}
+	if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+		return domain.Race{}, fmt.Errorf("%w: race %q", err, exported.Name)
+	}
+	exported.CreateRaceDto.CampaignId = campaignId
+	created, err := s.raceService.CreateRace(exported.CreateRaceDto)
+	if err != nil {
+		return domain.Race{}, fmt.Errorf("creating race %q: %w", exported.Name, err)
+	}
+	report.Created = append(report.Created, importEntry("race", created.Name, created.RaceID, ""))
+	return created, nil
+}
+
+// resolveSubrace matches the subrace among those of the race. A missing one
+// is only created under a homebrew race, so no generic race gains subraces;
+// otherwise it is dropped.
+func (s *service) resolveSubrace(characterRace domain.Race, exported *dto.CharacterExportSubrace, dungeonMaster bool, report *dto.CharacterImportReportDto) (*domain.Subrace, error) {
+	if exported == nil {
+		return nil, nil
+	}
+	subraces, err := s.subraceService.GetByRaceId(characterRace.RaceID)
+	if err != nil {
+		return nil, err
+	}
+	slug := entrySlug(exported.Slug, exported.Name)
+	for _, candidate := range subraces {
+		if Slug(candidate.Name) == slug {
+			report.Matched = append(report.Matched, importEntry("subrace", candidate.Name, candidate.SubraceId, ""))
+			return &candidate, nil
+		}
+	}
+	if characterRace.CampaignId == nil {
+		report.Dropped = append(report.Dropped, importEntry("subrace", exported.Name, 0, reasonGenericParent))
+		return nil, nil
+	}
+	if !dungeonMaster {
+		report.Dropped = append(report.Dropped, importEntry("subrace", exported.Name, 0, reasonNotDM))
+		return nil, nil
+	}
+	created, err := s.subraceService.Create(characterRace.RaceID, dto.SubraceDto{
+		Name:        exported.Name,
+		Description: exported.Description,
+		Speed:       exported.Speed,
+		Str:         exported.Str,
+		Dex:         exported.Dex,
+		Int:         exported.Int,
+		Con:         exported.Con,
+		Wiz:         exported.Wiz,
+		Cha:         exported.Cha,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating subrace %q: %w", exported.Name, err)
+	}
+	report.Created = append(report.Created, importEntry("subrace", created.Name, created.SubraceId, ""))
+	return &created, nil
+}
+
+// resolveClasses resolves every class of the file with its level and
+// subclass. Version 1 files only carry the primary class, at the sheet level.
+func (s *service) resolveClasses(export dto.CharacterExportDto, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) ([]domain.CharacterClass, error) {
+	exportedClasses := export.Classes
+	if len(exportedClasses) == 0 {
+		exportedClasses = []dto.CharacterExportClassLevel{{CharacterExportClass: export.Class, Level: export.Character.Level}}
+	}
+	classes := []domain.CharacterClass{}
+	for _, exported := range exportedClasses {
+		characterClass, err := s.resolveClass(exported.CharacterExportClass, campaignId, dungeonMaster, report)
+		if err != nil {
+			return nil, err
+		}
+		chosen, err := s.resolveSubclass(characterClass, exported, dungeonMaster, report)
+		if err != nil {
+			return nil, err
+		}
+		classes = append(classes, domain.CharacterClass{Class: characterClass, Subclass: chosen, Level: exported.Level})
+	}
+	return classes, nil
+}
+
+func (s *service) resolveClass(exported dto.CharacterExportClass, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) (domain.Class, error) {
+	classes, err := s.classService.GetAll()
+	if err != nil {
+		return domain.Class{}, err
+	}
+	slug := entrySlug(exported.Slug, exported.Name)
+	for _, candidate := range classes {
+		if inScope(candidate.CampaignId, campaignId) && Slug(candidate.Name) == slug {
+			report.Matched = append(report.Matched, importEntry("class", candidate.Name, candidate.ClassId, ""))
+			return candidate, nil
+		}
+	}
+	if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+		return domain.Class{}, fmt.Errorf("%w: class %q", err, exported.Name)
+	}
+	exported.ClassDto.CampaignId = campaignId
+	created, err := s.classService.Create(exported.ClassDto)
+	if err != nil {
+		return domain.Class{}, fmt.Errorf("creating class %q: %w", exported.Name, err)
+	}
+	report.Created = append(report.Created, importEntry("class", created.Name, created.ClassId, ""))
+	return created, nil
+}
+
+// resolveSubclass matches the subclass among those of the class, creating a
+// missing one only under a homebrew class like resolveSubrace does.
+func (s *service) resolveSubclass(characterClass domain.Class, exported dto.CharacterExportClassLevel, dungeonMaster bool, report *dto.CharacterImportReportDto) (*domain.Subclass, error) {
+	if exported.Subclass == nil {
+		return nil, nil
+	}
+	subclasses, err := s.subclassService.GetByClassId(characterClass.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	var chosen *domain.Subclass
+	slug := entrySlug(exported.Subclass.Slug, exported.Subclass.Name)
+	for i := range subclasses {
+		if Slug(subclasses[i].Name) == slug {
+			chosen = &subclasses[i]
+			break
+		}
+	}
+	switch {
+	case chosen != nil && chosen.Level > exported.Level:
+		report.Dropped = append(report.Dropped, importEntry("subclass", chosen.Name, chosen.SubclassId, reasonLevelTooLow))
+		return nil, nil
+	case chosen != nil:
+		report.Matched = append(report.Matched, importEntry("subclass", chosen.Name, chosen.SubclassId, ""))
+		return chosen, nil
+	case characterClass.CampaignId == nil:
+		report.Dropped = append(report.Dropped, importEntry("subclass", exported.Subclass.Name, 0, reasonGenericParent))
+		return nil, nil
+	case !dungeonMaster:
+		report.Dropped = append(report.Dropped, importEntry("subclass", exported.Subclass.Name, 0, reasonNotDM))
+		return nil, nil
+	}
+	subclassDto := exported.Subclass.SubclassDto
+	subclassDto.Level = min(subclassDto.Level, exported.Level)
+	created, err := s.subclassService.Create(characterClass.ClassId, subclassDto)
+	if err != nil {
+		return nil, fmt.Errorf("creating subclass %q: %w", exported.Subclass.Name, err)
+	}
+	report.Created = append(report.Created, importEntry("subclass", created.Name, created.SubclassId, ""))
+	return &created, nil
+}
+
+func (s *service) resolveBackground(exported dto.CharacterExportBackground, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) (domain.Background, error) {
+	backgrounds, err := s.backgroundService.GetAllBackgrounds()
+	if err != nil {
+		return domain.Background{}, err
+	}
+	slug := entrySlug(exported.Slug, exported.Name)
+	for _, candidate := range backgrounds {
+		if inScope(candidate.CampaignId, campaignId) && Slug(candidate.Name) == slug {
+			report.Matched = append(report.Matched, importEntry("background", candidate.Name, candidate.BackgroundID, ""))
+			return candidate, nil
+		}
+	}
+	if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+		return domain.Background{}, fmt.Errorf("%w: background %q", err, exported.Name)
+	}
+	created, err := s.backgroundService.CreateBackground(dto.CreateBackgroundDto{
+		Name:              exported.Name,
+		Languages:         exported.Languages,
+		PersonalityTraits: exported.PersonalityTraits,
+		Ideals:            exported.Ideals,
+		Bond:              exported.Bond,
+		Flaws:             exported.Flaws,
+		Trait:             exported.Trait,
+		ToolProficiencies: exported.ToolProficiencies,
+		CampaignId:        campaignId,
+	})
+	if err != nil {
+		return domain.Background{}, fmt.Errorf("creating background %q: %w", exported.Name, err)
+	}
+	report.Created = append(report.Created, importEntry("background", created.Name, created.BackgroundID, ""))
+	return created, nil
+}
+
+func (s *service) importItems(characterId int, exported []dto.CharacterExportItem, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	catalog, err := s.itemService.GetAllGeneric()
+	if err == nil && campaignId != nil {
+		var campaignItems []domain.Item
+		campaignItems, err = s.itemService.GetByCampaignId(*campaignId)
+		catalog = append(campaignItems, catalog...)
+	}
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("item", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Item
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				report.Matched = append(report.Matched, importEntry("item", resolved.Name, resolved.Item_Id, ""))
+				break
+			}
+		}
+		if resolved == nil {
+			if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+				report.Dropped = append(report.Dropped, importEntry("item", entry.Name, 0, droppedReason(err)))
+				continue
+			}
+			created, createErr := s.itemService.Create(domain.Item{
+				Name:                 entry.Name,
+				Weight:               entry.Weight,
+				Price:                entry.Price,
+				Description:          entry.Description,
+				Rarity:               entry.Rarity,
+				RequiresAttunement:   entry.RequiresAttunement,
+				AttunementClasses:    entry.AttunementClasses,
+				AttunementAlignments: entry.AttunementAlignments,
+				Charges:              entry.Charges,
+				Recharge:             entry.Recharge,
+				RechargeDice:         entry.RechargeDice,
+				ArmorClassBonus:      entry.ArmorClassBonus,
+				SavingThrowBonus:     entry.SavingThrowBonus,
+				AttackBonus:          entry.AttackBonus,
+				DamageBonus:          entry.DamageBonus,
+				Campaign_Id:          campaignId,
+			})
+			if createErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("item", entry.Name, 0, createErr.Error()))
+				continue
+			}
+			catalog = append(catalog, created)
+			resolved = &created
+			report.Created = append(report.Created, importEntry("item", created.Name, created.Item_Id, ""))
+		}
+		quantity := entry.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+		link, linkErr := s.itemXCharacterService.Create(domain.ItemXCharacterData{CharacterData_Id: characterId, Item: *resolved, Quantity: quantity})
+		if linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("item", entry.Name, resolved.Item_Id, linkErr.Error()))
+			continue
+		}
+		// Attunement and charges go through the usual rules; when they refuse,
+		// the item is kept and only its state is reported.
+		if entry.Attuned {
+			if _, linkErr = s.itemXCharacterService.SetAttuned(link.Character_Item_Id, true); linkErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("attunement", entry.Name, resolved.Item_Id, linkErr.Error()))
+			}
+		}
+		if entry.ChargesUsed > 0 {
+			if _, linkErr = s.itemXCharacterService.SpendCharges(link.Character_Item_Id, entry.ChargesUsed); linkErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("charges", entry.Name, resolved.Item_Id, linkErr.Error()))
+			}
+		}
+	}
+}
+
+func (s *service) importWeapons(characterId int, exported []dto.CharacterExportWeapon, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	catalog, err := s.weaponService.GetAllGeneric()
+	if err == nil && campaignId != nil {
+		var campaignWeapons []domain.Weapon
+		campaignWeapons, err = s.weaponService.GetByCampaignId(*campaignId)
+		catalog = append(campaignWeapons, catalog...)
+	}
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("weapon", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Weapon
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				report.Matched = append(report.Matched, importEntry("weapon", resolved.Name, resolved.Weapon_Id, ""))
+				break
+			}
+		}
+		if resolved == nil {
+			if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+				report.Dropped = append(report.Dropped, importEntry("weapon", entry.Name, 0, droppedReason(err)))
+				continue
+			}
+			created, createErr := s.weaponService.Create(domain.Weapon{
+				Weapon_Type:        entry.WeaponType,
+				Name:               entry.Name,
+				Weight:             entry.Weight,
+				Price:              entry.Price,
+				Category:           entry.Category,
+				Reach:              entry.Reach,
+				Description:        entry.Description,
+				Damage:             entry.Damage,
+				Versatile_Damage:   entry.VersatileDamage,
+				Ammunition:         entry.Ammunition,
+				Damage_Type:        entry.DamageType,
+				TwoHanded:          entry.TwoHanded,
+				Finesse:            entry.Finesse,
+				Ranged:             entry.Ranged,
+				RequiresAttunement: entry.RequiresAttunement,
+				Campaign_Id:        campaignId,
+			})
+			if createErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("weapon", entry.Name, 0, createErr.Error()))
+				continue
+			}
+			catalog = append(catalog, created)
+			resolved = &created
+			report.Created = append(report.Created, importEntry("weapon", created.Name, created.Weapon_Id, ""))
+		}
+		if _, linkErr := s.weaponXCharacterService.Create(domain.WeaponXCharacterData{CharacterData_Id: characterId, Weapon: *resolved, Equipped: entry.Equipped, Slot: entry.Slot, Attuned: entry.Attuned}); linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("weapon", entry.Name, resolved.Weapon_Id, linkErr.Error()))
+		}
+	}
+}
+
+func (s *service) importArmor(characterId int, exported []dto.CharacterExportArmor, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	allArmor, err := s.armorService.GetAllArmor()
+	var catalog []domain.Armor
+	for _, candidate := range allArmor {
+		if inScope(candidate.CampaignId, campaignId) {
+			catalog = append(catalog, candidate)
+		}
+	}
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("armor", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Armor
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				report.Matched = append(report.Matched, importEntry("armor", resolved.Name, resolved.ArmorId, ""))
+				break
+			}
+		}
+		if resolved == nil {
+			if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+				report.Dropped = append(report.Dropped, importEntry("armor", entry.Name, 0, droppedReason(err)))
+				continue
+			}
+			created, createErr := s.armorService.CreateArmor(dto.CreateArmorDto{
+				Material:           entry.Material,
+				Name:               entry.Name,
+				Weight:             entry.Weight,
+				Price:              entry.Price,
+				Category:           entry.Category,
+				ProtectionType:     entry.ProtectionType,
+				Description:        entry.Description,
+				Penalty:            entry.Penalty,
+				Strength:           entry.Strength,
+				ArmorClass:         entry.ArmorClass,
+				DexBonus:           entry.DexBonus,
+				MaxDexBonus:        entry.MaxDexBonus,
+				RequiresAttunement: entry.RequiresAttunement,
+				CampaignId:         campaignId,
+			})
+			if createErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("armor", entry.Name, 0, createErr.Error()))
+				continue
+			}
+			catalog = append(catalog, created)
+			resolved = &created
+			report.Created = append(report.Created, importEntry("armor", created.Name, created.ArmorId, ""))
+		}
+		if _, linkErr := s.armorXCharacterService.CreateArmorXCharacterData(domain.ArmorXCharacterData{CharacterData_Id: characterId, Armor: *resolved, Equipped: entry.Equipped, Slot: entry.Slot, Attuned: entry.Attuned}); linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("armor", entry.Name, resolved.ArmorId, linkErr.Error()))
+		}
+	}
+}
+
+func (s *service) importSpells(characterId int, exported []dto.CharacterExportSpell, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	allSpells, err := s.spellService.GetAll()
+	var catalog []domain.Spell
+	for _, candidate := range allSpells {
+		if inScope(candidate.CampaignId, campaignId) {
+			catalog = append(catalog, candidate)
+		}
+	}
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("spell", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Spell
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				report.Matched = append(report.Matched, importEntry("spell", resolved.Name, resolved.SpellId, ""))
+				break
+			}
+		}
+		if resolved == nil {
+			if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+				report.Dropped = append(report.Dropped, importEntry("spell", entry.Name, 0, droppedReason(err)))
+				continue
+			}
+			entry.SpellDto.CampaignId = campaignId
+			created, createErr := s.spellService.Create(entry.SpellDto)
+			if createErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("spell", entry.Name, 0, createErr.Error()))
+				continue
+			}
+			catalog = append(catalog, created)
+			resolved = &created
+			report.Created = append(report.Created, importEntry("spell", created.Name, created.SpellId, ""))
+		}
+		if _, linkErr := s.spellXCharacterService.Create(domain.CharacterXSpell{CharacterId: characterId, SpellId: resolved.SpellId}); linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("spell", entry.Name, resolved.SpellId, linkErr.Error()))
+		}
+	}
+}
+
+func (s *service) importFeatures(characterId int, exported []dto.CharacterExportFeature, campaignId *int, dungeonMaster bool, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	allFeatures, err := s.featureService.GetAllFeatures()
+	var catalog []domain.Feature
+	for _, candidate := range allFeatures {
+		if inScope(candidate.CampaignId, campaignId) {
+			catalog = append(catalog, candidate)
+		}
+	}
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("feature", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Feature
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				break
+			}
+		}
+		if resolved == nil {
+			if err := homebrewDenied(campaignId, dungeonMaster); err != nil {
+				report.Dropped = append(report.Dropped, importEntry("feature", entry.Name, 0, droppedReason(err)))
+				continue
+			}
+			// Creating a feature with a character id also links it.
+			created, createErr := s.featureService.CreateFeature(dto.CreateFeatureDto{CharacterId: characterId, Name: entry.Name, Description: entry.Description, CampaignId: campaignId})
+			if createErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("feature", entry.Name, 0, createErr.Error()))
+				continue
+			}
+			catalog = append(catalog, created)
+			report.Created = append(report.Created, importEntry("feature", created.Name, created.FeatureId, ""))
+			continue
+		}
+		if _, linkErr := s.featureXCharacterService.CreateCharacterFeature(dto.CreateCharacterFeatureDto{FeatureId: resolved.FeatureId, CharacterId: characterId}); linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("feature", entry.Name, resolved.FeatureId, linkErr.Error()))
+			continue
+		}
+		report.Matched = append(report.Matched, importEntry("feature", resolved.Name, resolved.FeatureId, ""))
+	}
+}
+
+// Proficiencies cannot be scoped to a campaign, so like skills the unknown
+// ones are dropped instead of created.
+func (s *service) importProficiencies(characterId int, exported []dto.CharacterExportProficiency, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	catalog, err := s.proficiencyService.GetAll()
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("proficiency", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Proficiency
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				report.Matched = append(report.Matched, importEntry("proficiency", resolved.Name, resolved.ProficiencyId, ""))
+				break
+			}
+		}
+		if resolved == nil {
+			report.Dropped = append(report.Dropped, importEntry("proficiency", entry.Name, 0, reasonNotInCatalog))
+			continue
+		}
+		if _, linkErr := s.proficiencyXCharacterService.Create(domain.CharacterXProficiency{CharacterId: characterId, ProficiencyId: resolved.ProficiencyId}); linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("proficiency", entry.Name, resolved.ProficiencyId, linkErr.Error()))
+		}
+	}
+}
+
+// importResources restores the uses left of each counter. Feature counters
+// exist once the feature is imported; custom counters are created again.
+func (s *service) importResources(characterId int, exported []dto.CharacterExportResource, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	current, err := s.characterResourceService.GetByCharacterId(characterId)
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("resource", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *dto.CharacterResourceDto
+		for i := range current {
+			if (current[i].FeatureId != nil) == (entry.Feature != "") && Slug(current[i].Name) == Slug(entry.Name) {
+				resolved = &current[i]
+				break
+			}
+		}
+		if resolved == nil {
+			if entry.Feature != "" {
+				report.Dropped = append(report.Dropped, importEntry("resource", entry.Name, 0, reasonFeatureNotFound))
+				continue
+			}
+			created, createErr := s.characterResourceService.Create(characterId, dto.CreateCharacterResourceDto{Name: entry.Name, Maximum: entry.Maximum, Recharge: entry.Recharge})
+			if createErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("resource", entry.Name, 0, createErr.Error()))
+				continue
+			}
+			resolved = &created
+			report.Created = append(report.Created, importEntry("resource", created.Name, created.CharacterResourceId, ""))
+		}
+		if spent := resolved.Current - max(entry.Current, 0); spent > 0 {
+			if _, spendErr := s.characterResourceService.Spend(characterId, resolved.CharacterResourceId, spent); spendErr != nil {
+				report.Dropped = append(report.Dropped, importEntry("resource", entry.Name, resolved.CharacterResourceId, spendErr.Error()))
+			}
+		}
+	}
+}
+
+// Skills are a fixed list, unknown ones are dropped instead of created.
+func (s *service) importSkills(characterId int, exported []dto.CharacterExportSkill, report *dto.CharacterImportReportDto) {
+	if len(exported) == 0 {
+		return
+	}
+	catalog, err := s.skillService.GetAll()
+	for _, entry := range exported {
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("skill", entry.Name, 0, err.Error()))
+			continue
+		}
+		var resolved *domain.Skill
+		slug := entrySlug(entry.Slug, entry.Name)
+		for i := range catalog {
+			if Slug(catalog[i].Name) == slug {
+				resolved = &catalog[i]
+				break
+			}
+		}
+		if resolved == nil {
+			report.Dropped = append(report.Dropped, importEntry("skill", entry.Name, 0, reasonNotInCatalog))
+			continue
+		}
+		if _, linkErr := s.skillXCharacterService.Create(domain.SkillXCharacterData{SkillID: int64(resolved.SkillId), CharacterID: int64(characterId)}); linkErr != nil {
+			report.Dropped = append(report.Dropped, importEntry("skill", entry.Name, resolved.SkillId, linkErr.Error()))
+			continue
+		}
+		report.Matched = append(report.Matched, importEntry("skill", resolved.Name, resolved.SkillId, ""))
+	}
+}
+
+func exportClass(class domain.Class) dto.CharacterExportClass {
+	return dto.CharacterExportClass{
+		Slug: Slug(class.Name),
+		ClassDto: dto.ClassDto{
+			Name:                class.Name,
+			Description:         class.Description,
+			ProficiencyBonus:    class.ProficiencyBonus,
+			HitDice:             class.HitDice,
+			ArmorProficiencies:  class.ArmorProficiencies,
+			WeaponProficiencies: class.WeaponProficiencies,
+			ToolProficiencies:   class.ToolProficiencies,
+			SpellcastingAbility: class.SpellcastingAbility,
+		},
+	}
+}
+
+func entrySlug(slug string, name string) string {
+	if slug != "" {
+		return Slug(slug)
+	}
+	return Slug(name)
+}
+
+func importEntry(entryType string, name string, id int, reason string) dto.CharacterImportEntryDto {
+	return dto.CharacterImportEntryDto{Type: entryType, Name: name, Id: id, Reason: reason}
+}
+
+func (s *service) importJournal(characterId int, exported []dto.CharacterExportJournal, cookie string, report *dto.CharacterImportReportDto) {
+	for _, entry := range exported {
+		created, err := s.journalService.Create(characterId, dto.JournalEntryDto{
+			Title:       entry.Title,
+			Content:     entry.Content,
+			Visibility:  entry.Visibility,
+			Attachments: entry.Attachments,
+		}, cookie)
+		if err != nil {
+			report.Dropped = append(report.Dropped, importEntry("journal", entry.Title, 0, err.Error()))
+			continue
+		}
+		report.Created = append(report.Created, importEntry("journal", created.Title, created.JournalEntryId, ""))
+	}
+}
@@ -0,0 +1,25 @@
+package characterhistory
+
+import (
+	"time"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryCharacterHistory interface {
+	Create(history domain.CharacterHistory) (domain.CharacterHistory, error)
+	GetByCharacterId(characterId int) ([]domain.CharacterHistory, error)
+	GetByVersion(characterId int, version int) (domain.CharacterHistory, error)
+	GetLatest(characterId int) (domain.CharacterHistory, error)
+	GetLatestBefore(characterId int, moment time.Time) (domain.CharacterHistory, error)
+}
+
+type ServiceCharacterHistory interface {
+	Record(characterId int, authorId *string, change string) (domain.CharacterHistory, error)
+	RecordBaseline(characterId int) error
+	GetHistory(characterId int) ([]dto.CharacterHistoryEntryDto, error)
+	GetVersion(characterId int, version int) (dto.CharacterVersionDto, error)
+	GetAtSessionStart(characterId int, sessionId int) (dto.CharacterVersionDto, error)
+	Restore(characterId int, version int, authorId *string) (dto.FullCharacterData, error)
+}
@@ -0,0 +1,134 @@
+package characterhistory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrPrepareStatement    = errors.New("error preparing statement")
+	ErrGettingLastInsertId = errors.New("error getting last insert id")
+	ErrNotFound            = errors.New("character history not found")
+)
+
+type characterHistoryMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewCharacterHistoryRepository(db *sql.DB) RepositoryCharacterHistory {
+	return &characterHistoryMySqlRepository{db: db}
+}
+
+// Create implements RepositoryCharacterHistory. The version is assigned inside
+// the transaction so concurrent changes to the same character do not collide.
+func (r *characterHistoryMySqlRepository) Create(history domain.CharacterHistory) (domain.CharacterHistory, error) {
+	tempContext := context.Background()
+	tx, err := r.db.BeginTx(tempContext, nil)
+	if err != nil {
+		return domain.CharacterHistory{}, err
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRowContext(tempContext, QueryGetNextVersion, history.CharacterId).Scan(&history.Version); err != nil {
+		return domain.CharacterHistory{}, err
+	}
+
+	statement, err := tx.PrepareContext(tempContext, QueryInsert)
+	if err != nil {
+		return domain.CharacterHistory{}, ErrPrepareStatement
+	}
+	defer statement.Close()
+
+	history.CreatedAt = time.Now().UTC()
+	result, err := statement.ExecContext(
+		tempContext,
+		history.CharacterId,
+		history.Version,
+		history.AuthorId,
+		history.Change,
+		history.Snapshot,
+		history.CreatedAt,
+	)
+	if err != nil {
+		return domain.CharacterHistory{}, err
+	}
+
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return domain.CharacterHistory{}, ErrGettingLastInsertId
+	}
+	history.CharacterHistoryId = int(lastId)
+
+	if err := tx.Commit(); err != nil {
+		return domain.CharacterHistory{}, err
+	}
+	return history, nil
+}
+
+// GetByCharacterId implements RepositoryCharacterHistory.
+func (r *characterHistoryMySqlRepository) GetByCharacterId(characterId int) ([]domain.CharacterHistory, error) {
+	rows, err := r.db.Query(QueryGetByCharacterId, characterId)
+	if err != nil {
+		return []domain.CharacterHistory{}, err
+	}
+	defer rows.Close()
+
+	histories := []domain.CharacterHistory{}
+	for rows.Next() {
+		var history domain.CharacterHistory
+		if err := scanCharacterHistory(rows, &history); err != nil {
+			return []domain.CharacterHistory{}, err
+		}
+		histories = append(histories, history)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.CharacterHistory{}, err
+	}
+	return histories, nil
+}
+
+// GetByVersion implements RepositoryCharacterHistory.
+func (r *characterHistoryMySqlRepository) GetByVersion(characterId int, version int) (domain.CharacterHistory, error) {
+	return r.getOne(QueryGetByVersion, characterId, version)
+}
+
+// GetLatest implements RepositoryCharacterHistory.
+func (r *characterHistoryMySqlRepository) GetLatest(characterId int) (domain.CharacterHistory, error) {
+	return r.getOne(QueryGetLatest, characterId)
+}
+
+// GetLatestBefore implements RepositoryCharacterHistory.
+func (r *characterHistoryMySqlRepository) GetLatestBefore(characterId int, moment time.Time) (domain.CharacterHistory, error) {
+	return r.getOne(QueryGetLatestBefore, characterId, moment)
+}
+
+func (r *characterHistoryMySqlRepository) getOne(query string, args ...any) (domain.CharacterHistory, error) {
+	var history domain.CharacterHistory
+	if err := scanCharacterHistory(r.db.QueryRow(query, args...), &history); err != nil {
+		if err == sql.ErrNoRows {
+			return domain.CharacterHistory{}, ErrNotFound
+		}
+		return domain.CharacterHistory{}, err
+	}
+	return history, nil
+}
+
+type scannable interface {
+	Scan(dest ...any) error
+}
+
+func scanCharacterHistory(row scannable, history *domain.CharacterHistory) error {
+	return row.Scan(
+		&history.CharacterHistoryId,
+		&history.CharacterId,
+		&history.Version,
+		&history.AuthorId,
+		&history.Change,
+		&history.Snapshot,
+		&history.CreatedAt,
+	)
+}
@@ -0,0 +1,787 @@
+package characterhistory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	characterresource "github.com/proyecto-dnd/backend/internal/characterResource"
+	characterstatus "github.com/proyecto-dnd/backend/internal/characterStatus"
+	characterXclass "github.com/proyecto-dnd/backend/internal/characterXClass"
+	characterXproficiency "github.com/proyecto-dnd/backend/internal/characterXProficiency"
+	characterXspell "github.com/proyecto-dnd/backend/internal/characterXSpell"
+	"github.com/proyecto-dnd/backend/internal/character_feature"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/session"
+	skillxcharacterdata "github.com/proyecto-dnd/backend/internal/skillXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+	"github.com/proyecto-dnd/backend/internal/wallet"
+	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
+)
+
+var ErrNoSnapshotBeforeSession = errors.New("no snapshot of the character exists before the session started")
+
+type service struct {
+	repository                   RepositoryCharacterHistory
+	characterDataService         characterdata.ServiceCharacterData
+	sessionService               session.SessionService
+	itemXCharacterService        itemxcharacterdata.ServiceItemXCharacterData
+	weaponXCharacterService      weaponxcharacterdata.ServiceWeaponXCharacterData
+	armorXCharacterService       armorXCharacterData.ServiceArmorXCharacterData
+	spellXCharacterService       characterXspell.ServiceCharacterXSpell
+	featureXCharacterService     character_feature.CharacterFeatureService
+	proficiencyXCharacterService characterXproficiency.CharacterXProficiencyService
+	skillXCharacterService       skillxcharacterdata.ServiceSkillXCharacter
+	walletService                wallet.ServiceWallet
+	characterStatusService       characterstatus.ServiceCharacterStatus
+	characterResourceService     characterresource.ServiceCharacterResource
+}
+
+func NewCharacterHistoryService(repository RepositoryCharacterHistory, characterDataService characterdata.ServiceCharacterData, sessionService session.SessionService, itemXCharacterService itemxcharacterdata.ServiceItemXCharacterData, weaponXCharacterService weaponxcharacterdata.ServiceWeaponXCharacterData, armorXCharacterService armorXCharacterData.ServiceArmorXCharacterData, spellXCharacterService characterXspell.ServiceCharacterXSpell, featureXCharacterService character_feature.CharacterFeatureService, proficiencyXCharacterService characterXproficiency.CharacterXProficiencyService, skillXCharacterService skillxcharacterdata.ServiceSkillXCharacter, walletService wallet.ServiceWallet, characterStatusService characterstatus.ServiceCharacterStatus, characterResourceService characterresource.ServiceCharacterResource) ServiceCharacterHistory {
+	return &service{repository: repository, characterDataService: characterDataService, sessionService: sessionService, itemXCharacterService: itemXCharacterService, weaponXCharacterService: weaponXCharacterService, armorXCharacterService: armorXCharacterService, spellXCharacterService: spellXCharacterService, featureXCharacterService: featureXCharacterService, proficiencyXCharacterService: proficiencyXCharacterService, skillXCharacterService: skillXCharacterService, walletService: walletService, characterStatusService: characterStatusService, characterResourceService: characterResourceService}
+}
+
+// snapshot is what a version stores: the character sheet plus its hitpoints,
+// slots, hit dice, death saves and resource uses, which change in play
+// without touching the sheet. Versions recorded before they were kept have
+// nil Status and Resources.
+type snapshot struct {
+	dto.FullCharacterData
+	Status    *dto.CharacterStatusDto    `json:"status"`
+	Resources []dto.CharacterResourceDto `json:"resources"`
+}
+
+// snapshotOf reads the character the way a version stores it.
+func (s *service) snapshotOf(characterId int) (snapshot, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return snapshot{}, err
+	}
+	status, err := s.characterStatusService.GetStatus(characterId)
+	if err != nil {
+		return snapshot{}, err
+	}
+	resources, err := s.characterResourceService.GetByCharacterId(characterId)
+	if err != nil {
+		return snapshot{}, err
+	}
+	return snapshot{FullCharacterData: character, Status: &status, Resources: resources}, nil
+}
+
+// Record implements ServiceCharacterHistory. A new version is only stored when
+// the character actually differs from the latest snapshot.
+func (s *service) Record(characterId int, authorId *string, change string) (domain.CharacterHistory, error) {
+	current, err := s.snapshotOf(characterId)
+	if err != nil {
+		return domain.CharacterHistory{}, err
+	}
+	snapshot, err := json.Marshal(current)
+	if err != nil {
+		return domain.CharacterHistory{}, err
+	}
+
+	latest, err := s.repository.GetLatest(characterId)
+	if err == nil && bytes.Equal(latest.Snapshot, snapshot) {
+		return latest, nil
+	}
+	if err != nil && err != ErrNotFound {
+		return domain.CharacterHistory{}, err
+	}
+
+	return s.repository.Create(domain.CharacterHistory{
+		CharacterId: characterId,
+		AuthorId:    authorId,
+		Change:      change,
+		Snapshot:    snapshot,
+	})
+}
+
+// RecordBaseline implements ServiceCharacterHistory. It stores the character as
+// it is before its first tracked change, so that change can be undone.
+func (s *service) RecordBaseline(characterId int) error {
+	_, err := s.repository.GetLatest(characterId)
+	if err != ErrNotFound {
+		return err
+	}
+	_, err = s.Record(characterId, nil, "baseline")
+	return err
+}
+
+// GetHistory implements ServiceCharacterHistory.
+func (s *service) GetHistory(characterId int) ([]dto.CharacterHistoryEntryDto, error) {
+	histories, err := s.repository.GetByCharacterId(characterId)
+	if err != nil {
+		return []dto.CharacterHistoryEntryDto{}, err
+	}
+
+	entries := []dto.CharacterHistoryEntryDto{}
+	var previous map[string]interface{}
+	for _, history := range histories {
+		var current map[string]interface{}
+		if err := json.Unmarshal(history.Snapshot, &current); err != nil {
+			return []dto.CharacterHistoryEntryDto{}, err
+		}
+		diffs := []dto.CharacterFieldDiffDto{}
+		if previous != nil {
+			diffs = diffSnapshots(previous, current)
+		}
+		entries = append(entries, dto.CharacterHistoryEntryDto{
+			Version:   history.Version,
+			AuthorId:  history.AuthorId,
+			Change:    history.Change,
+			CreatedAt: history.CreatedAt,
+			Diffs:     diffs,
+		})
+		previous = current
+	}
+	return entries, nil
+}
+
+// GetVersion implements ServiceCharacterHistory.
+func (s *service) GetVersion(characterId int, version int) (dto.CharacterVersionDto, error) {
+	history, err := s.repository.GetByVersion(characterId, version)
+	if err != nil {
+		return dto.CharacterVersionDto{}, err
+	}
+	return historyToVersionDto(history)
+}
+
+// GetAtSessionStart implements ServiceCharacterHistory.
+func (s *service) GetAtSessionStart(characterId int, sessionId int) (dto.CharacterVersionDto, error) {
+	characterSession, err := s.sessionService.GetSessionById(sessionId)
+	if err != nil {
+		return dto.CharacterVersionDto{}, err
+	}
+	history, err := s.repository.GetLatestBefore(characterId, characterSession.Start)
+	if err != nil {
+		if err == ErrNotFound {
+			return dto.CharacterVersionDto{}, ErrNoSnapshotBeforeSession
+		}
+		return dto.CharacterVersionDto{}, err
+	}
+	return historyToVersionDto(history)
+}
+
+// Restore implements ServiceCharacterHistory. Links are reconciled instead of
+// recreated so ids referenced by trades stay valid. The classes of the version
+// are checked before the first write, and when a write still fails the
+// character is put back the way it was before the restore began.
+func (s *service) Restore(characterId int, version int, authorId *string) (dto.FullCharacterData, error) {
+	history, err := s.repository.GetByVersion(characterId, version)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	var target snapshot
+	if err := json.Unmarshal(history.Snapshot, &target); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	if err := checkClasses(target); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	before, err := s.snapshotOf(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, err
+	}
+
+	reason := fmt.Sprintf("restored version %d", version)
+	if err := s.apply(characterId, target, reason); err != nil {
+		if undoErr := s.apply(characterId, before, fmt.Sprintf("restore of version %d undone", version)); undoErr != nil {
+			return dto.FullCharacterData{}, errors.Join(err, fmt.Errorf("undoing the restore: %w", undoErr))
+		}
+		return dto.FullCharacterData{}, err
+	}
+
+	if _, err := s.Record(characterId, authorId, reason); err != nil {
+		return dto.FullCharacterData{}, err
+	}
+	return s.characterDataService.GetById(characterId)
+}
+
+// checkClasses refuses versions whose classes break the multiclass rules, for
+// instance after a class or subclass changed in the catalog, so a restore does
+// not fail halfway through.
+func checkClasses(target snapshot) error {
+	if len(target.Classes) == 0 {
+		return characterdata.ErrLastClass
+	}
+	for _, characterClass := range target.Classes {
+		if characterClass.Level < 1 {
+			return characterdata.ErrInvalidClassLevel
+		}
+		if characterClass.Subclass == nil {
+			continue
+		}
+		if characterClass.Subclass.ClassId != characterClass.Class.ClassId {
+			return subclass.ErrOtherClass
+		}
+		if characterClass.Level < characterClass.Subclass.Level {
+			return characterdata.ErrSubclassLevel
+		}
+	}
+	if characterXclass.TotalLevel(target.Classes) > characterdata.MaxCharacterLevel {
+		return characterdata.ErrInvalidClassLevel
+	}
+	if len(target.Classes) == 1 {
+		return nil
+	}
+	character := fullCharacterToCharacterData(target.FullCharacterData, target.Character_Id)
+	for _, characterClass := range target.Classes {
+		if err := characterXclass.CheckPrerequisite(character, characterClass.Class); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply brings the sheet, classes, wallet, links and play state of the
+// character to those of the snapshot, stopping at the first write that fails.
+func (s *service) apply(characterId int, target snapshot, reason string) error {
+	if _, err := s.characterDataService.Update(fullCharacterToCharacterData(target.FullCharacterData, characterId)); err != nil {
+		return err
+	}
+	if err := s.restoreClasses(characterId, target.Classes); err != nil {
+		return err
+	}
+	// Class changes grant and take back features and proficiencies, so the
+	// links are compared with the character as it is now.
+	current, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return err
+	}
+	if current.Wallet != target.Wallet {
+		if _, err := s.walletService.SetBalance(characterId, target.Wallet, reason); err != nil {
+			return fmt.Errorf("wallet: %w", err)
+		}
+	}
+	if err := s.restoreItems(characterId, current.Items, target.Items); err != nil {
+		return err
+	}
+	if err := s.restoreWeapons(characterId, current.Weapons, target.Weapons); err != nil {
+		return err
+	}
+	if err := s.restoreArmor(characterId, current.Armor, target.Armor); err != nil {
+		return err
+	}
+	if err := s.restoreSpells(characterId, current.Spells, target.Spells); err != nil {
+		return err
+	}
+	if err := s.restoreFeatures(characterId, current.Features, target.Features); err != nil {
+		return err
+	}
+	if err := s.restoreProficiencies(characterId, current.Proficiencies, target.Proficiencies); err != nil {
+		return err
+	}
+	if err := s.restoreSkills(characterId, current.Skills, target.Skills); err != nil {
+		return err
+	}
+	// Hit dice and resource maximums follow the classes and features, so the
+	// play state goes back last.
+	if target.Status != nil {
+		if _, err := s.characterStatusService.SetStatus(characterId, *target.Status); err != nil {
+			return fmt.Errorf("status: %w", err)
+		}
+	}
+	if target.Resources != nil {
+		return s.restoreResources(characterId, target.Resources)
+	}
+	return nil
+}
+
+// restoreClasses brings the class entries back to the target ones within the
+// multiclass rules: levels go down and classes go away before new classes
+// come in at level 1, and only then are levels raised and subclasses chosen,
+// so the level cap and the one class minimum hold at every step.
+func (s *service) restoreClasses(characterId int, target []domain.CharacterClass) error {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return err
+	}
+	wanted := map[int]domain.CharacterClass{}
+	for _, characterClass := range target {
+		wanted[characterClass.Class.ClassId] = characterClass
+	}
+	current := map[int]bool{}
+	shared := false
+	for _, characterClass := range character.Classes {
+		current[characterClass.Class.ClassId] = true
+		if _, ok := wanted[characterClass.Class.ClassId]; ok {
+			shared = true
+		}
+	}
+
+	// Without a shared class, one of the old classes is kept at level 1 until
+	// the new ones are in.
+	kept := 0
+	for _, characterClass := range character.Classes {
+		classId := characterClass.Class.ClassId
+		wantedClass, ok := wanted[classId]
+		switch {
+		case ok && wantedClass.Level < characterClass.Level:
+			_, err = s.characterDataService.SetClassLevel(characterId, classId, wantedClass.Level)
+		case !ok && !shared && kept == 0:
+			kept = classId
+			if characterClass.Level > 1 {
+				_, err = s.characterDataService.SetClassLevel(characterId, classId, 1)
+			}
+		case !ok:
+			_, err = s.characterDataService.RemoveClass(characterId, classId)
+		}
+		if err != nil {
+			return fmt.Errorf("class %s: %w", characterClass.Class.Name, err)
+		}
+	}
+	for _, characterClass := range target {
+		if current[characterClass.Class.ClassId] {
+			continue
+		}
+		if _, err := s.characterDataService.AddClass(characterId, characterClass.Class.ClassId, 1); err != nil {
+			return fmt.Errorf("class %s: %w", characterClass.Class.Name, err)
+		}
+	}
+	if kept != 0 {
+		if _, err := s.characterDataService.RemoveClass(characterId, kept); err != nil {
+			return fmt.Errorf("class %d: %w", kept, err)
+		}
+	}
+
+	character, err = s.characterDataService.GetById(characterId)
+	if err != nil {
+		return err
+	}
+	for _, characterClass := range character.Classes {
+		wantedClass, ok := wanted[characterClass.Class.ClassId]
+		if !ok {
+			continue
+		}
+		if characterClass.Level != wantedClass.Level {
+			if _, err := s.characterDataService.SetClassLevel(characterId, wantedClass.Class.ClassId, wantedClass.Level); err != nil {
+				return fmt.Errorf("class %s: %w", wantedClass.Class.Name, err)
+			}
+		}
+		if subclassId(characterClass.Subclass) != subclassId(wantedClass.Subclass) {
+			var chosen *int
+			if wantedClass.Subclass != nil {
+				chosen = &wantedClass.Subclass.SubclassId
+			}
+			if _, err := s.characterDataService.SetSubclass(characterId, wantedClass.Class.ClassId, chosen); err != nil {
+				return fmt.Errorf("subclass of %s: %w", wantedClass.Class.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// restoreResources matches resources by feature and name, recreates custom
+// counters that were deleted since and puts back how many uses are left.
+func (s *service) restoreResources(characterId int, target []dto.CharacterResourceDto) error {
+	current, err := s.characterResourceService.GetByCharacterId(characterId)
+	if err != nil {
+		return err
+	}
+	key := func(resource dto.CharacterResourceDto) string {
+		if resource.FeatureId == nil {
+			return resource.Name
+		}
+		return fmt.Sprintf("%d:%s", *resource.FeatureId, resource.Name)
+	}
+	byKey := map[string]dto.CharacterResourceDto{}
+	for _, resource := range current {
+		byKey[key(resource)] = resource
+	}
+	for _, wanted := range target {
+		resource, ok := byKey[key(wanted)]
+		delete(byKey, key(wanted))
+		if !ok {
+			// Feature resources come back with their feature.
+			if wanted.FeatureId != nil {
+				continue
+			}
+			resource, err = s.characterResourceService.Create(characterId, dto.CreateCharacterResourceDto{Name: wanted.Name, Maximum: wanted.Formula, Recharge: wanted.Recharge})
+			if err != nil {
+				return fmt.Errorf("resource %s: %w", wanted.Name, err)
+			}
+		} else if resource.FeatureId == nil && (resource.Formula != wanted.Formula || resource.Recharge != wanted.Recharge) {
+			resource, err = s.characterResourceService.Update(characterId, resource.CharacterResourceId, dto.CreateCharacterResourceDto{Name: wanted.Name, Maximum: wanted.Formula, Recharge: wanted.Recharge})
+			if err != nil {
+				return fmt.Errorf("resource %s: %w", wanted.Name, err)
+			}
+		}
+		if resource.Current == wanted.Current {
+			continue
+		}
+		resource, err = s.characterResourceService.Restore(characterId, resource.CharacterResourceId, -1)
+		if err == nil && resource.Current > wanted.Current {
+			_, err = s.characterResourceService.Spend(characterId, resource.CharacterResourceId, resource.Current-max(wanted.Current, 0))
+		}
+		if err != nil {
+			return fmt.Errorf("resource %s: %w", wanted.Name, err)
+		}
+	}
+	for _, leftover := range byKey {
+		if leftover.FeatureId != nil {
+			continue
+		}
+		if err := s.characterResourceService.Delete(characterId, leftover.CharacterResourceId); err != nil {
+			return fmt.Errorf("resource %s: %w", leftover.Name, err)
+		}
+	}
+	return nil
+}
+
+func subclassId(chosen *domain.Subclass) int {
+	if chosen == nil {
+		return 0
+	}
+	return chosen.SubclassId
+}
+
+func (s *service) restoreItems(characterId int, current []domain.ItemXCharacterData, target []domain.ItemXCharacterData) error {
+	byItem := map[int]domain.ItemXCharacterData{}
+	for _, link := range current {
+		byItem[link.Item.Item_Id] = link
+	}
+	for _, wanted := range target {
+		link, ok := byItem[wanted.Item.Item_Id]
+		if !ok {
+			if _, err := s.itemXCharacterService.Create(domain.ItemXCharacterData{CharacterData_Id: characterId, Item: wanted.Item, Quantity: wanted.Quantity}); err != nil {
+				return fmt.Errorf("item %s: %w", wanted.Item.Name, err)
+			}
+			continue
+		}
+		delete(byItem, wanted.Item.Item_Id)
+		if link.Quantity != wanted.Quantity {
+			link.Quantity = wanted.Quantity
+			if _, err := s.itemXCharacterService.Update(link); err != nil {
+				return fmt.Errorf("item %s: %w", wanted.Item.Name, err)
+			}
+		}
+	}
+	for _, leftover := range byItem {
+		if err := s.itemXCharacterService.Delete(leftover.Character_Item_Id); err != nil {
+			return fmt.Errorf("item %s: %w", leftover.Item.Name, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) restoreWeapons(characterId int, current []domain.WeaponXCharacterData, target []domain.WeaponXCharacterData) error {
+	byWeapon := map[int][]domain.WeaponXCharacterData{}
+	for _, link := range current {
+		byWeapon[link.Weapon.Weapon_Id] = append(byWeapon[link.Weapon.Weapon_Id], link)
+	}
+	for _, wanted := range target {
+		links := byWeapon[wanted.Weapon.Weapon_Id]
+		if len(links) == 0 {
+			if _, err := s.weaponXCharacterService.Create(domain.WeaponXCharacterData{CharacterData_Id: characterId, Weapon: wanted.Weapon, Equipped: wanted.Equipped, Slot: wanted.Slot, Attuned: wanted.Attuned}); err != nil {
+				return fmt.Errorf("weapon %s: %w", wanted.Weapon.Name, err)
+			}
+			continue
+		}
+		link := links[0]
+		byWeapon[wanted.Weapon.Weapon_Id] = links[1:]
+		if link.Equipped != wanted.Equipped || link.Slot != wanted.Slot || link.Attuned != wanted.Attuned {
+			link.Equipped, link.Slot, link.Attuned = wanted.Equipped, wanted.Slot, wanted.Attuned
+			if _, err := s.weaponXCharacterService.Update(link); err != nil {
+				return fmt.Errorf("weapon %s: %w", wanted.Weapon.Name, err)
+			}
+		}
+	}
+	for _, links := range byWeapon {
+		for _, leftover := range links {
+			if err := s.weaponXCharacterService.Delete(leftover.Character_Weapon_Id); err != nil {
+				return fmt.Errorf("weapon %s: %w", leftover.Weapon.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *service) restoreArmor(characterId int, current []domain.ArmorXCharacterData, target []domain.ArmorXCharacterData) error {
+	byArmor := map[int][]domain.ArmorXCharacterData{}
+	for _, link := range current {
+		byArmor[link.Armor.ArmorId] = append(byArmor[link.Armor.ArmorId], link)
+	}
+	for _, wanted := range target {
+		links := byArmor[wanted.Armor.ArmorId]
+		if len(links) == 0 {
+			if _, err := s.armorXCharacterService.CreateArmorXCharacterData(domain.ArmorXCharacterData{CharacterData_Id: characterId, Armor: wanted.Armor, Equipped: wanted.Equipped, Slot: wanted.Slot, Attuned: wanted.Attuned}); err != nil {
+				return fmt.Errorf("armor %s: %w", wanted.Armor.Name, err)
+			}
+			continue
+		}
+		link := links[0]
+		byArmor[wanted.Armor.ArmorId] = links[1:]
+		if link.Equipped != wanted.Equipped || link.Slot != wanted.Slot || link.Attuned != wanted.Attuned {
+			link.Equipped, link.Slot, link.Attuned = wanted.Equipped, wanted.Slot, wanted.Attuned
+			if _, err := s.armorXCharacterService.UpdateArmorXCharacterData(link); err != nil {
+				return fmt.Errorf("armor %s: %w", wanted.Armor.Name, err)
+			}
+		}
+	}
+	for _, links := range byArmor {
+		for _, leftover := range links {
+			if err := s.armorXCharacterService.DeleteArmorXCharacterData(leftover.ArmorXCharacterData_Id); err != nil {
+				return fmt.Errorf("armor %s: %w", leftover.Armor.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *service) restoreSpells(characterId int, current []domain.Spell, target []domain.Spell) error {
+	added, removed := diffIds(current, target, func(spell domain.Spell) int { return spell.SpellId })
+	for _, spellId := range added {
+		if _, err := s.spellXCharacterService.Create(domain.CharacterXSpell{CharacterId: characterId, SpellId: spellId}); err != nil {
+			return fmt.Errorf("spell %d: %w", spellId, err)
+		}
+	}
+	for _, spellId := range removed {
+		if err := s.spellXCharacterService.DeleteParams(characterId, spellId); err != nil {
+			return fmt.Errorf("spell %d: %w", spellId, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) restoreFeatures(characterId int, current []domain.Feature, target []domain.Feature) error {
+	added, removed := diffIds(current, target, func(feature domain.Feature) int { return feature.FeatureId })
+	for _, featureId := range added {
+		if _, err := s.featureXCharacterService.CreateCharacterFeature(dto.CreateCharacterFeatureDto{FeatureId: featureId, CharacterId: characterId}); err != nil {
+			return fmt.Errorf("feature %d: %w", featureId, err)
+		}
+	}
+	for _, featureId := range removed {
+		if err := s.featureXCharacterService.DeleteCharacterFeature(featureId, characterId); err != nil {
+			return fmt.Errorf("feature %d: %w", featureId, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) restoreProficiencies(characterId int, current []domain.Proficiency, target []domain.Proficiency) error {
+	added, removed := diffIds(current, target, func(proficiency domain.Proficiency) int { return proficiency.ProficiencyId })
+	for _, proficiencyId := range added {
+		if _, err := s.proficiencyXCharacterService.Create(domain.CharacterXProficiency{CharacterId: characterId, ProficiencyId: proficiencyId}); err != nil {
+			return fmt.Errorf("proficiency %d: %w", proficiencyId, err)
+		}
+	}
+	for _, proficiencyId := range removed {
+		if err := s.proficiencyXCharacterService.DeleteParams(characterId, proficiencyId); err != nil {
+			return fmt.Errorf("proficiency %d: %w", proficiencyId, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) restoreSkills(characterId int, current []domain.Skill, target []domain.Skill) error {
+	added, removed := diffIds(current, target, func(skill domain.Skill) int { return skill.SkillId })
+	for _, skillId := range added {
+		if _, err := s.skillXCharacterService.Create(domain.SkillXCharacterData{SkillID: int64(skillId), CharacterID: int64(characterId)}); err != nil {
+			return fmt.Errorf("skill %d: %w", skillId, err)
+		}
+	}
+	for _, skillId := range removed {
+		if err := s.skillXCharacterService.Delete(domain.SkillXCharacterData{SkillID: int64(skillId), CharacterID: int64(characterId)}); err != nil {
+			return fmt.Errorf("skill %d: %w", skillId, err)
+		}
+	}
+	return nil
+}
+
+func diffIds[T any](current []T, target []T, id func(T) int) (added []int, removed []int) {
+	currentIds := map[int]bool{}
+	for _, entry := range current {
+		currentIds[id(entry)] = true
+	}
+	targetIds := map[int]bool{}
+	for _, entry := range target {
+		targetIds[id(entry)] = true
+		if !currentIds[id(entry)] {
+			added = append(added, id(entry))
+		}
+	}
+	for currentId := range currentIds {
+		if !targetIds[currentId] {
+			removed = append(removed, currentId)
+		}
+	}
+	return added, removed
+}
+
+func historyToVersionDto(history domain.CharacterHistory) (dto.CharacterVersionDto, error) {
+	var version snapshot
+	if err := json.Unmarshal(history.Snapshot, &version); err != nil {
+		return dto.CharacterVersionDto{}, err
+	}
+	return dto.CharacterVersionDto{
+		Version:   history.Version,
+		AuthorId:  history.AuthorId,
+		Change:    history.Change,
+		CreatedAt: history.CreatedAt,
+		Character: version.FullCharacterData,
+		Status:    version.Status,
+		Resources: version.Resources,
+	}, nil
+}
+
+func fullCharacterToCharacterData(character dto.FullCharacterData, characterId int) domain.CharacterData {
+	return domain.CharacterData{
+		Character_Id: characterId,
+		User_Id:      character.User_Id,
+		Campaign_Id:  character.Campaign_Id,
+		Race:         character.Race,
+		Subrace:      character.Subrace,
+		Class:        character.Class,
+		Background:   character.Background,
+		Name:         character.Name,
+		Story:        character.Story,
+		Alignment:    character.Alignment,
+		Age:          character.Age,
+		Hair:         character.Hair,
+		Eyes:         character.Eyes,
+		Skin:         character.Skin,
+		Height:       character.Height,
+		Weight:       character.Weight,
+		ImgUrl:       character.ImgUrl,
+		Str:          character.Str,
+		Dex:          character.Dex,
+		Int:          character.Int,
+		Con:          character.Con,
+		Wiz:          character.Wiz,
+		Cha:          character.Cha,
+		Hitpoints:    character.Hitpoints,
+		HitDice:      character.HitDice,
+		Speed:        character.Speed,
+		Armor_Class:  character.Armor_Class,
+		Level:        character.Level,
+		Exp:          character.Exp,
+	}
+}
+
+// snapshotFields lists the json keys of FullCharacterData in declaration
+// order so diffs come out in the same order as the character sheet, followed
+// by the play state kept alongside it.
+var snapshotFields = func() []string {
+	fields := []string{}
+	characterType := reflect.TypeOf(dto.FullCharacterData{})
+	for i := 0; i < characterType.NumField(); i++ {
+		name := strings.Split(characterType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" && name != "characterid" {
+			fields = append(fields, name)
+		}
+	}
+	return append(fields, "status", "resources")
+}()
+
+func diffSnapshots(previous map[string]interface{}, current map[string]interface{}) []dto.CharacterFieldDiffDto {
+	diffs := []dto.CharacterFieldDiffDto{}
+	for _, field := range snapshotFields {
+		before, after := previous[field], current[field]
+		switch afterFields := after.(type) {
+		case map[string]interface{}:
+			if _, named := afterFields["name"]; !named {
+				// Objects that are not catalog rows, like the wallet or the
+				// status, are compared key by key.
+				beforeFields, _ := before.(map[string]interface{})
+				for _, key := range sortedKeys(afterFields) {
+					if !reflect.DeepEqual(beforeFields[key], afterFields[key]) {
+						diffs = append(diffs, dto.CharacterFieldDiffDto{Field: field + "." + key, Before: beforeFields[key], After: afterFields[key]})
+					}
+				}
+				continue
+			}
+			// Race, class and background are catalog rows, only a swap matters.
+			beforeName, afterName := entryLabel(before), entryLabel(after)
+			if beforeName != afterName {
+				diffs = append(diffs, dto.CharacterFieldDiffDto{Field: field, Before: beforeName, After: afterName})
+			}
+		case []interface{}, nil:
+			if _, isList := before.([]interface{}); !isList {
+				if _, isList := after.([]interface{}); !isList {
+					if !reflect.DeepEqual(before, after) {
+						diffs = append(diffs, dto.CharacterFieldDiffDto{Field: field, Before: before, After: after})
+					}
+					continue
+				}
+			}
+			added, removed := diffLabels(before, after)
+			if len(added) > 0 || len(removed) > 0 {
+				diffs = append(diffs, dto.CharacterFieldDiffDto{Field: field, Added: added, Removed: removed})
+			}
+		default:
+			if !reflect.DeepEqual(before, after) {
+				diffs = append(diffs, dto.CharacterFieldDiffDto{Field: field, Before: before, After: after})
+			}
+		}
+	}
+	return diffs
+}
+
+func diffLabels(before interface{}, after interface{}) (added []string, removed []string) {
+	counts := map[string]int{}
+	beforeList, _ := before.([]interface{})
+	afterList, _ := after.([]interface{})
+	for _, entry := range beforeList {
+		counts[entryLabel(entry)]--
+	}
+	for _, entry := range afterList {
+		counts[entryLabel(entry)]++
+	}
+	for _, entry := range afterList {
+		label := entryLabel(entry)
+		if counts[label] > 0 {
+			added = append(added, label)
+			counts[label]--
+		}
+	}
+	for _, entry := range beforeList {
+		label := entryLabel(entry)
+		if counts[label] < 0 {
+			removed = append(removed, label)
+			counts[label]++
+		}
+	}
+	return added, removed
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// entryLabel builds a readable label for a snapshot entry. Inventory links
+// nest the catalog row under "item", "weapon" or "armor".
+func entryLabel(entry interface{}) string {
+	fields, ok := entry.(map[string]interface{})
+	if !ok {
+		return fmt.Sprint(entry)
+	}
+	label := fmt.Sprint(fields["name"])
+	for _, key := range []string{"item", "weapon", "armor"} {
+		if nested, ok := fields[key].(map[string]interface{}); ok {
+			label = fmt.Sprint(nested["name"])
+		}
+	}
+	if current, ok := fields["current"]; ok {
+		label = fmt.Sprintf("%s %v/%v", label, current, fields["maximum"])
+	}
+	if quantity, ok := fields["quantity"]; ok {
+		label = fmt.Sprintf("%s x%v", label, quantity)
+	}
+	if equipped, ok := fields["equipped"].(bool); ok && equipped {
+		label += " (equipped)"
+	}
+	return label
+}
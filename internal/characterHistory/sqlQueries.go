@@ -0,0 +1,14 @@
+package characterhistory
+
+var (
+	QueryGetNextVersion   = `SELECT COALESCE(MAX(version), 0) + 1 FROM character_history WHERE character_id = ? FOR UPDATE;`
+	QueryInsert           = `INSERT INTO character_history (character_id, version, author_id, change_description, snapshot, created_at) VALUES (?, ?, ?, ?, ?, ?);`
+	QueryGetByCharacterId = `SELECT character_history_id, character_id, version, author_id, change_description, snapshot, created_at
+	FROM character_history WHERE character_id = ? ORDER BY version ASC;`
+	QueryGetByVersion = `SELECT character_history_id, character_id, version, author_id, change_description, snapshot, created_at
+	FROM character_history WHERE character_id = ? AND version = ?;`
+	QueryGetLatest = `SELECT character_history_id, character_id, version, author_id, change_description, snapshot, created_at
+	FROM character_history WHERE character_id = ? ORDER BY version DESC LIMIT 1;`
+	QueryGetLatestBefore = `SELECT character_history_id, character_id, version, author_id, change_description, snapshot, created_at
+	FROM character_history WHERE character_id = ? AND created_at <= ? ORDER BY version DESC LIMIT 1;`
+)
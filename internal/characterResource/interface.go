@@ -0,0 +1,30 @@
+package characterresource
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryCharacterResource interface {
+	GetByCharacterId(characterId int) ([]domain.CharacterResource, error)
+	GetById(id int) (domain.CharacterResource, error)
+	Create(resource domain.CharacterResource) (domain.CharacterResource, error)
+	Update(resource domain.CharacterResource) error
+	Delete(id int) error
+	DeleteByCharacterId(characterId int) error
+	Spend(id int, amount int, maximum int) (bool, error)
+	Restore(id int, amount int) error
+	Recharge(characterId int, recharge string) (int, error)
+}
+
+type ServiceCharacterResource interface {
+	GetByCharacterId(characterId int) ([]dto.CharacterResourceDto, error)
+	Create(characterId int, resource dto.CreateCharacterResourceDto) (dto.CharacterResourceDto, error)
+	Update(characterId int, resourceId int, resource dto.CreateCharacterResourceDto) (dto.CharacterResourceDto, error)
+	Delete(characterId int, resourceId int) error
+	Spend(characterId int, resourceId int, amount int) (dto.CharacterResourceDto, error)
+	Restore(characterId int, resourceId int, amount int) (dto.CharacterResourceDto, error)
+	Recharge(characterId int, recharges []string) (int, error)
+	Sync(characterId int) error
+	DeleteByCharacterId(characterId int) error
+}
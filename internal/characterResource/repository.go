@@ -0,0 +1,134 @@
+package characterresource
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var ErrNotFound = errors.New("character resource not found")
+
+type characterResourceMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewCharacterResourceRepository(db *sql.DB) RepositoryCharacterResource {
+	return &characterResourceMySqlRepository{db: db}
+}
+
+// GetByCharacterId implements RepositoryCharacterResource.
+func (r *characterResourceMySqlRepository) GetByCharacterId(characterId int) ([]domain.CharacterResource, error) {
+	rows, err := r.db.Query(QueryGetByCharacterId, characterId)
+	if err != nil {
+		return []domain.CharacterResource{}, err
+	}
+	defer rows.Close()
+
+	resources := []domain.CharacterResource{}
+	for rows.Next() {
+		resource, err := scanResource(rows)
+		if err != nil {
+			return []domain.CharacterResource{}, err
+		}
+		resources = append(resources, resource)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.CharacterResource{}, err
+	}
+	return resources, nil
+}
+
+// GetById implements RepositoryCharacterResource.
+func (r *characterResourceMySqlRepository) GetById(id int) (domain.CharacterResource, error) {
+	resource, err := scanResource(r.db.QueryRow(QueryGetById, id))
+	if err == sql.ErrNoRows {
+		return domain.CharacterResource{}, ErrNotFound
+	}
+	return resource, err
+}
+
+// Create implements RepositoryCharacterResource.
+func (r *characterResourceMySqlRepository) Create(resource domain.CharacterResource) (domain.CharacterResource, error) {
+	result, err := r.db.Exec(QueryCreate, resource.CharacterId, resource.FeatureId, resource.Name, resource.Maximum, resource.Recharge)
+	if err != nil {
+		return domain.CharacterResource{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return domain.CharacterResource{}, err
+	}
+	resource.CharacterResourceId = int(id)
+	resource.Used = 0
+	return resource, nil
+}
+
+// Update implements RepositoryCharacterResource. Spent uses are kept.
+func (r *characterResourceMySqlRepository) Update(resource domain.CharacterResource) error {
+	_, err := r.db.Exec(QueryUpdate, resource.Name, resource.Maximum, resource.Recharge, resource.CharacterResourceId)
+	return err
+}
+
+// Delete implements RepositoryCharacterResource.
+func (r *characterResourceMySqlRepository) Delete(id int) error {
+	_, err := r.db.Exec(QueryDelete, id)
+	return err
+}
+
+// DeleteByCharacterId implements RepositoryCharacterResource.
+func (r *characterResourceMySqlRepository) DeleteByCharacterId(characterId int) error {
+	_, err := r.db.Exec(QueryDeleteByCharacterId, characterId)
+	return err
+}
+
+// Spend implements RepositoryCharacterResource. The uses are only taken while
+// they fit under maximum, so concurrent requests cannot overspend.
+func (r *characterResourceMySqlRepository) Spend(id int, amount int, maximum int) (bool, error) {
+	result, err := r.db.Exec(QuerySpend, amount, id, amount, maximum)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// Restore implements RepositoryCharacterResource.
+func (r *characterResourceMySqlRepository) Restore(id int, amount int) error {
+	_, err := r.db.Exec(QueryRestore, amount, id)
+	return err
+}
+
+// Recharge implements RepositoryCharacterResource. It returns how many
+// resources were refilled.
+func (r *characterResourceMySqlRepository) Recharge(characterId int, recharge string) (int, error) {
+	result, err := r.db.Exec(QueryRecharge, characterId, recharge)
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rowsAffected), nil
+}
+
+type scannable interface {
+	Scan(dest ...any) error
+}
+
+func scanResource(row scannable) (domain.CharacterResource, error) {
+	var resource domain.CharacterResource
+	var featureId sql.NullInt64
+	err := row.Scan(&resource.CharacterResourceId, &resource.CharacterId, &featureId, &resource.Name, &resource.Maximum, &resource.Recharge, &resource.Used)
+	if err != nil {
+		return domain.CharacterResource{}, err
+	}
+	if featureId.Valid {
+		id := int(featureId.Int64)
+		resource.FeatureId = &id
+	}
+	return resource, nil
+}
@@ -0,0 +1,302 @@
+package characterresource
+
+import (
+	"errors"
+	"strings"
+
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/resource"
+)
+
+var (
+	ErrNotOwned        = errors.New("the resource does not belong to the character")
+	ErrFeatureResource = errors.New("resources granted by a feature change with the feature")
+	ErrNotEnoughUses   = errors.New("the resource does not have enough uses left")
+	ErrInvalidAmount   = errors.New("amount must be greater than 0")
+)
+
+type service struct {
+	repository           RepositoryCharacterResource
+	characterDataService characterdata.ServiceCharacterData
+}
+
+func NewCharacterResourceService(repository RepositoryCharacterResource, characterDataService characterdata.ServiceCharacterData) ServiceCharacterResource {
+	return &service{repository: repository, characterDataService: characterDataService}
+}
+
+// GetByCharacterId implements ServiceCharacterResource. It only reads: the
+// stored resources follow the features through Sync, run on every write.
+func (s *service) GetByCharacterId(characterId int) ([]dto.CharacterResourceDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := s.repository.GetByCharacterId(characterId)
+	if err != nil {
+		return nil, err
+	}
+	result := []dto.CharacterResourceDto{}
+	for _, stored := range resources {
+		resourceDto, err := toDto(stored, character)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resourceDto)
+	}
+	return result, nil
+}
+
+// Sync implements ServiceCharacterResource. It brings the stored resources in
+// line with the ones the character's features declare: new declarations are
+// added, edited ones updated and those of features the character lost are
+// removed.
+func (s *service) Sync(characterId int) error {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return err
+	}
+	_, err = s.sync(character)
+	return err
+}
+
+// DeleteByCharacterId implements ServiceCharacterResource.
+func (s *service) DeleteByCharacterId(characterId int) error {
+	return s.repository.DeleteByCharacterId(characterId)
+}
+
+// Create implements ServiceCharacterResource. It adds a custom counter.
+func (s *service) Create(characterId int, request dto.CreateCharacterResourceDto) (dto.CharacterResourceDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	definition := domain.FeatureResource{Name: request.Name, Maximum: request.Maximum, Recharge: request.Recharge}
+	if err := resource.Validate(definition); err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	created, err := s.repository.Create(domain.CharacterResource{
+		CharacterId: characterId,
+		Name:        request.Name,
+		Maximum:     request.Maximum,
+		Recharge:    request.Recharge,
+	})
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	return toDto(created, character)
+}
+
+// Update implements ServiceCharacterResource.
+func (s *service) Update(characterId int, resourceId int, request dto.CreateCharacterResourceDto) (dto.CharacterResourceDto, error) {
+	character, stored, err := s.owned(characterId, resourceId)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	if stored.FeatureId != nil {
+		return dto.CharacterResourceDto{}, ErrFeatureResource
+	}
+	definition := domain.FeatureResource{Name: request.Name, Maximum: request.Maximum, Recharge: request.Recharge}
+	if err := resource.Validate(definition); err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	stored.Name, stored.Maximum, stored.Recharge = request.Name, request.Maximum, request.Recharge
+	if err := s.repository.Update(stored); err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	return toDto(stored, character)
+}
+
+// Delete implements ServiceCharacterResource.
+func (s *service) Delete(characterId int, resourceId int) error {
+	_, stored, err := s.owned(characterId, resourceId)
+	if err != nil {
+		return err
+	}
+	if stored.FeatureId != nil {
+		return ErrFeatureResource
+	}
+	return s.repository.Delete(resourceId)
+}
+
+// Spend implements ServiceCharacterResource. Amount defaults to one use.
+func (s *service) Spend(characterId int, resourceId int, amount int) (dto.CharacterResourceDto, error) {
+	if amount == 0 {
+		amount = 1
+	}
+	if amount < 0 {
+		return dto.CharacterResourceDto{}, ErrInvalidAmount
+	}
+	character, stored, err := s.owned(characterId, resourceId)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	maximum, err := resource.Maximum(stored.Maximum, character)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	spent, err := s.repository.Spend(resourceId, amount, maximum)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	if !spent {
+		return dto.CharacterResourceDto{}, ErrNotEnoughUses
+	}
+	stored.Used += amount
+	return toDto(stored, character)
+}
+
+// Restore implements ServiceCharacterResource. Amount defaults to one use and
+// a negative amount refills the resource.
+func (s *service) Restore(characterId int, resourceId int, amount int) (dto.CharacterResourceDto, error) {
+	character, stored, err := s.owned(characterId, resourceId)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	if amount == 0 {
+		amount = 1
+	}
+	if amount < 0 {
+		amount = stored.Used
+	}
+	if err := s.repository.Restore(resourceId, amount); err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	stored.Used = max(stored.Used-amount, 0)
+	return toDto(stored, character)
+}
+
+// Recharge implements ServiceCharacterResource. It refills every resource of
+// the character that recharges by one of the given rules, and returns how
+// many were refilled.
+func (s *service) Recharge(characterId int, recharges []string) (int, error) {
+	if err := s.Sync(characterId); err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, recharge := range recharges {
+		if !resource.ValidRecharge(recharge) {
+			return total, resource.ErrInvalidRecharge
+		}
+		count, err := s.repository.Recharge(characterId, recharge)
+		if err != nil {
+			return total, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// owned syncs the resources of the character before returning the one
+// requested, so spending and restoring act on the current maximums.
+func (s *service) owned(characterId int, resourceId int) (dto.FullCharacterData, domain.CharacterResource, error) {
+	stored, err := s.repository.GetById(resourceId)
+	if err != nil {
+		return dto.FullCharacterData{}, domain.CharacterResource{}, err
+	}
+	if stored.CharacterId != characterId {
+		return dto.FullCharacterData{}, domain.CharacterResource{}, ErrNotOwned
+	}
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.FullCharacterData{}, domain.CharacterResource{}, err
+	}
+	resources, err := s.sync(character)
+	if err != nil {
+		return dto.FullCharacterData{}, domain.CharacterResource{}, err
+	}
+	for _, current := range resources {
+		if current.CharacterResourceId == resourceId {
+			return character, current, nil
+		}
+	}
+	// The feature granting it is gone and the resource went with it.
+	return dto.FullCharacterData{}, domain.CharacterResource{}, ErrNotFound
+}
+
+func (s *service) sync(character dto.FullCharacterData) ([]domain.CharacterResource, error) {
+	stored, err := s.repository.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return nil, err
+	}
+	type key struct {
+		featureId int
+		name      string
+	}
+	declared := map[key]domain.FeatureResource{}
+	for _, feature := range character.Features {
+		for _, definition := range feature.Resources {
+			// The class progression sets the maximum of resources by name as levels go up.
+			if maximum, ok := character.ResourceMaxima[strings.ToLower(strings.TrimSpace(definition.Name))]; ok {
+				definition.Maximum = maximum
+			}
+			declared[key{feature.FeatureId, definition.Name}] = definition
+		}
+	}
+
+	resources := []domain.CharacterResource{}
+	found := map[key]bool{}
+	for _, current := range stored {
+		if current.FeatureId == nil {
+			resources = append(resources, current)
+			continue
+		}
+		k := key{*current.FeatureId, current.Name}
+		definition, ok := declared[k]
+		if !ok || found[k] {
+			if err := s.repository.Delete(current.CharacterResourceId); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		found[k] = true
+		if current.Maximum != definition.Maximum || current.Recharge != definition.Recharge {
+			current.Maximum, current.Recharge = definition.Maximum, definition.Recharge
+			if err := s.repository.Update(current); err != nil {
+				return nil, err
+			}
+		}
+		resources = append(resources, current)
+	}
+	for _, feature := range character.Features {
+		for _, definition := range feature.Resources {
+			k := key{feature.FeatureId, definition.Name}
+			if found[k] {
+				continue
+			}
+			found[k] = true
+			definition = declared[k]
+			featureId := feature.FeatureId
+			created, err := s.repository.Create(domain.CharacterResource{
+				CharacterId: character.Character_Id,
+				FeatureId:   &featureId,
+				Name:        definition.Name,
+				Maximum:     definition.Maximum,
+				Recharge:    definition.Recharge,
+			})
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, created)
+		}
+	}
+	return resources, nil
+}
+
+func toDto(stored domain.CharacterResource, character dto.FullCharacterData) (dto.CharacterResourceDto, error) {
+	maximum, err := resource.Maximum(stored.Maximum, character)
+	if err != nil {
+		return dto.CharacterResourceDto{}, err
+	}
+	return dto.CharacterResourceDto{
+		CharacterResourceId: stored.CharacterResourceId,
+		CharacterId:         stored.CharacterId,
+		FeatureId:           stored.FeatureId,
+		Name:                stored.Name,
+		Formula:             stored.Maximum,
+		Recharge:            stored.Recharge,
+		Maximum:             maximum,
+		Current:             max(maximum-stored.Used, 0),
+	}, nil
+}
@@ -0,0 +1,13 @@
+package characterresource
+
+var (
+	QueryGetByCharacterId    = `SELECT character_resource_id, character_id, feature_id, name, maximum, recharge, used FROM character_resource WHERE character_id = ? ORDER BY character_resource_id;`
+	QueryGetById             = `SELECT character_resource_id, character_id, feature_id, name, maximum, recharge, used FROM character_resource WHERE character_resource_id = ?;`
+	QueryCreate              = `INSERT INTO character_resource (character_id, feature_id, name, maximum, recharge, used) VALUES (?, ?, ?, ?, ?, 0);`
+	QueryUpdate              = `UPDATE character_resource SET name = ?, maximum = ?, recharge = ? WHERE character_resource_id = ?;`
+	QueryDelete              = `DELETE FROM character_resource WHERE character_resource_id = ?;`
+	QueryDeleteByCharacterId = `DELETE FROM character_resource WHERE character_id = ?;`
+	QuerySpend               = `UPDATE character_resource SET used = used + ? WHERE character_resource_id = ? AND used + ? <= ?;`
+	QueryRestore             = `UPDATE character_resource SET used = GREATEST(used - ?, 0) WHERE character_resource_id = ?;`
+	QueryRecharge            = `UPDATE character_resource SET used = 0 WHERE character_id = ? AND recharge = ? AND used > 0;`
+)
@@ -0,0 +1,23 @@
+package characterstatus
+
+import characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+
+type cleanedService struct {
+	characterdata.ServiceCharacterData
+	status ServiceCharacterStatus
+}
+
+// WithStateCleanup removes the play state of the characters deleted through
+// service. The character service cannot do it itself, as the status and
+// resource services are built on top of it.
+func WithStateCleanup(service characterdata.ServiceCharacterData, status ServiceCharacterStatus) characterdata.ServiceCharacterData {
+	return &cleanedService{ServiceCharacterData: service, status: status}
+}
+
+// Delete implements characterdata.ServiceCharacterData.
+func (s *cleanedService) Delete(id int) error {
+	if err := s.status.DeleteByCharacterId(id); err != nil {
+		return err
+	}
+	return s.ServiceCharacterData.Delete(id)
+}
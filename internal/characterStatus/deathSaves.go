@@ -0,0 +1,224 @@
+package characterstatus
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/pkg/dice"
+)
+
+const (
+	StateConscious = "conscious"
+	StateDying     = "dying"
+	StateStable    = "stable"
+	StateDead      = "dead"
+
+	OutcomeSuccess         = "success"
+	OutcomeFailure         = "failure"
+	OutcomeCriticalFailure = "critical_failure"
+	OutcomeRevived         = "revived"
+
+	// DeathStateEvent is the socket event type carrying a dto.DeathStateDto.
+	DeathStateEvent = "death_state"
+
+	deathSaveDC    = 10
+	deathSaveLimit = 3
+)
+
+// TakeDamage implements ServiceCharacterStatus. Damage that drops a character
+// to 0 hitpoints leaves it dying, or kills it outright when the damage left
+// over reaches its hitpoint maximum. Damage taken at 0 hitpoints counts as a
+// failed death save.
+func (s *service) TakeDamage(characterId int, damage int, sessionId *int) (dto.CharacterStatusDto, error) {
+	if damage <= 0 {
+		return dto.CharacterStatusDto{}, ErrInvalidDamage
+	}
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	if deathSaves.State == StateDead {
+		return dto.CharacterStatusDto{}, ErrCharacterDead
+	}
+	current, err := s.currentHitpoints(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+
+	if current > 0 {
+		overflow := damage - current
+		current = max(current-damage, 0)
+		if err := s.repository.SetHitpoints(characterId, current); err != nil {
+			return dto.CharacterStatusDto{}, err
+		}
+		if current > 0 {
+			return s.status(character)
+		}
+		deathSaves = domain.CharacterDeathSaves{CharacterId: characterId, State: StateDying}
+		if overflow >= character.Hitpoints {
+			deathSaves.State = StateDead
+		}
+	} else {
+		deathSaves.State = StateDying
+		deathSaves.Failures++
+		if damage >= character.Hitpoints || deathSaves.Failures >= deathSaveLimit {
+			deathSaves.State = StateDead
+		}
+	}
+	if err := s.repository.SetDeathSaves(deathSaves); err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	return s.publishStatus(character, sessionId, nil)
+}
+
+// Heal implements ServiceCharacterStatus. Hitpoints are restored up to the
+// character maximum and a dying character comes back; the dead stay dead.
+func (s *service) Heal(characterId int, healing int, sessionId *int) (dto.CharacterStatusDto, error) {
+	if healing <= 0 {
+		return dto.CharacterStatusDto{}, ErrInvalidHealing
+	}
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	if deathSaves.State == StateDead {
+		return dto.CharacterStatusDto{}, ErrCharacterDead
+	}
+	current, err := s.currentHitpoints(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	return s.SetHitpoints(characterId, min(current+healing, character.Hitpoints), sessionId)
+}
+
+// RollDeathSave implements ServiceCharacterStatus. The d20 is rolled here and
+// recorded as a dice event of the session: 10 or more is a success, a natural
+// 1 counts as two failures and a natural 20 brings the character back with 1
+// hitpoint. Three successes stabilize the character and three failures kill it.
+func (s *service) RollDeathSave(characterId int, sessionId *int) (dto.DeathSaveResultDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.DeathSaveResultDto{}, err
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.DeathSaveResultDto{}, err
+	}
+	switch deathSaves.State {
+	case StateDead:
+		return dto.DeathSaveResultDto{}, ErrCharacterDead
+	case StateDying:
+	default:
+		return dto.DeathSaveResultDto{}, ErrNotDying
+	}
+
+	roll := dice.Die(20)
+	result := dto.DeathSaveResultDto{Roll: roll}
+	switch {
+	case roll == 20:
+		result.Outcome = OutcomeRevived
+	case roll == 1:
+		result.Outcome = OutcomeCriticalFailure
+		deathSaves.Failures += 2
+	case roll >= deathSaveDC:
+		result.Outcome = OutcomeSuccess
+		deathSaves.Successes++
+	default:
+		result.Outcome = OutcomeFailure
+		deathSaves.Failures++
+	}
+	s.recordRoll(characterId, sessionId, "death_save", "1d20", roll, "Death saving throw: "+result.Outcome)
+
+	if result.Outcome == OutcomeRevived {
+		if err := s.repository.SetHitpoints(characterId, 1); err != nil {
+			return dto.DeathSaveResultDto{}, err
+		}
+		err = s.repository.ClearDeathSaves(characterId)
+	} else {
+		if deathSaves.Failures >= deathSaveLimit {
+			deathSaves.State = StateDead
+		} else if deathSaves.Successes >= deathSaveLimit {
+			deathSaves = domain.CharacterDeathSaves{CharacterId: characterId, State: StateStable}
+		}
+		err = s.repository.SetDeathSaves(deathSaves)
+	}
+	if err != nil {
+		return dto.DeathSaveResultDto{}, err
+	}
+
+	result.Status, err = s.publishStatus(character, sessionId, &roll)
+	if err != nil {
+		return dto.DeathSaveResultDto{}, err
+	}
+	return result, nil
+}
+
+// Stabilize implements ServiceCharacterStatus. A stable character stays at 0
+// hitpoints but no longer rolls death saves until it takes damage again.
+func (s *service) Stabilize(characterId int, sessionId *int) (dto.CharacterStatusDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	switch deathSaves.State {
+	case StateDead:
+		return dto.CharacterStatusDto{}, ErrCharacterDead
+	case StateDying:
+	default:
+		return dto.CharacterStatusDto{}, ErrNotDying
+	}
+	if err := s.repository.SetDeathSaves(domain.CharacterDeathSaves{CharacterId: characterId, State: StateStable}); err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	return s.publishStatus(character, sessionId, nil)
+}
+
+// deathSaves returns the stored dying state of a character. Characters with
+// no stored state are conscious, unless their hitpoints were left at 0.
+func (s *service) deathSaves(character dto.FullCharacterData) (domain.CharacterDeathSaves, error) {
+	deathSaves, err := s.repository.GetDeathSaves(character.Character_Id)
+	if err == nil {
+		return deathSaves, nil
+	}
+	if err != ErrNotFound {
+		return domain.CharacterDeathSaves{}, err
+	}
+	current, err := s.currentHitpoints(character)
+	if err != nil {
+		return domain.CharacterDeathSaves{}, err
+	}
+	deathSaves = domain.CharacterDeathSaves{CharacterId: character.Character_Id, State: StateConscious}
+	if current == 0 {
+		deathSaves.State = StateDying
+	}
+	return deathSaves, nil
+}
+
+// publishStatus returns the character status after a change to its dying
+// state, broadcasting the change to the session when one is given.
+func (s *service) publishStatus(character dto.FullCharacterData, sessionId *int, roll *int) (dto.CharacterStatusDto, error) {
+	status, err := s.status(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	if sessionId != nil && s.notifier != nil {
+		s.notifier.Publish(*sessionId, DeathStateEvent, dto.DeathStateDto{
+			CharacterId:      character.Character_Id,
+			CurrentHitpoints: status.CurrentHitpoints,
+			DeathSaves:       status.DeathSaves,
+			Roll:             roll,
+		})
+	}
+	return status, nil
+}
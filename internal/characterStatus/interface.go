@@ -0,0 +1,44 @@
+package characterstatus
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryCharacterStatus interface {
+	GetHitpoints(characterId int) (int, error)
+	SetHitpoints(characterId int, current int) error
+	GetSpellSlots(characterId int) ([]domain.CharacterSpellSlot, error)
+	ExpendSpellSlot(characterId int, level int, pact bool, total int) (bool, error)
+	RestoreSpellSlot(characterId int, level int, pact bool, count int) error
+	SetSpellSlot(characterId int, level int, pact bool, expended int) error
+	ResetSpellSlots(characterId int, pactOnly bool) error
+	GetHitDice(characterId int) ([]domain.CharacterHitDice, error)
+	SpendHitDie(characterId int, die string, total int) (bool, error)
+	RestoreHitDice(characterId int, die string, count int) error
+	SetHitDice(characterId int, die string, spent int) error
+	GetDeathSaves(characterId int) (domain.CharacterDeathSaves, error)
+	SetDeathSaves(deathSaves domain.CharacterDeathSaves) error
+	ClearDeathSaves(characterId int) error
+	DeleteByCharacterId(characterId int) error
+}
+
+type ServiceCharacterStatus interface {
+	GetStatus(characterId int) (dto.CharacterStatusDto, error)
+	SetHitpoints(characterId int, current int, sessionId *int) (dto.CharacterStatusDto, error)
+	TakeDamage(characterId int, damage int, sessionId *int) (dto.CharacterStatusDto, error)
+	Heal(characterId int, healing int, sessionId *int) (dto.CharacterStatusDto, error)
+	RollDeathSave(characterId int, sessionId *int) (dto.DeathSaveResultDto, error)
+	Stabilize(characterId int, sessionId *int) (dto.CharacterStatusDto, error)
+	ExpendSlot(characterId int, request dto.SpellSlotRequestDto) (dto.CharacterStatusDto, error)
+	RestoreSlot(characterId int, request dto.SpellSlotRequestDto) (dto.CharacterStatusDto, error)
+	CastSpell(characterId int, spellLevel int, slotLevel int) (dto.SpellSlotRequestDto, error)
+	Rest(characterId int, request dto.RestRequestDto) (dto.RestResultDto, error)
+	SetStatus(characterId int, status dto.CharacterStatusDto) (dto.CharacterStatusDto, error)
+	DeleteByCharacterId(characterId int) error
+}
+
+// Notifier publishes character state changes to the players of a session.
+type Notifier interface {
+	Publish(sessionId int, eventType string, payload any)
+}
@@ -0,0 +1,186 @@
+package characterstatus
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var ErrNotFound = errors.New("character status not found")
+
+type characterStatusMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewCharacterStatusRepository(db *sql.DB) RepositoryCharacterStatus {
+	return &characterStatusMySqlRepository{db: db}
+}
+
+// GetHitpoints implements RepositoryCharacterStatus. ErrNotFound means the
+// character has not taken damage since it was created.
+func (r *characterStatusMySqlRepository) GetHitpoints(characterId int) (int, error) {
+	var current int
+	err := r.db.QueryRow(QueryGetHitpoints, characterId).Scan(&current)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	return current, err
+}
+
+// SetHitpoints implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) SetHitpoints(characterId int, current int) error {
+	_, err := r.db.Exec(QueryUpsertHitpoints, characterId, current)
+	return err
+}
+
+// GetSpellSlots implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) GetSpellSlots(characterId int) ([]domain.CharacterSpellSlot, error) {
+	rows, err := r.db.Query(QueryGetSpellSlots, characterId)
+	if err != nil {
+		return []domain.CharacterSpellSlot{}, err
+	}
+	defer rows.Close()
+
+	slots := []domain.CharacterSpellSlot{}
+	for rows.Next() {
+		var slot domain.CharacterSpellSlot
+		if err := rows.Scan(&slot.CharacterId, &slot.SlotLevel, &slot.Pact, &slot.Expended); err != nil {
+			return []domain.CharacterSpellSlot{}, err
+		}
+		slots = append(slots, slot)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.CharacterSpellSlot{}, err
+	}
+	return slots, nil
+}
+
+// ExpendSpellSlot implements RepositoryCharacterStatus. The slot is only taken
+// while fewer than total are expended, so concurrent casts cannot overspend.
+func (r *characterStatusMySqlRepository) ExpendSpellSlot(characterId int, level int, pact bool, total int) (bool, error) {
+	if _, err := r.db.Exec(QueryEnsureSpellSlot, characterId, level, pact); err != nil {
+		return false, err
+	}
+	result, err := r.db.Exec(QueryExpendSpellSlot, characterId, level, pact, total)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// RestoreSpellSlot implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) RestoreSpellSlot(characterId int, level int, pact bool, count int) error {
+	_, err := r.db.Exec(QueryRestoreSpellSlot, count, characterId, level, pact)
+	return err
+}
+
+// SetSpellSlot implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) SetSpellSlot(characterId int, level int, pact bool, expended int) error {
+	_, err := r.db.Exec(QuerySetSpellSlot, characterId, level, pact, expended)
+	return err
+}
+
+// ResetSpellSlots implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) ResetSpellSlots(characterId int, pactOnly bool) error {
+	query := QueryResetSpellSlots
+	if pactOnly {
+		query = QueryResetPactSlots
+	}
+	_, err := r.db.Exec(query, characterId)
+	return err
+}
+
+// GetHitDice implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) GetHitDice(characterId int) ([]domain.CharacterHitDice, error) {
+	rows, err := r.db.Query(QueryGetHitDice, characterId)
+	if err != nil {
+		return []domain.CharacterHitDice{}, err
+	}
+	defer rows.Close()
+
+	hitDice := []domain.CharacterHitDice{}
+	for rows.Next() {
+		var dice domain.CharacterHitDice
+		if err := rows.Scan(&dice.CharacterId, &dice.Die, &dice.Spent); err != nil {
+			return []domain.CharacterHitDice{}, err
+		}
+		hitDice = append(hitDice, dice)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.CharacterHitDice{}, err
+	}
+	return hitDice, nil
+}
+
+// SpendHitDie implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) SpendHitDie(characterId int, die string, total int) (bool, error) {
+	if _, err := r.db.Exec(QueryEnsureHitDice, characterId, die); err != nil {
+		return false, err
+	}
+	result, err := r.db.Exec(QuerySpendHitDie, characterId, die, total)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// RestoreHitDice implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) RestoreHitDice(characterId int, die string, count int) error {
+	_, err := r.db.Exec(QueryRestoreHitDice, count, characterId, die)
+	return err
+}
+
+// SetHitDice implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) SetHitDice(characterId int, die string, spent int) error {
+	_, err := r.db.Exec(QuerySetHitDice, characterId, die, spent)
+	return err
+}
+
+// GetDeathSaves implements RepositoryCharacterStatus. ErrNotFound means the
+// character has not gone down since it was last healed.
+func (r *characterStatusMySqlRepository) GetDeathSaves(characterId int) (domain.CharacterDeathSaves, error) {
+	var deathSaves domain.CharacterDeathSaves
+	err := r.db.QueryRow(QueryGetDeathSaves, characterId).Scan(&deathSaves.CharacterId, &deathSaves.State, &deathSaves.Successes, &deathSaves.Failures)
+	if err == sql.ErrNoRows {
+		return domain.CharacterDeathSaves{}, ErrNotFound
+	}
+	return deathSaves, err
+}
+
+// SetDeathSaves implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) SetDeathSaves(deathSaves domain.CharacterDeathSaves) error {
+	_, err := r.db.Exec(QueryUpsertDeathSaves, deathSaves.CharacterId, deathSaves.State, deathSaves.Successes, deathSaves.Failures)
+	return err
+}
+
+// ClearDeathSaves implements RepositoryCharacterStatus.
+func (r *characterStatusMySqlRepository) ClearDeathSaves(characterId int) error {
+	_, err := r.db.Exec(QueryDeleteDeathSaves, characterId)
+	return err
+}
+
+// DeleteByCharacterId implements RepositoryCharacterStatus. It removes the hit
+// points, spell slots, hit dice and death saves of the character together.
+func (r *characterStatusMySqlRepository) DeleteByCharacterId(characterId int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{QueryDeleteHitpoints, QueryResetSpellSlots, QueryDeleteHitDice, QueryDeleteDeathSaves} {
+		if _, err := tx.Exec(query, characterId); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
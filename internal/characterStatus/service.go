@@ -0,0 +1,510 @@
+package characterstatus
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	characterresource "github.com/proyecto-dnd/backend/internal/characterResource"
+	"github.com/proyecto-dnd/backend/internal/dice_event"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/resource"
+	"github.com/proyecto-dnd/backend/pkg/dice"
+	"github.com/proyecto-dnd/backend/pkg/stats"
+)
+
+const (
+	ShortRest = "short"
+	LongRest  = "long"
+)
+
+var (
+	ErrInvalidRestType  = errors.New("rest type must be short or long")
+	ErrInvalidSlotLevel = errors.New("the character has no spell slots of that level")
+	ErrNoSlotAvailable  = errors.New("no spell slot of that level is available")
+	ErrNoHitDice        = errors.New("no hit dice of that size are available")
+	ErrInvalidHitpoints = errors.New("hitpoints must be between 0 and the character maximum")
+	ErrInvalidDamage    = errors.New("damage must be greater than 0")
+	ErrInvalidHealing   = errors.New("healing must be greater than 0")
+	ErrNotDying         = errors.New("the character is not dying")
+	ErrCharacterDead    = errors.New("the character is dead")
+	ErrUnconscious      = errors.New("the character must be conscious to rest")
+)
+
+type service struct {
+	repository           RepositoryCharacterStatus
+	characterDataService characterdata.ServiceCharacterData
+	diceEventService     dice_event.DiceEventService
+	resourceService      characterresource.ServiceCharacterResource
+	itemService          itemxcharacterdata.ServiceItemXCharacterData
+	notifier             Notifier
+}
+
+func NewCharacterStatusService(repository RepositoryCharacterStatus, characterDataService characterdata.ServiceCharacterData, diceEventService dice_event.DiceEventService, resourceService characterresource.ServiceCharacterResource, itemService itemxcharacterdata.ServiceItemXCharacterData, notifier Notifier) ServiceCharacterStatus {
+	return &service{repository: repository, characterDataService: characterDataService, diceEventService: diceEventService, resourceService: resourceService, itemService: itemService, notifier: notifier}
+}
+
+// GetStatus implements ServiceCharacterStatus.
+func (s *service) GetStatus(characterId int) (dto.CharacterStatusDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	return s.status(character)
+}
+
+// SetHitpoints implements ServiceCharacterStatus. Healing a character above 0
+// hitpoints clears its dying state, even when it was dead, so that
+// resurrection is a matter of setting its hitpoints; dropping it to 0 leaves
+// it dying.
+func (s *service) SetHitpoints(characterId int, current int, sessionId *int) (dto.CharacterStatusDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	if current < 0 || current > character.Hitpoints {
+		return dto.CharacterStatusDto{}, ErrInvalidHitpoints
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	if err := s.repository.SetHitpoints(characterId, current); err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	switch {
+	case current > 0 && deathSaves.State != StateConscious:
+		if err := s.repository.ClearDeathSaves(characterId); err != nil {
+			return dto.CharacterStatusDto{}, err
+		}
+	case current == 0 && deathSaves.State == StateConscious:
+		deathSaves.State = StateDying
+		if err := s.repository.SetDeathSaves(deathSaves); err != nil {
+			return dto.CharacterStatusDto{}, err
+		}
+	default:
+		return s.status(character)
+	}
+	return s.publishStatus(character, sessionId, nil)
+}
+
+// ExpendSlot implements ServiceCharacterStatus.
+func (s *service) ExpendSlot(characterId int, request dto.SpellSlotRequestDto) (dto.CharacterStatusDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	total := slotTotal(character, request.Level, request.Pact)
+	if total == 0 {
+		return dto.CharacterStatusDto{}, ErrInvalidSlotLevel
+	}
+	count := request.Count
+	if count <= 0 {
+		count = 1
+	}
+	for i := 0; i < count; i++ {
+		expended, err := s.repository.ExpendSpellSlot(characterId, request.Level, request.Pact, total)
+		if err != nil {
+			return dto.CharacterStatusDto{}, err
+		}
+		if !expended {
+			if i > 0 {
+				s.repository.RestoreSpellSlot(characterId, request.Level, request.Pact, i)
+			}
+			return dto.CharacterStatusDto{}, ErrNoSlotAvailable
+		}
+	}
+	return s.status(character)
+}
+
+// RestoreSlot implements ServiceCharacterStatus.
+func (s *service) RestoreSlot(characterId int, request dto.SpellSlotRequestDto) (dto.CharacterStatusDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	total := slotTotal(character, request.Level, request.Pact)
+	if total == 0 {
+		return dto.CharacterStatusDto{}, ErrInvalidSlotLevel
+	}
+	count := request.Count
+	if count == 0 {
+		count = 1
+	}
+	if count < 0 {
+		count = total
+	}
+	if err := s.repository.RestoreSpellSlot(characterId, request.Level, request.Pact, count); err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	return s.status(character)
+}
+
+// CastSpell implements ServiceCharacterStatus. It expends the slot a spell is
+// cast with: slotLevel when given, otherwise the lowest available slot that
+// can hold the spell, falling back to a pact slot. Cantrips use no slot.
+func (s *service) CastSpell(characterId int, spellLevel int, slotLevel int) (dto.SpellSlotRequestDto, error) {
+	if spellLevel <= 0 {
+		return dto.SpellSlotRequestDto{}, nil
+	}
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.SpellSlotRequestDto{}, err
+	}
+
+	candidates := []dto.SpellSlotRequestDto{}
+	if slotLevel > 0 {
+		if slotLevel < spellLevel {
+			return dto.SpellSlotRequestDto{}, ErrInvalidSlotLevel
+		}
+		candidates = append(candidates, dto.SpellSlotRequestDto{Level: slotLevel})
+		if character.SpellSlots.PactSlotLevel == slotLevel {
+			candidates = append(candidates, dto.SpellSlotRequestDto{Level: slotLevel, Pact: true})
+		}
+	} else {
+		for level := spellLevel; level <= len(character.SpellSlots.Slots); level++ {
+			candidates = append(candidates, dto.SpellSlotRequestDto{Level: level})
+		}
+		if character.SpellSlots.PactSlotLevel >= spellLevel {
+			candidates = append(candidates, dto.SpellSlotRequestDto{Level: character.SpellSlots.PactSlotLevel, Pact: true})
+		}
+	}
+
+	for _, candidate := range candidates {
+		total := slotTotal(character, candidate.Level, candidate.Pact)
+		if total == 0 {
+			continue
+		}
+		expended, err := s.repository.ExpendSpellSlot(characterId, candidate.Level, candidate.Pact, total)
+		if err != nil {
+			return dto.SpellSlotRequestDto{}, err
+		}
+		if expended {
+			candidate.Count = 1
+			return candidate, nil
+		}
+	}
+	return dto.SpellSlotRequestDto{}, ErrNoSlotAvailable
+}
+
+// Rest implements ServiceCharacterStatus. Besides hitpoints, slots and hit
+// dice, a rest recharges the character's limited-use resources.
+func (s *service) Rest(characterId int, request dto.RestRequestDto) (dto.RestResultDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.RestResultDto{}, err
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.RestResultDto{}, err
+	}
+	switch deathSaves.State {
+	case StateDead:
+		return dto.RestResultDto{}, ErrCharacterDead
+	case StateDying, StateStable:
+		return dto.RestResultDto{}, ErrUnconscious
+	}
+	result := dto.RestResultDto{Type: request.Type, Rolls: []dto.HitDiceRollDto{}}
+	switch request.Type {
+	case ShortRest:
+		err = s.shortRest(character, request, &result)
+	case LongRest:
+		err = s.longRest(character, &result)
+	default:
+		return dto.RestResultDto{}, ErrInvalidRestType
+	}
+	if err != nil {
+		return dto.RestResultDto{}, err
+	}
+	result.ResourcesRecharged, err = s.resourceService.Recharge(characterId, resource.RechargedBy(request.Type))
+	if err != nil {
+		return dto.RestResultDto{}, err
+	}
+	result.ItemsRecharged, err = s.itemService.Recharge(characterId, resource.RechargedBy(request.Type))
+	if err != nil {
+		return dto.RestResultDto{}, err
+	}
+	result.Status, err = s.status(character)
+	if err != nil {
+		return dto.RestResultDto{}, err
+	}
+	return result, nil
+}
+
+// SetStatus implements ServiceCharacterStatus. It puts back the hitpoints,
+// expended slots, spent hit dice and death saves of an earlier status, as far
+// as the character's current maximums allow.
+func (s *service) SetStatus(characterId int, status dto.CharacterStatusDto) (dto.CharacterStatusDto, error) {
+	character, err := s.characterDataService.GetById(characterId)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	if err := s.repository.SetHitpoints(characterId, max(min(status.CurrentHitpoints, character.Hitpoints), 0)); err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+
+	if err := s.repository.ResetSpellSlots(characterId, false); err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	for _, slot := range status.SpellSlots {
+		if slot.Expended > 0 {
+			if err := s.repository.SetSpellSlot(characterId, slot.Level, false, slot.Expended); err != nil {
+				return dto.CharacterStatusDto{}, err
+			}
+		}
+	}
+	if status.PactSlots != nil && status.PactSlots.Expended > 0 {
+		if err := s.repository.SetSpellSlot(characterId, status.PactSlots.Level, true, status.PactSlots.Expended); err != nil {
+			return dto.CharacterStatusDto{}, err
+		}
+	}
+
+	for _, pool := range character.HitDicePool {
+		spent := 0
+		for _, hitDice := range status.HitDice {
+			if hitDice.Die == pool.Die {
+				spent = min(hitDice.Spent, pool.Total)
+			}
+		}
+		if err := s.repository.SetHitDice(characterId, pool.Die, spent); err != nil {
+			return dto.CharacterStatusDto{}, err
+		}
+	}
+
+	if status.DeathSaves.State == StateConscious {
+		err = s.repository.ClearDeathSaves(characterId)
+	} else {
+		err = s.repository.SetDeathSaves(domain.CharacterDeathSaves{CharacterId: characterId, State: status.DeathSaves.State, Successes: status.DeathSaves.Successes, Failures: status.DeathSaves.Failures})
+	}
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	return s.status(character)
+}
+
+// shortRest spends the requested hit dice, each healing its roll plus the
+// Constitution modifier, and recovers pact slots.
+func (s *service) shortRest(character dto.FullCharacterData, request dto.RestRequestDto, result *dto.RestResultDto) error {
+	current, err := s.currentHitpoints(character)
+	if err != nil {
+		return err
+	}
+	totals := map[string]int{}
+	for _, pool := range character.HitDicePool {
+		totals[pool.Die] = pool.Total
+	}
+	// Check every requested die up front so a rest is not left half applied.
+	spentDice, err := s.repository.GetHitDice(character.Character_Id)
+	if err != nil {
+		return err
+	}
+	available := map[string]int{}
+	for die, total := range totals {
+		available[die] = total
+	}
+	for _, spentDie := range spentDice {
+		available[spentDie.Die] -= spentDie.Spent
+	}
+	for _, spend := range request.HitDice {
+		die := strings.ToLower(strings.TrimSpace(spend.Die))
+		available[die] -= spend.Count
+		if spend.Count < 0 || totals[die] == 0 || available[die] < 0 {
+			return fmt.Errorf("%w: %s", ErrNoHitDice, spend.Die)
+		}
+	}
+	modifier := stats.Modifier(character.Con)
+
+	for _, spend := range request.HitDice {
+		die := strings.ToLower(strings.TrimSpace(spend.Die))
+		parsed, err := dice.Parse(die)
+		if err != nil || totals[die] == 0 {
+			return fmt.Errorf("%w: %s", ErrNoHitDice, spend.Die)
+		}
+		for i := 0; i < spend.Count; i++ {
+			spent, err := s.repository.SpendHitDie(character.Character_Id, die, totals[die])
+			if err != nil {
+				return err
+			}
+			if !spent {
+				return fmt.Errorf("%w: %s", ErrNoHitDice, spend.Die)
+			}
+			roll := dice.Die(parsed.Sides)
+			healing := roll + modifier
+			if healing < 0 {
+				healing = 0
+			}
+			result.Rolls = append(result.Rolls, dto.HitDiceRollDto{Die: die, Roll: roll, Modifier: modifier, Total: healing})
+			healing = min(healing, character.Hitpoints-current)
+			current += healing
+			result.Healed += healing
+			if err := s.repository.SetHitpoints(character.Character_Id, current); err != nil {
+				return err
+			}
+			s.recordRoll(character.Character_Id, request.SessionId, "con", die, roll+modifier, "Short rest hit die")
+		}
+	}
+	return s.repository.ResetSpellSlots(character.Character_Id, true)
+}
+
+// longRest restores hitpoints and every spell slot, and recovers half of the
+// character's hit dice (at least one), largest dice first.
+func (s *service) longRest(character dto.FullCharacterData, result *dto.RestResultDto) error {
+	current, err := s.currentHitpoints(character)
+	if err != nil {
+		return err
+	}
+	result.Healed = character.Hitpoints - current
+	if err := s.repository.SetHitpoints(character.Character_Id, character.Hitpoints); err != nil {
+		return err
+	}
+	if err := s.repository.ResetSpellSlots(character.Character_Id, false); err != nil {
+		return err
+	}
+
+	spentDice, err := s.repository.GetHitDice(character.Character_Id)
+	if err != nil {
+		return err
+	}
+	sort.Slice(spentDice, func(i, j int) bool { return dieSides(spentDice[i].Die) > dieSides(spentDice[j].Die) })
+	recovering := max(characterLevel(character)/2, 1)
+	for _, spent := range spentDice {
+		if recovering == 0 {
+			break
+		}
+		count := min(spent.Spent, recovering)
+		if count == 0 {
+			continue
+		}
+		if err := s.repository.RestoreHitDice(character.Character_Id, spent.Die, count); err != nil {
+			return err
+		}
+		recovering -= count
+		result.HitDiceRecovered += count
+	}
+	return nil
+}
+
+func (s *service) recordRoll(characterId int, sessionId *int, stat string, die string, result int, description string) {
+	if sessionId == nil {
+		return
+	}
+	s.diceEventService.Create(domain.DiceEvent{
+		Stat:             stat,
+		DiceRolled:       die,
+		DiceResult:       result,
+		EventProtagonist: characterId,
+		Description:      description,
+		SessionId:        *sessionId,
+		TimeStamp:        time.Now(),
+	})
+}
+
+func (s *service) currentHitpoints(character dto.FullCharacterData) (int, error) {
+	current, err := s.repository.GetHitpoints(character.Character_Id)
+	if err == ErrNotFound {
+		return character.Hitpoints, nil
+	}
+	return min(current, character.Hitpoints), err
+}
+
+func (s *service) status(character dto.FullCharacterData) (dto.CharacterStatusDto, error) {
+	current, err := s.currentHitpoints(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	expendedSlots, err := s.repository.GetSpellSlots(character.Character_Id)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	spentDice, err := s.repository.GetHitDice(character.Character_Id)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+	deathSaves, err := s.deathSaves(character)
+	if err != nil {
+		return dto.CharacterStatusDto{}, err
+	}
+
+	status := dto.CharacterStatusDto{
+		CharacterId:      character.Character_Id,
+		CurrentHitpoints: current,
+		MaxHitpoints:     character.Hitpoints,
+		SpellSlots:       []dto.SpellSlotStatusDto{},
+		HitDice:          []dto.HitDiceStatusDto{},
+		DeathSaves:       dto.DeathSavesDto{State: deathSaves.State, Successes: deathSaves.Successes, Failures: deathSaves.Failures},
+	}
+	expended := func(level int, pact bool) int {
+		for _, slot := range expendedSlots {
+			if slot.SlotLevel == level && slot.Pact == pact {
+				return slot.Expended
+			}
+		}
+		return 0
+	}
+	for i, total := range character.SpellSlots.Slots {
+		status.SpellSlots = append(status.SpellSlots, slotStatus(i+1, total, expended(i+1, false)))
+	}
+	if character.SpellSlots.PactSlots > 0 {
+		pact := slotStatus(character.SpellSlots.PactSlotLevel, character.SpellSlots.PactSlots, expended(character.SpellSlots.PactSlotLevel, true))
+		status.PactSlots = &pact
+	}
+	for _, pool := range character.HitDicePool {
+		spent := 0
+		for _, spentDie := range spentDice {
+			if spentDie.Die == pool.Die {
+				spent = min(spentDie.Spent, pool.Total)
+			}
+		}
+		status.HitDice = append(status.HitDice, dto.HitDiceStatusDto{Die: pool.Die, Total: pool.Total, Spent: spent, Available: pool.Total - spent})
+	}
+	return status, nil
+}
+
+func slotStatus(level int, total int, expended int) dto.SpellSlotStatusDto {
+	expended = min(expended, total)
+	return dto.SpellSlotStatusDto{Level: level, Total: total, Expended: expended, Available: total - expended}
+}
+
+func slotTotal(character dto.FullCharacterData, level int, pact bool) int {
+	if pact {
+		if level == character.SpellSlots.PactSlotLevel {
+			return character.SpellSlots.PactSlots
+		}
+		return 0
+	}
+	if level < 1 || level > len(character.SpellSlots.Slots) {
+		return 0
+	}
+	return character.SpellSlots.Slots[level-1]
+}
+
+func characterLevel(character dto.FullCharacterData) int {
+	total := 0
+	for _, pool := range character.HitDicePool {
+		total += pool.Total
+	}
+	return total
+}
+
+func dieSides(die string) int {
+	parsed, err := dice.Parse(die)
+	if err != nil {
+		return 0
+	}
+	return parsed.Sides
+}
+
+// DeleteByCharacterId implements ServiceCharacterStatus. It removes the play
+// state of a character: hit points, spell slots, hit dice, death saves and
+// resources.
+func (s *service) DeleteByCharacterId(characterId int) error {
+	if err := s.resourceService.DeleteByCharacterId(characterId); err != nil {
+		return err
+	}
+	return s.repository.DeleteByCharacterId(characterId)
+}
@@ -0,0 +1,46 @@
+package characterstatus
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/attackEvent"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/spell"
+)
+
+type slottedService struct {
+	attackEvent.AttackEventService
+	status ServiceCharacterStatus
+	spells spell.ServiceSpell
+}
+
+// WithSpellSlots expends the protagonist's spell slot for the spell attacks
+// created through service. A character with no slot left still gets its
+// event: the table may be tracking slots on paper.
+func WithSpellSlots(service attackEvent.AttackEventService, status ServiceCharacterStatus, spells spell.ServiceSpell) attackEvent.AttackEventService {
+	return &slottedService{AttackEventService: service, status: status, spells: spells}
+}
+
+// CreateEvent implements attackEvent.AttackEventService.
+func (s *slottedService) CreateEvent(event domain.AttackEvent) (domain.AttackEvent, error) {
+	if event.Spell == nil {
+		return s.AttackEventService.CreateEvent(event)
+	}
+	castSpell, err := s.spells.GetById(*event.Spell)
+	if err != nil {
+		return domain.AttackEvent{}, err
+	}
+	usedSlot, err := s.status.CastSpell(event.EventProtagonistId, castSpell.Level, event.SlotLevel)
+	if err != nil && !errors.Is(err, ErrNoSlotAvailable) && !errors.Is(err, ErrInvalidSlotLevel) {
+		return domain.AttackEvent{}, err
+	}
+
+	createdEvent, err := s.AttackEventService.CreateEvent(event)
+	if err != nil {
+		if usedSlot.Count > 0 {
+			s.status.RestoreSlot(event.EventProtagonistId, usedSlot)
+		}
+		return domain.AttackEvent{}, err
+	}
+	return createdEvent, nil
+}
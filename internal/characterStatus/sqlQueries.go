@@ -0,0 +1,26 @@
+package characterstatus
+
+var (
+	QueryGetHitpoints    = `SELECT current_hitpoints FROM character_hitpoints WHERE character_id = ?;`
+	QueryUpsertHitpoints = `INSERT INTO character_hitpoints (character_id, current_hitpoints) VALUES (?, ?) ON DUPLICATE KEY UPDATE current_hitpoints = VALUES(current_hitpoints);`
+	QueryDeleteHitpoints = `DELETE FROM character_hitpoints WHERE character_id = ?;`
+
+	QueryGetSpellSlots    = `SELECT character_id, slot_level, pact, expended FROM character_spell_slot WHERE character_id = ? ORDER BY pact, slot_level;`
+	QueryEnsureSpellSlot  = `INSERT IGNORE INTO character_spell_slot (character_id, slot_level, pact, expended) VALUES (?, ?, ?, 0);`
+	QueryExpendSpellSlot  = `UPDATE character_spell_slot SET expended = expended + 1 WHERE character_id = ? AND slot_level = ? AND pact = ? AND expended < ?;`
+	QueryRestoreSpellSlot = `UPDATE character_spell_slot SET expended = GREATEST(expended - ?, 0) WHERE character_id = ? AND slot_level = ? AND pact = ?;`
+	QuerySetSpellSlot     = `INSERT INTO character_spell_slot (character_id, slot_level, pact, expended) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE expended = VALUES(expended);`
+	QueryResetSpellSlots  = `DELETE FROM character_spell_slot WHERE character_id = ?;`
+	QueryResetPactSlots   = `DELETE FROM character_spell_slot WHERE character_id = ? AND pact = TRUE;`
+
+	QueryGetHitDice     = `SELECT character_id, die, spent FROM character_hit_dice WHERE character_id = ?;`
+	QueryEnsureHitDice  = `INSERT IGNORE INTO character_hit_dice (character_id, die, spent) VALUES (?, ?, 0);`
+	QuerySpendHitDie    = `UPDATE character_hit_dice SET spent = spent + 1 WHERE character_id = ? AND die = ? AND spent < ?;`
+	QuerySetHitDice     = `INSERT INTO character_hit_dice (character_id, die, spent) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE spent = VALUES(spent);`
+	QueryRestoreHitDice = `UPDATE character_hit_dice SET spent = GREATEST(spent - ?, 0) WHERE character_id = ? AND die = ?;`
+	QueryDeleteHitDice  = `DELETE FROM character_hit_dice WHERE character_id = ?;`
+
+	QueryGetDeathSaves    = `SELECT character_id, state, successes, failures FROM character_death_save WHERE character_id = ?;`
+	QueryUpsertDeathSaves = `INSERT INTO character_death_save (character_id, state, successes, failures) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE state = VALUES(state), successes = VALUES(successes), failures = VALUES(failures);`
+	QueryDeleteDeathSaves = `DELETE FROM character_death_save WHERE character_id = ?;`
+)
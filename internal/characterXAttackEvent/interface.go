@@ -11,6 +11,7 @@ type CharacterXAttackEventRepository interface {
 	GetByCharacterId(characterId int) ([]domain.CharacterXAttackEvent, error)
 	GetByEventId(attackEventId int) ([]domain.CharacterXAttackEvent, error)
 	Create(CharacterXAttackEvent domain.CharacterXAttackEvent) (domain.CharacterXAttackEvent, error)
+	Update(CharacterXAttackEvent domain.CharacterXAttackEvent, id int) (domain.CharacterXAttackEvent, error)
 	Delete(id int) error
 }
 
@@ -20,5 +21,6 @@ type CharacterXAttackEventService interface {
 	GetByCharacterId(characterId int) ([]domain.CharacterXAttackEvent, error)
 	GetByEventId(attackEventId int) ([]domain.CharacterXAttackEvent, error)
 	Create(CharacterXAttackEvent dto.CharacterXAttackEventDto) (domain.CharacterXAttackEvent, error)
+	Update(CharacterXAttackEvent dto.CharacterXAttackEventDto, id int) (domain.CharacterXAttackEvent, error)
 	Delete(id int) error
 }
@@ -132,6 +132,22 @@ func (r *characterXAttackEventRepository) Create(characterXAttackEvent domain.Ch
 	return characterXAttackEvent, nil
 }
 
+func (r *characterXAttackEventRepository) Update(characterXAttackEvent domain.CharacterXAttackEvent, id int) (domain.CharacterXAttackEvent, error) {
+	statement, err := r.db.Prepare(QueryUpdate)
+	if err != nil {
+		return domain.CharacterXAttackEvent{}, ErrPrepareStatement
+	}
+	defer statement.Close()
+
+	_, err = statement.Exec(characterXAttackEvent.EventId, characterXAttackEvent.CharacterId, characterXAttackEvent.Dmg, characterXAttackEvent.DmgRoll, characterXAttackEvent.AttackResult, characterXAttackEvent.AttackRoll, characterXAttackEvent.ArmorClass, id)
+	if err != nil {
+		return domain.CharacterXAttackEvent{}, err
+	}
+
+	characterXAttackEvent.CharacterAttackEventId = id
+	return characterXAttackEvent, nil
+}
+
 func (r *characterXAttackEventRepository) Delete(id int) error {
 	result, err := r.db.Exec(QueryDelete, id)
 	if err != nil {
@@ -1,16 +1,22 @@
 package characterxattackevent
 
 import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/attackEvent"
+	characterstatus "github.com/proyecto-dnd/backend/internal/characterStatus"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
 
 type service struct {
 	characterXAttackEventRepository CharacterXAttackEventRepository
+	attackEventService              attackEvent.AttackEventService
+	statusService                   characterstatus.ServiceCharacterStatus
 }
 
-func NewCharacterXAttackEventService(characterXAttackEventRepository CharacterXAttackEventRepository) CharacterXAttackEventService {
-	return &service{characterXAttackEventRepository: characterXAttackEventRepository}
+func NewCharacterXAttackEventService(characterXAttackEventRepository CharacterXAttackEventRepository, attackEventService attackEvent.AttackEventService, statusService characterstatus.ServiceCharacterStatus) CharacterXAttackEventService {
+	return &service{characterXAttackEventRepository: characterXAttackEventRepository, attackEventService: attackEventService, statusService: statusService}
 }
 
 func (s *service) GetAll() ([]domain.CharacterXAttackEvent, error) {
@@ -49,6 +55,8 @@ func (s *service) GetByEventId(attackEventId int) ([]domain.CharacterXAttackEven
 	return characterXAttackEvents, nil
 }
 
+// Create implements CharacterXAttackEventService. The damage is taken off the
+// character's hitpoints, which may leave it dying or dead.
 func (s *service) Create(characterXAttackEvent dto.CharacterXAttackEventDto) (domain.CharacterXAttackEvent, error) {
 	newCharacterXAttackEvent := domain.CharacterXAttackEvent{
 		CharacterId: characterXAttackEvent.CharacterId,
@@ -65,14 +73,91 @@ func (s *service) Create(characterXAttackEvent dto.CharacterXAttackEventDto) (do
 		return domain.CharacterXAttackEvent{}, err
 	}
 
+	if err := s.applyDamage(createdCharacterXAttackEvent.CharacterId, createdCharacterXAttackEvent.EventId, dealt(createdCharacterXAttackEvent)); err != nil {
+		s.characterXAttackEventRepository.Delete(createdCharacterXAttackEvent.CharacterAttackEventId)
+		return domain.CharacterXAttackEvent{}, err
+	}
+
 	return createdCharacterXAttackEvent, nil
 }
 
+// Update implements CharacterXAttackEventService. Only the difference with
+// the stored damage is applied; when the attack moves to another character,
+// the first one gets its hitpoints back.
+func (s *service) Update(characterXAttackEvent dto.CharacterXAttackEventDto, id int) (domain.CharacterXAttackEvent, error) {
+	previous, err := s.characterXAttackEventRepository.GetById(id)
+	if err != nil {
+		return domain.CharacterXAttackEvent{}, err
+	}
+
+	updatedCharacterXAttackEvent, err := s.characterXAttackEventRepository.Update(domain.CharacterXAttackEvent{
+		CharacterId:  characterXAttackEvent.CharacterId,
+		EventId:      characterXAttackEvent.EventId,
+		Dmg:          characterXAttackEvent.Dmg,
+		DmgRoll:      characterXAttackEvent.DmgRoll,
+		AttackResult: characterXAttackEvent.AttackResult,
+		AttackRoll:   characterXAttackEvent.AttackRoll,
+		ArmorClass:   characterXAttackEvent.ArmorClass,
+	}, id)
+	if err != nil {
+		return domain.CharacterXAttackEvent{}, err
+	}
+
+	if previous.CharacterId == updatedCharacterXAttackEvent.CharacterId {
+		err = s.applyDamage(updatedCharacterXAttackEvent.CharacterId, updatedCharacterXAttackEvent.EventId, dealt(updatedCharacterXAttackEvent)-dealt(previous))
+	} else {
+		err = s.applyDamage(previous.CharacterId, previous.EventId, -dealt(previous))
+		if err == nil {
+			err = s.applyDamage(updatedCharacterXAttackEvent.CharacterId, updatedCharacterXAttackEvent.EventId, dealt(updatedCharacterXAttackEvent))
+		}
+	}
+	if err != nil {
+		s.characterXAttackEventRepository.Update(previous, id)
+		return domain.CharacterXAttackEvent{}, err
+	}
+
+	return updatedCharacterXAttackEvent, nil
+}
+
+// Delete implements CharacterXAttackEventService. The character gets back the
+// hitpoints the attack took.
 func (s *service) Delete(id int) error {
-	err := s.characterXAttackEventRepository.Delete(id)
+	previous, err := s.characterXAttackEventRepository.GetById(id)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	err = s.characterXAttackEventRepository.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	return s.applyDamage(previous.CharacterId, previous.EventId, -dealt(previous))
+}
+
+// applyDamage takes damage off the character's hitpoints, or heals it when the
+// damage is negative, announcing the change to the session of the attack.
+// Neither changes a character that is already dead.
+func (s *service) applyDamage(characterId int, attackEventId int, damage int) error {
+	if damage == 0 {
+		return nil
+	}
+	event, err := s.attackEventService.GetEventById(attackEventId)
+	if err != nil {
+		return err
+	}
+	if damage > 0 {
+		_, err = s.statusService.TakeDamage(characterId, damage, &event.Session.SessionId)
+	} else {
+		_, err = s.statusService.Heal(characterId, -damage, &event.Session.SessionId)
+	}
+	if errors.Is(err, characterstatus.ErrCharacterDead) {
+		return nil
+	}
+	return err
+}
+
+// dealt is the damage an attack does to hitpoints; negative damage does none.
+func dealt(characterXAttackEvent domain.CharacterXAttackEvent) int {
+	return max(characterXAttackEvent.Dmg, 0)
 }
@@ -6,5 +6,6 @@ var (
 	QueryGetByCharacterId = `SELECT * FROM character_attack_event WHERE character_id=?;`
 	QueryGetByEventId = `SELECT * FROM character_attack_event WHERE event_id=?;`
 	QueryInsert = `INSERT INTO character_attack_event (event_id, character_id, dmg, dmg_roll, attack_result, attack_roll, armor_class) VALUES (?, ?, ?, ?, ?, ?, ?);`
+	QueryUpdate = `UPDATE character_attack_event SET event_id=?, character_id=?, dmg=?, dmg_roll=?, attack_result=?, attack_roll=?, armor_class=? WHERE character_event=?;`
 	QueryDelete = `DELETE FROM character_attack_event WHERE character_event=?;`
 )
\ No newline at end of file
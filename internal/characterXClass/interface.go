@@ -0,0 +1,19 @@
+package characterXclass
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+type RepositoryCharacterXClass interface {
+	Create(characterClass domain.CharacterClass) (domain.CharacterClass, error)
+	GetByCharacterId(characterId int) ([]domain.CharacterClass, error)
+	UpdateLevel(characterId int, classId int, level int) error
+	Delete(characterId int, classId int) error
+	DeleteByCharacterDataId(characterId int) error
+}
+
+type ServiceCharacterXClass interface {
+	Create(characterClass domain.CharacterClass) (domain.CharacterClass, error)
+	GetByCharacterId(characterId int) ([]domain.CharacterClass, error)
+	UpdateLevel(characterId int, classId int, level int) error
+	Delete(characterId int, classId int) error
+	DeleteByCharacterDataId(characterId int) error
+}
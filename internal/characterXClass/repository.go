@@ -0,0 +1,114 @@
+package characterXclass
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrPrepareStatement    = errors.New("error preparing statement")
+	ErrGettingLastInsertId = errors.New("error getting last insert id")
+	ErrNotFound            = errors.New("character class not found")
+)
+
+type CharacterXClassMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewCharacterXClassRepository(db *sql.DB) RepositoryCharacterXClass {
+	return &CharacterXClassMySqlRepository{db: db}
+}
+
+// Create implements RepositoryCharacterXClass.
+func (r *CharacterXClassMySqlRepository) Create(characterClass domain.CharacterClass) (domain.CharacterClass, error) {
+	statement, err := r.db.Prepare(QueryInsert)
+	if err != nil {
+		return domain.CharacterClass{}, ErrPrepareStatement
+	}
+	defer statement.Close()
+	result, err := statement.Exec(characterClass.CharacterId, characterClass.Class.ClassId, characterClass.Level)
+	if err != nil {
+		return domain.CharacterClass{}, err
+	}
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return domain.CharacterClass{}, ErrGettingLastInsertId
+	}
+	characterClass.CharacterClassId = int(lastId)
+	return characterClass, nil
+}
+
+// GetByCharacterId implements RepositoryCharacterXClass.
+func (r *CharacterXClassMySqlRepository) GetByCharacterId(characterId int) ([]domain.CharacterClass, error) {
+	rows, err := r.db.Query(QueryGetByCharacterId, characterId)
+	if err != nil {
+		return []domain.CharacterClass{}, err
+	}
+	defer rows.Close()
+
+	characterClasses := []domain.CharacterClass{}
+	for rows.Next() {
+		var characterClass domain.CharacterClass
+		if err := rows.Scan(
+			&characterClass.CharacterClassId,
+			&characterClass.CharacterId,
+			&characterClass.Level,
+			&characterClass.Class.ClassId,
+			&characterClass.Class.Name,
+			&characterClass.Class.Description,
+			&characterClass.Class.ProficiencyBonus,
+			&characterClass.Class.HitDice,
+			&characterClass.Class.ArmorProficiencies,
+			&characterClass.Class.WeaponProficiencies,
+			&characterClass.Class.ToolProficiencies,
+			&characterClass.Class.SpellcastingAbility,
+		); err != nil {
+			return []domain.CharacterClass{}, err
+		}
+		characterClasses = append(characterClasses, characterClass)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.CharacterClass{}, err
+	}
+	return characterClasses, nil
+}
+
+// UpdateLevel implements RepositoryCharacterXClass.
+func (r *CharacterXClassMySqlRepository) UpdateLevel(characterId int, classId int, level int) error {
+	result, err := r.db.Exec(QueryUpdateLevel, level, characterId, classId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete implements RepositoryCharacterXClass.
+func (r *CharacterXClassMySqlRepository) Delete(characterId int, classId int) error {
+	result, err := r.db.Exec(QueryDelete, characterId, classId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByCharacterDataId implements RepositoryCharacterXClass.
+func (r *CharacterXClassMySqlRepository) DeleteByCharacterDataId(characterId int) error {
+	_, err := r.db.Exec(QueryDeleteByCharacterId, characterId)
+	return err
+}
@@ -0,0 +1,298 @@
+package characterXclass
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/pkg/stats"
+)
+
+var ErrMulticlassPrerequisite = errors.New("multiclass prerequisite not met")
+
+type casterType int
+
+const (
+	notCaster casterType = iota
+	fullCaster
+	halfCaster
+	pactCaster
+)
+
+// requirement lists ability minimums; any one group satisfies it (fighter
+// takes Str 13 or Dex 13).
+type requirement [][]ability
+
+type ability struct {
+	stat  string
+	score int
+}
+
+type classRules struct {
+	prerequisite requirement
+	caster       casterType
+	// knownSpells is the spells known table of classes that learn a fixed
+	// repertoire; preparedAbility is set for classes that prepare spells.
+	knownSpells     []int
+	preparedAbility string
+	// cantrips is how many cantrips the class knows at 1st level; every class
+	// learns one more at 4th and at 10th level.
+	cantrips int
+}
+
+var (
+	barbarianRules = classRules{prerequisite: requirement{{{"str", 13}}}}
+	bardRules      = classRules{prerequisite: requirement{{{"cha", 13}}}, caster: fullCaster, knownSpells: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 15, 15, 16, 18, 19, 19, 20, 22, 22, 22}, cantrips: 2}
+	clericRules    = classRules{prerequisite: requirement{{{"wis", 13}}}, caster: fullCaster, preparedAbility: "wis", cantrips: 3}
+	druidRules     = classRules{prerequisite: requirement{{{"wis", 13}}}, caster: fullCaster, preparedAbility: "wis", cantrips: 2}
+	fighterRules   = classRules{prerequisite: requirement{{{"str", 13}}, {{"dex", 13}}}}
+	monkRules      = classRules{prerequisite: requirement{{{"dex", 13}, {"wis", 13}}}}
+	paladinRules   = classRules{prerequisite: requirement{{{"str", 13}, {"cha", 13}}}, caster: halfCaster, preparedAbility: "cha"}
+	rangerRules    = classRules{prerequisite: requirement{{{"dex", 13}, {"wis", 13}}}, caster: halfCaster, knownSpells: []int{0, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11}}
+	rogueRules     = classRules{prerequisite: requirement{{{"dex", 13}}}}
+	sorcererRules  = classRules{prerequisite: requirement{{{"cha", 13}}}, caster: fullCaster, knownSpells: []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 12, 13, 13, 14, 14, 15, 15, 15, 15}, cantrips: 4}
+	warlockRules   = classRules{prerequisite: requirement{{{"cha", 13}}}, caster: pactCaster, knownSpells: []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15}, cantrips: 2}
+	wizardRules    = classRules{prerequisite: requirement{{{"int", 13}}}, caster: fullCaster, preparedAbility: "int", cantrips: 3}
+)
+
+var accentReplacer = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u")
+
+// rulesByClass is keyed by normalized class name, english and spanish.
+var rulesByClass = map[string]classRules{
+	"barbarian":  barbarianRules,
+	"barbaro":    barbarianRules,
+	"bard":       bardRules,
+	"bardo":      bardRules,
+	"cleric":     clericRules,
+	"clerigo":    clericRules,
+	"druid":      druidRules,
+	"druida":     druidRules,
+	"fighter":    fighterRules,
+	"guerrero":   fighterRules,
+	"monk":       monkRules,
+	"monje":      monkRules,
+	"paladin":    paladinRules,
+	"ranger":     rangerRules,
+	"explorador": rangerRules,
+	"rogue":      rogueRules,
+	"picaro":     rogueRules,
+	"sorcerer":   sorcererRules,
+	"hechicero":  sorcererRules,
+	"warlock":    warlockRules,
+	"brujo":      warlockRules,
+	"wizard":     wizardRules,
+	"mago":       wizardRules,
+}
+
+// multiclassSlots is the multiclass spellcaster table, indexed by caster level.
+var multiclassSlots = [21][]int{
+	{},
+	{2},
+	{3},
+	{4, 2},
+	{4, 3},
+	{4, 3, 2},
+	{4, 3, 3},
+	{4, 3, 3, 1},
+	{4, 3, 3, 2},
+	{4, 3, 3, 3, 1},
+	{4, 3, 3, 3, 2},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 2, 1, 1},
+}
+
+func rulesFor(class domain.Class) classRules {
+	name := accentReplacer.Replace(strings.ToLower(strings.TrimSpace(class.Name)))
+	if rules, ok := rulesByClass[name]; ok {
+		return rules
+	}
+	// Homebrew classes: anything with a spellcasting ability casts as a full caster.
+	if strings.TrimSpace(class.SpellcastingAbility) != "" {
+		return classRules{caster: fullCaster}
+	}
+	return classRules{}
+}
+
+func score(character domain.CharacterData, stat string) int {
+	switch stat {
+	case "str":
+		return character.Str
+	case "dex":
+		return character.Dex
+	case "con":
+		return character.Con
+	case "int":
+		return character.Int
+	case "wis":
+		return character.Wiz
+	case "cha":
+		return character.Cha
+	}
+	return 0
+}
+
+// CheckPrerequisite returns ErrMulticlassPrerequisite when the character's
+// ability scores do not allow multiclassing into or out of class.
+func CheckPrerequisite(character domain.CharacterData, class domain.Class) error {
+	prerequisite := rulesFor(class).prerequisite
+	if len(prerequisite) == 0 {
+		return nil
+	}
+	missing := []string{}
+	for _, group := range prerequisite {
+		groupMissing := []string{}
+		for _, required := range group {
+			if score(character, required.stat) < required.score {
+				groupMissing = append(groupMissing, fmt.Sprintf("%s %d", required.stat, required.score))
+			}
+		}
+		if len(groupMissing) == 0 {
+			return nil
+		}
+		missing = append(missing, strings.Join(groupMissing, " and "))
+	}
+	return fmt.Errorf("%w: %s requires %s", ErrMulticlassPrerequisite, class.Name, strings.Join(missing, " or "))
+}
+
+// TotalLevel is the character level derived from its class levels.
+func TotalLevel(classes []domain.CharacterClass) int {
+	total := 0
+	for _, characterClass := range classes {
+		total += characterClass.Level
+	}
+	return total
+}
+
+// HitDicePool groups the hit dice granted by each class level by die size.
+func HitDicePool(classes []domain.CharacterClass) []domain.HitDicePool {
+	pool := []domain.HitDicePool{}
+	index := map[string]int{}
+	for _, characterClass := range classes {
+		die := strings.ToLower(strings.TrimSpace(characterClass.Class.HitDice))
+		// Stored values may carry a count ("1d10"); only the die matters here.
+		if i := strings.Index(die, "d"); i > 0 {
+			die = die[i:]
+		}
+		if die == "" {
+			continue
+		}
+		if i, ok := index[die]; ok {
+			pool[i].Total += characterClass.Level
+			continue
+		}
+		index[die] = len(pool)
+		pool = append(pool, domain.HitDicePool{Die: die, Total: characterClass.Level})
+	}
+	return pool
+}
+
+// SpellSlots computes spell slots from class levels. A single caster class
+// follows its own progression, which for half casters rounds up, while
+// multiclass characters add their caster levels and use the multiclass table.
+func SpellSlots(classes []domain.CharacterClass) domain.SpellSlots {
+	slots := domain.SpellSlots{Slots: []int{}}
+	casterLevel := 0
+	casterClasses := 0
+	for _, characterClass := range classes {
+		switch rulesFor(characterClass.Class).caster {
+		case fullCaster:
+			casterLevel += characterClass.Level
+			casterClasses++
+		case halfCaster:
+			casterLevel += characterClass.Level / 2
+			casterClasses++
+		case pactCaster:
+			slots.PactSlots, slots.PactSlotLevel = pactSlots(characterClass.Level)
+		}
+	}
+	if casterClasses == 1 {
+		for _, characterClass := range classes {
+			if rulesFor(characterClass.Class).caster == halfCaster && characterClass.Level > 1 {
+				casterLevel = (characterClass.Level + 1) / 2
+			}
+		}
+	}
+	if casterLevel > 20 {
+		casterLevel = 20
+	}
+	slots.Slots = append(slots.Slots, multiclassSlots[casterLevel]...)
+	return slots
+}
+
+func pactSlots(level int) (int, int) {
+	switch {
+	case level <= 0:
+		return 0, 0
+	case level == 1:
+		return 1, 1
+	case level == 2:
+		return 2, 1
+	case level <= 10:
+		return 2, (level + 1) / 2
+	case level <= 16:
+		return 3, 5
+	}
+	return 4, 5
+}
+
+// MaxSpellLevel is the highest spell level a class can cast at the given class
+// level. Warlock mystic arcanum follows the full caster progression.
+func MaxSpellLevel(class domain.Class, level int) int {
+	switch rulesFor(class).caster {
+	case fullCaster, pactCaster:
+		return min((level+1)/2, 9)
+	case halfCaster:
+		if level < 2 {
+			return 0
+		}
+		return min((level+3)/4, 5)
+	}
+	return 0
+}
+
+// SpellLimit returns how many leveled spells a class allows at the given class
+// level and whether they are prepared (true) or known (false). Classes without
+// a rule for it, homebrew included, have no limit and return ok false.
+func SpellLimit(character domain.CharacterData, class domain.Class, level int) (limit int, prepares bool, ok bool) {
+	rules := rulesFor(class)
+	if level < 1 {
+		return 0, false, false
+	}
+	if rules.knownSpells != nil {
+		return rules.knownSpells[min(level, 20)-1], false, true
+	}
+	if rules.preparedAbility != "" {
+		if rules.caster == halfCaster {
+			level = level / 2
+		}
+		return max(stats.Modifier(score(character, rules.preparedAbility))+level, 1), true, true
+	}
+	return 0, false, false
+}
+
+// CantripLimit returns how many cantrips a class knows at the given class
+// level. Classes without cantrips in their rules, homebrew included, have no
+// limit and return ok false.
+func CantripLimit(class domain.Class, level int) (limit int, ok bool) {
+	rules := rulesFor(class)
+	if rules.cantrips == 0 || level < 1 {
+		return 0, false
+	}
+	limit = rules.cantrips
+	if level >= 4 {
+		limit++
+	}
+	if level >= 10 {
+		limit++
+	}
+	return limit, true
+}
@@ -0,0 +1,36 @@
+package characterXclass
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+type service struct {
+	repository RepositoryCharacterXClass
+}
+
+func NewCharacterXClassService(repository RepositoryCharacterXClass) ServiceCharacterXClass {
+	return &service{repository: repository}
+}
+
+// Create implements ServiceCharacterXClass.
+func (s *service) Create(characterClass domain.CharacterClass) (domain.CharacterClass, error) {
+	return s.repository.Create(characterClass)
+}
+
+// GetByCharacterId implements ServiceCharacterXClass.
+func (s *service) GetByCharacterId(characterId int) ([]domain.CharacterClass, error) {
+	return s.repository.GetByCharacterId(characterId)
+}
+
+// UpdateLevel implements ServiceCharacterXClass.
+func (s *service) UpdateLevel(characterId int, classId int, level int) error {
+	return s.repository.UpdateLevel(characterId, classId, level)
+}
+
+// Delete implements ServiceCharacterXClass.
+func (s *service) Delete(characterId int, classId int) error {
+	return s.repository.Delete(characterId, classId)
+}
+
+// DeleteByCharacterDataId implements ServiceCharacterXClass.
+func (s *service) DeleteByCharacterDataId(characterId int) error {
+	return s.repository.DeleteByCharacterDataId(characterId)
+}
@@ -0,0 +1,11 @@
+package characterXclass
+
+var (
+	QueryInsert           = `INSERT INTO character_class (character_id, class_id, level) VALUES (?, ?, ?);`
+	QueryGetByCharacterId = `SELECT character_class.character_class_id, character_class.character_id, character_class.level,
+	class.class_id, class.name, class.description, class.proficiency_bonus, class.hit_dice, class.armor_proficiencies, class.weapon_proficiencies, class.tool_proficiencies, class.spellcasting_ability
+	FROM character_class INNER JOIN class ON character_class.class_id = class.class_id WHERE character_class.character_id = ? ORDER BY character_class.character_class_id;`
+	QueryUpdateLevel         = `UPDATE character_class SET level = ? WHERE character_id = ? AND class_id = ?;`
+	QueryDelete              = `DELETE FROM character_class WHERE character_id = ? AND class_id = ?;`
+	QueryDeleteByCharacterId = `DELETE FROM character_class WHERE character_id = ?;`
+)
@@ -4,12 +4,16 @@ import "github.com/proyecto-dnd/backend/internal/domain"
 
 type CharacterXProficiencyRepository interface {
 	Create(characterXProficiency domain.CharacterXProficiency) (domain.CharacterXProficiency, error)
+	GetById(characterXProficiencyId int) (domain.CharacterXProficiency, error)
 	Delete(characterXProficiencyId int) error
+	DeleteParams(characterId int, proficiencyId int) error
 	DeleteByCharacterDataId(id int) error
 }
 
 type CharacterXProficiencyService interface {
 	Create(characterXProficiency domain.CharacterXProficiency) (domain.CharacterXProficiency, error)
+	GetById(characterXProficiencyId int) (domain.CharacterXProficiency, error)
 	Delete(characterXProficiencyId int) error
+	DeleteParams(characterId int, proficiencyId int) error
 	DeleteByCharacterDataId(id int) error
 }
@@ -10,6 +10,7 @@ import (
 var (
 	ErrPrepareStatement = errors.New("error preparing statement")
 	ErrLastIndex        = errors.New("error getting last index")
+	ErrNotFound         = errors.New("character proficiency not found")
 )
 
 type characterXProficiencyRepository struct {
@@ -74,3 +75,32 @@ func (r *characterXProficiencyRepository) Delete(characterXProficiencyId int) er
 
 	return nil
 }
+
+func (r *characterXProficiencyRepository) GetById(characterXProficiencyId int) (domain.CharacterXProficiency, error) {
+	var characterXProficiency domain.CharacterXProficiency
+	err := r.db.QueryRow(QueryGetById, characterXProficiencyId).Scan(
+		&characterXProficiency.CharacterProficiencyId,
+		&characterXProficiency.CharacterId,
+		&characterXProficiency.ProficiencyId,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.CharacterXProficiency{}, ErrNotFound
+		}
+		return domain.CharacterXProficiency{}, err
+	}
+	return characterXProficiency, nil
+}
+
+func (r *characterXProficiencyRepository) DeleteParams(characterId int, proficiencyId int) error {
+	statement, err := r.db.Prepare(QueryDeleteParams)
+	if err != nil {
+		return ErrPrepareStatement
+	}
+	defer statement.Close()
+	_, err = statement.Exec(characterId, proficiencyId)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -28,3 +28,11 @@ func (s *service) Create(characterXProficiency domain.CharacterXProficiency) (do
 func (s *service) Delete(characterXProficiencyId int) error {
 	return s.repository.Delete(characterXProficiencyId)
 }
+
+func (s *service) GetById(characterXProficiencyId int) (domain.CharacterXProficiency, error) {
+	return s.repository.GetById(characterXProficiencyId)
+}
+
+func (s *service) DeleteParams(characterId int, proficiencyId int) error {
+	return s.repository.DeleteParams(characterId, proficiencyId)
+}
@@ -2,7 +2,9 @@ package characterXproficiency
 
 var (
 	QueryInsert = "INSERT INTO character_proficiency (character_id, proficiency_id) values(?,?);"
+	QueryGetById = "SELECT character_proficiency_id, character_id, proficiency_id FROM character_proficiency WHERE character_proficiency_id=?;"
 	QueryDelete = "DELETE FROM character_proficiency WHERE character_proficiency_id=?;"
+	QueryDeleteParams = "DELETE FROM character_proficiency WHERE character_id=? AND proficiency_id=?;"
 	QueryDeleteByCharacterId = "DELETE FROM character_proficiency WHERE character_id =?;"
 )
 
@@ -4,6 +4,9 @@ import "github.com/proyecto-dnd/backend/internal/domain"
 
 type RepositoryCharacterXSpell interface {
 	Create(characterXSpell domain.CharacterXSpell) (domain.CharacterXSpell, error)
+	GetById(id int) (domain.CharacterXSpell, error)
+	GetByCharacterId(characterId int) ([]domain.CharacterXSpell, error)
+	UpdatePreparation(id int, preparation string) error
 	Delete(id int) error
 	DeleteParams(characterId int, spellId int) error
 	DeleteByCharacterDataId(id int) error
@@ -11,6 +14,9 @@ type RepositoryCharacterXSpell interface {
 
 type ServiceCharacterXSpell interface {
 	Create(characterXSpell domain.CharacterXSpell) (domain.CharacterXSpell, error)
+	GetById(id int) (domain.CharacterXSpell, error)
+	GetByCharacterId(characterId int) ([]domain.CharacterXSpell, error)
+	UpdatePreparation(id int, preparation string) error
 	Delete(id int) error
 	DeleteParams(characterId int, spellId int) error
 	DeleteByCharacterDataId(id int) error
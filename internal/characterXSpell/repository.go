@@ -47,6 +47,8 @@ func (r *CharacterXSpellRepository) Create(characterXSpell domain.CharacterXSpel
 	result, err := statement.Exec(
 		characterXSpell.CharacterId,
 		characterXSpell.SpellId,
+		characterXSpell.Preparation,
+		characterXSpell.ClassId,
 	)
 	if err != nil {
 		return domain.CharacterXSpell{}, err
@@ -60,6 +62,8 @@ func (r *CharacterXSpellRepository) Create(characterXSpell domain.CharacterXSpel
 		CharacterSpellId: int(lastId),
 		CharacterId:      characterXSpell.CharacterId,
 		SpellId:          characterXSpell.SpellId,
+		Preparation:      characterXSpell.Preparation,
+		ClassId:          characterXSpell.ClassId,
 	}
 	fmt.Println(createdCharacterXSpell)
 	return createdCharacterXSpell, nil
@@ -91,3 +95,63 @@ func (r *CharacterXSpellRepository) DeleteParams(characterId int, spellId int) e
 	}
 	return nil
 }
+
+func (r *CharacterXSpellRepository) GetById(id int) (domain.CharacterXSpell, error) {
+	var characterXSpell domain.CharacterXSpell
+	err := r.db.QueryRow(QueryGetById, id).Scan(
+		&characterXSpell.CharacterSpellId,
+		&characterXSpell.CharacterId,
+		&characterXSpell.SpellId,
+		&characterXSpell.Preparation,
+		&characterXSpell.ClassId,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.CharacterXSpell{}, ErrNotFound
+		}
+		return domain.CharacterXSpell{}, err
+	}
+	return characterXSpell, nil
+}
+
+func (r *CharacterXSpellRepository) GetByCharacterId(characterId int) ([]domain.CharacterXSpell, error) {
+	rows, err := r.db.Query(QueryGetByCharacterId, characterId)
+	if err != nil {
+		return []domain.CharacterXSpell{}, err
+	}
+	defer rows.Close()
+
+	characterSpells := []domain.CharacterXSpell{}
+	for rows.Next() {
+		var characterXSpell domain.CharacterXSpell
+		if err := rows.Scan(
+			&characterXSpell.CharacterSpellId,
+			&characterXSpell.CharacterId,
+			&characterXSpell.SpellId,
+			&characterXSpell.Preparation,
+			&characterXSpell.ClassId,
+		); err != nil {
+			return []domain.CharacterXSpell{}, err
+		}
+		characterSpells = append(characterSpells, characterXSpell)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.CharacterXSpell{}, err
+	}
+	return characterSpells, nil
+}
+
+func (r *CharacterXSpellRepository) UpdatePreparation(id int, preparation string) error {
+	result, err := r.db.Exec(QueryUpdatePreparation, preparation, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -1,9 +1,21 @@
 package characterXspell
 
 import (
+	"errors"
+
 	"github.com/proyecto-dnd/backend/internal/domain"
 )
 
+// A known spell is in the character's repertoire or spellbook, a prepared one
+// can be cast and an always prepared one does not count against the limit.
+const (
+	PreparationKnown    = "known"
+	PreparationPrepared = "prepared"
+	PreparationAlways   = "always"
+)
+
+var ErrInvalidPreparation = errors.New("preparation must be known, prepared or always")
+
 type service struct {
 	characterXSpellRepository RepositoryCharacterXSpell
 }
@@ -19,6 +31,12 @@ func (s *service) DeleteByCharacterDataId(id int) error {
 
 
 func (s *service) Create(characterXSpell domain.CharacterXSpell) (domain.CharacterXSpell, error) {
+	if characterXSpell.Preparation == "" {
+		characterXSpell.Preparation = PreparationPrepared
+	}
+	if !ValidPreparation(characterXSpell.Preparation) {
+		return domain.CharacterXSpell{}, ErrInvalidPreparation
+	}
 	return s.characterXSpellRepository.Create(characterXSpell)
 }
 
@@ -29,3 +47,22 @@ func (s *service) Delete(id int) error {
 func (s *service) DeleteParams(characterId int, spellId int) error {
 	return s.characterXSpellRepository.DeleteParams(characterId, spellId)
 }
+
+func (s *service) GetById(id int) (domain.CharacterXSpell, error) {
+	return s.characterXSpellRepository.GetById(id)
+}
+
+func (s *service) GetByCharacterId(characterId int) ([]domain.CharacterXSpell, error) {
+	return s.characterXSpellRepository.GetByCharacterId(characterId)
+}
+
+func (s *service) UpdatePreparation(id int, preparation string) error {
+	if !ValidPreparation(preparation) {
+		return ErrInvalidPreparation
+	}
+	return s.characterXSpellRepository.UpdatePreparation(id, preparation)
+}
+
+func ValidPreparation(preparation string) bool {
+	return preparation == PreparationKnown || preparation == PreparationPrepared || preparation == PreparationAlways
+}
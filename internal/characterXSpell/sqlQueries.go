@@ -1,8 +1,11 @@
 package characterXspell
 
 var (
-	QueryInsert       = `INSERT INTO character_spell (character_id, spell_id) values(?,?);`
-	QueryDelete       = `DELETE FROM character_spell WHERE character_spell_id=?;`
-	QueryDeleteParams = `DELETE FROM character_spell WHERE character_id=? AND spell_id=?;`
+	QueryInsert              = `INSERT INTO character_spell (character_id, spell_id, preparation, class_id) values(?,?,?,?);`
+	QueryGetById             = `SELECT character_spell_id, character_id, spell_id, preparation, class_id FROM character_spell WHERE character_spell_id=?;`
+	QueryGetByCharacterId    = `SELECT character_spell_id, character_id, spell_id, preparation, class_id FROM character_spell WHERE character_id=?;`
+	QueryUpdatePreparation   = `UPDATE character_spell SET preparation=? WHERE character_spell_id=?;`
+	QueryDelete              = `DELETE FROM character_spell WHERE character_spell_id=?;`
+	QueryDeleteParams        = `DELETE FROM character_spell WHERE character_id=? AND spell_id=?;`
 	QueryDeleteByCharacterId = `DELETE FROM character_spell WHERE character_id=?;`
 )
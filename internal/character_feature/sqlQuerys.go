@@ -2,7 +2,7 @@ package character_feature
 
 var (
 	QueryCreateCharacterFeature = `
-		INSERT INTO character_feature (feature_id, character_id)
+		INSERT INTO character_feature (character_id, feature_id)
 		VALUES (?, ?)
 	`
 
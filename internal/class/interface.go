@@ -1,6 +1,7 @@
 package class
 
 import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -11,6 +12,7 @@ type RepositoryCharacterClass interface {
 	GetById(id int)(domain.Class, error)
 	Update(classDto dto.ClassDto,id int)(domain.Class, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Class], error)
 }
 
 type ClassService interface {
@@ -19,4 +21,5 @@ type ClassService interface {
 	GetById(id int)(domain.Class, error)
 	Update(classDto dto.ClassDto,id int)(domain.Class, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Class], error)
 }
\ No newline at end of file
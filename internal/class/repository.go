@@ -4,8 +4,10 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -36,6 +38,7 @@ func (r *repositoryMysqlRepository) Create(classDto dto.ClassDto) (domain.Class,
 		classDto.WeaponProficiencies,
 		classDto.ToolProficiencies,
 		classDto.SpellcastingAbility,
+		classDto.CampaignId,
 	)
 	if err != nil {
 		return domain.Class{}, err
@@ -48,6 +51,7 @@ func (r *repositoryMysqlRepository) Create(classDto dto.ClassDto) (domain.Class,
 
 	var createdClass domain.Class
 	createdClass.ClassId = int(lastId)
+	createdClass.Name = classDto.Name
 	createdClass.Description = classDto.Description
 	createdClass.ArmorProficiencies = classDto.ArmorProficiencies
 	createdClass.HitDice = classDto.HitDice
@@ -55,6 +59,7 @@ func (r *repositoryMysqlRepository) Create(classDto dto.ClassDto) (domain.Class,
 	createdClass.ProficiencyBonus = classDto.ProficiencyBonus
 	createdClass.WeaponProficiencies = classDto.WeaponProficiencies
 	createdClass.SpellcastingAbility = classDto.SpellcastingAbility
+	createdClass.CampaignId = classDto.CampaignId
 
 	return createdClass, nil
 }
@@ -70,7 +75,7 @@ func (r *repositoryMysqlRepository) GetAll() ([]domain.Class, error) {
 
 	for rows.Next() {
 		var class domain.Class
-		if err := rows.Scan(&class.ClassId, &class.Name, &class.Description, &class.ProficiencyBonus, &class.HitDice, &class.ArmorProficiencies, &class.WeaponProficiencies, &class.ToolProficiencies, &class.SpellcastingAbility); err != nil {
+		if err := rows.Scan(&class.ClassId, &class.Name, &class.Description, &class.ProficiencyBonus, &class.HitDice, &class.ArmorProficiencies, &class.WeaponProficiencies, &class.ToolProficiencies, &class.SpellcastingAbility, &class.CampaignId); err != nil {
 			return nil, err
 		}
 		classes = append(classes, class)
@@ -80,7 +85,7 @@ func (r *repositoryMysqlRepository) GetAll() ([]domain.Class, error) {
 
 func (r *repositoryMysqlRepository) GetById(id int) (domain.Class, error) {
 	var class domain.Class
-	if err := r.db.QueryRow(QueryGetById, id).Scan(&class.ClassId, &class.Name, &class.Description, &class.ProficiencyBonus, &class.HitDice, &class.ArmorProficiencies, &class.WeaponProficiencies, &class.ToolProficiencies, &class.SpellcastingAbility); err != nil {
+	if err := r.db.QueryRow(QueryGetById, id).Scan(&class.ClassId, &class.Name, &class.Description, &class.ProficiencyBonus, &class.HitDice, &class.ArmorProficiencies, &class.WeaponProficiencies, &class.ToolProficiencies, &class.SpellcastingAbility, &class.CampaignId); err != nil {
 		return domain.Class{}, err
 	}
 	return class, nil
@@ -93,13 +98,14 @@ func (r *repositoryMysqlRepository) Update(classDto dto.ClassDto, id int) (domai
 	}
 	defer statement.Close()
 
-	_, err = statement.Exec(classDto.Name, classDto.Description, classDto.ProficiencyBonus, classDto.HitDice, classDto.ArmorProficiencies, classDto.WeaponProficiencies, classDto.ToolProficiencies, classDto.SpellcastingAbility, id)
+	_, err = statement.Exec(classDto.Name, classDto.Description, classDto.ProficiencyBonus, classDto.HitDice, classDto.ArmorProficiencies, classDto.WeaponProficiencies, classDto.ToolProficiencies, classDto.SpellcastingAbility, classDto.CampaignId, id)
 	if err != nil {
-		return domain.Class{}, nil
+		return domain.Class{}, err
 	}
 
 	var updatedClass domain.Class
 	updatedClass.ClassId = id
+	updatedClass.Name = classDto.Name
 	updatedClass.Description = classDto.Description
 	updatedClass.ArmorProficiencies = classDto.ArmorProficiencies
 	updatedClass.HitDice = classDto.HitDice
@@ -107,22 +113,51 @@ func (r *repositoryMysqlRepository) Update(classDto dto.ClassDto, id int) (domai
 	updatedClass.ProficiencyBonus = classDto.ProficiencyBonus
 	updatedClass.WeaponProficiencies = classDto.WeaponProficiencies
 	updatedClass.SpellcastingAbility = classDto.SpellcastingAbility
+	updatedClass.CampaignId = classDto.CampaignId
 
 	return updatedClass, nil
 }
 
+// Delete implements RepositoryCharacterClass. The translations of the class
+// go with it.
 func (r *repositoryMysqlRepository) Delete(id int) error {
-	statement, err := r.db.Prepare(QueryDeleteClass)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return ErrPrepareStatement
+		return err
 	}
+	defer tx.Rollback()
 
-	defer statement.Close()
+	_, err = tx.Exec(QueryDeleteClass, id)
+	if err != nil {
+		return err
+	}
 
-	_, err = statement.Exec(id)
+	_, err = tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Commit()
+}
+
+// Search implements RepositoryCharacterClass.
+func (r *repositoryMysqlRepository) Search(params catalog.Params) (catalog.Page[domain.Class], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanClass)
+}
+
+func scanClass(row catalog.Scannable) (domain.Class, error) {
+	var class domain.Class
+	err := row.Scan(
+		&class.ClassId,
+		&class.Name,
+		&class.Description,
+		&class.ProficiencyBonus,
+		&class.HitDice,
+		&class.ArmorProficiencies,
+		&class.WeaponProficiencies,
+		&class.ToolProficiencies,
+		&class.SpellcastingAbility,
+		&class.CampaignId,
+	)
+	return class, err
 }
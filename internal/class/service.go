@@ -1,6 +1,7 @@
 package class
 
 import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -50,3 +51,8 @@ func (s *service) Update(classDto dto.ClassDto, id int) (domain.Class, error) {
 func (s *service) Delete(id int) error {
 	return s.classRepository.Delete(id)
 }
+
+// Search implements ClassService.
+func (s *service) Search(params catalog.Params) (catalog.Page[domain.Class], error) {
+	return s.classRepository.Search(params)
+}
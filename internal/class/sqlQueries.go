@@ -1,9 +1,21 @@
 package class
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
-	QueryInsertClass = `INSERT INTO class (name, description, proficiency_bonus, hit_dice, armor_proficiencies, weapon_proficiencies, tool_proficiencies, spellcasting_ability) VALUES(?,?,?,?,?,?,?,?);`
+	QueryInsertClass = `INSERT INTO class (name, description, proficiency_bonus, hit_dice, armor_proficiencies, weapon_proficiencies, tool_proficiencies, spellcasting_ability, campaign_id) VALUES(?,?,?,?,?,?,?,?,?);`
 	QueryGetAll      = `SELECT * from class;`
 	QueryGetById     = `SELECT * FROM class WHERE class_id = ?;`
-	QueryUpdateClass = `UPDATE class SET name = ?, description = ?, proficiency_bonus = ?, hit_dice = ?, armor_proficiencies = ?, weapon_proficiencies = ?, tool_proficiencies = ?, spellcasting_ability = ? WHERE class_id = ?;`
+	QueryUpdateClass = `UPDATE class SET name = ?, description = ?, proficiency_bonus = ?, hit_dice = ?, armor_proficiencies = ?, weapon_proficiencies = ?, tool_proficiencies = ?, spellcasting_ability = ?, campaign_id = ? WHERE class_id = ?;`
 	QueryDeleteClass = `DELETE FROM class WHERE class_id = ?;`
 )
+
+// CatalogTable describes how GET /class searches and sorts classes.
+var CatalogTable = catalog.Table{
+	Name:           "class",
+	Id:             "class_id",
+	Search:         []string{"name", "description"},
+	Sorts:          map[string]string{"name": "name"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
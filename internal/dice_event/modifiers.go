@@ -0,0 +1,48 @@
+package dice_event
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+// StatModifiers works out the modifier a character adds to a roll of a stat.
+// Stats it does not know report false.
+type StatModifiers interface {
+	StatModifier(characterId int, stat string) (int, bool, error)
+}
+
+type modifiedService struct {
+	DiceEventService
+	modifiers StatModifiers
+}
+
+// WithStatModifiers fills in the modifier of the dice events created or
+// updated through service when the client leaves it empty.
+func WithStatModifiers(service DiceEventService, modifiers StatModifiers) DiceEventService {
+	return &modifiedService{DiceEventService: service, modifiers: modifiers}
+}
+
+// Create implements DiceEventService.
+func (s *modifiedService) Create(diceEvent domain.DiceEvent) (domain.DiceEvent, error) {
+	if err := s.fillModifier(&diceEvent); err != nil {
+		return domain.DiceEvent{}, err
+	}
+	return s.DiceEventService.Create(diceEvent)
+}
+
+// Update implements DiceEventService.
+func (s *modifiedService) Update(diceEvent domain.DiceEvent, id int) (domain.DiceEvent, error) {
+	if err := s.fillModifier(&diceEvent); err != nil {
+		return domain.DiceEvent{}, err
+	}
+	return s.DiceEventService.Update(diceEvent, id)
+}
+
+func (s *modifiedService) fillModifier(diceEvent *domain.DiceEvent) error {
+	if diceEvent.Modifier != nil {
+		return nil
+	}
+	modifier, ok, err := s.modifiers.StatModifier(diceEvent.EventProtagonist, diceEvent.Stat)
+	if err != nil || !ok {
+		return err
+	}
+	diceEvent.Modifier = &modifier
+	return nil
+}
@@ -54,6 +54,7 @@ func (r *repository) GetBySessionId(sessionid int) ([]domain.DiceEvent, error) {
 			&diceEvent.Description,
 			&diceEvent.SessionId,
 			&diceEvent.TimeStamp,
+			&diceEvent.Modifier,
 		); err != nil {
 			return nil, err
 		}
@@ -74,7 +75,6 @@ func (r *repository) Create(diceEvent domain.DiceEvent) (domain.DiceEvent, error
 	defer statement.Close()
 
 	result, err := statement.Exec(
-		diceEvent.DiceEventId,
 		diceEvent.Stat,
 		diceEvent.Difficulty,
 		diceEvent.DiceRolled,
@@ -83,6 +83,7 @@ func (r *repository) Create(diceEvent domain.DiceEvent) (domain.DiceEvent, error
 		diceEvent.Description,
 		diceEvent.SessionId,
 		diceEvent.TimeStamp,
+		diceEvent.Modifier,
 	)
 	if err != nil {
 		return domain.DiceEvent{}, err
@@ -93,7 +94,7 @@ func (r *repository) Create(diceEvent domain.DiceEvent) (domain.DiceEvent, error
 		return domain.DiceEvent{}, ErrLastInsertId
 	}
 	diceEvent.DiceEventId = int(lastId)
-	return domain.DiceEvent{}, nil
+	return diceEvent, nil
 }
 
 func (r *repository) GetAll() ([]domain.DiceEvent, error) {
@@ -116,6 +117,7 @@ func (r *repository) GetAll() ([]domain.DiceEvent, error) {
 			&diceEvent.Description,
 			&diceEvent.SessionId,
 			&diceEvent.TimeStamp,
+			&diceEvent.Modifier,
 		); err != nil {
 			return nil, err
 		}
@@ -141,6 +143,7 @@ func (r *repository) GetById(id int) (domain.DiceEvent, error) {
 		&diceEvent.Description,
 		&diceEvent.SessionId,
 		&diceEvent.TimeStamp,
+		&diceEvent.Modifier,
 	)
 	if err != nil {
 		return domain.DiceEvent{}, err
@@ -163,6 +166,7 @@ func (r *repository) Update(diceEvent domain.DiceEvent, id int) (domain.DiceEven
 		diceEvent.EventProtagonist,
 		diceEvent.Description,
 		diceEvent.SessionId,
+		diceEvent.Modifier,
 		id,
 	)
 	if err != nil {
@@ -14,4 +14,8 @@ type Armor struct {
 	ArmorClass     int    `json:"armor_class"`
 	DexBonus       string `json:"dex_bonus"`
 	CampaignId     *int   `json:"campaign_id"`
+	// MaxDexBonus caps the dexterity modifier added to the armor class; nil
+	// means no cap.
+	MaxDexBonus        *int `json:"max_dex_bonus"`
+	RequiresAttunement bool `json:"requires_attunement"`
 }
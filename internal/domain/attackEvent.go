@@ -16,4 +16,7 @@ type AttackEvent struct {
 	DmgType            *string    `json:"dmg_type"`
 	Description        *string    `json:"description"`
 	TimeStamp          *time.Time `json:"time_stamp"`
+	// SlotLevel is the spell slot a spell attack was cast with. It is not
+	// stored; 0 casts the spell with the lowest slot that can hold it.
+	SlotLevel int `json:"slot_level,omitempty"`
 }
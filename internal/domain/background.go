@@ -10,4 +10,7 @@ type Background struct {
 	Flaws             string `json:"flaws"`
 	Trait             string `json:"trait"`
 	ToolProficiencies string `json:"tool_proficiencies"`
+	CampaignId        *int   `json:"campaign_id"`
+	// FeatureId is the background feature, set through its own endpoint.
+	FeatureId *int `json:"feature_id"`
 }
@@ -0,0 +1,13 @@
+package domain
+
+// BackgroundEquipment is starting equipment a background gives new
+// characters. Kind is "item", "weapon" or "armor" and tells which catalog
+// EquipmentId points to.
+type BackgroundEquipment struct {
+	BackgroundEquipmentId int    `json:"background_equipment_id"`
+	BackgroundId          int    `json:"background_id"`
+	Kind                  string `json:"kind"`
+	EquipmentId           int    `json:"equipment_id"`
+	Quantity              int    `json:"quantity"`
+	Name                  string `json:"name"`
+}
@@ -0,0 +1,8 @@
+package domain
+
+// CampaignRules holds the optional rules a dungeon master enables for a campaign.
+type CampaignRules struct {
+	CampaignId         int  `json:"campaign_id"`
+	FreeSpellSelection bool `json:"free_spell_selection"`
+	VariantEncumbrance bool `json:"variant_encumbrance"`
+}
@@ -0,0 +1,23 @@
+package domain
+
+type CharacterClass struct {
+	CharacterClassId int       `json:"character_class_id"`
+	CharacterId      int       `json:"character_id"`
+	Class            Class     `json:"class"`
+	Subclass         *Subclass `json:"subclass"`
+	Level            int       `json:"level"`
+}
+
+// HitDicePool groups a character's hit dice by die size.
+type HitDicePool struct {
+	Die   string `json:"die"`
+	Total int    `json:"total"`
+}
+
+// SpellSlots holds the slots available per spell level, index 0 being level 1.
+// Warlock pact magic is tracked apart since it recovers on short rests.
+type SpellSlots struct {
+	Slots         []int `json:"slots"`
+	PactSlots     int   `json:"pact_slots"`
+	PactSlotLevel int   `json:"pact_slot_level"`
+}
@@ -1,10 +1,14 @@
 package domain
 
+// CharacterData is the sheet of a character. Its ability scores and speed are
+// final: they already include the bonuses of the race and subrace, which are
+// not added again.
 type CharacterData struct {
 	Character_Id int        `json:"character_id"`
 	User_Id      *string    `json:"user_id"`
 	Campaign_Id  int        `json:"campaign_id"`
 	Race         Race       `json:"race"`
+	Subrace      *Subrace   `json:"subrace"`
 	Class        Class      `json:"class"`
 	Background   Background `json:"background"`
 	Name         string     `json:"name"`
@@ -29,4 +33,6 @@ type CharacterData struct {
 	Armor_Class  int        `json:"armor_class"`
 	Level        int        `json:"level"`
 	Exp          int        `json:"exp"`
+	// Classes is only read on creation to build a multiclass character.
+	Classes []CharacterClass `json:"classes,omitempty"`
 }
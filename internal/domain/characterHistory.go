@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type CharacterHistory struct {
+	CharacterHistoryId int       `json:"character_history_id"`
+	CharacterId        int       `json:"character_id"`
+	Version            int       `json:"version"`
+	AuthorId           *string   `json:"author_id"`
+	Change             string    `json:"change"`
+	Snapshot           []byte    `json:"-"`
+	CreatedAt          time.Time `json:"created_at"`
+}
@@ -0,0 +1,14 @@
+package domain
+
+// CharacterResource is a limited-use resource of a character, either granted
+// by one of its features or added by hand as a custom counter. Used counts the
+// uses spent since the resource last recharged.
+type CharacterResource struct {
+	CharacterResourceId int    `json:"character_resource_id"`
+	CharacterId         int    `json:"character_id"`
+	FeatureId           *int   `json:"feature_id"`
+	Name                string `json:"name"`
+	Maximum             string `json:"maximum"`
+	Recharge            string `json:"recharge"`
+	Used                int    `json:"used"`
+}
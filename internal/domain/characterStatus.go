@@ -0,0 +1,26 @@
+package domain
+
+// CharacterSpellSlot counts the slots of one level a character has expended
+// since its last rest. Pact slots are tracked apart from regular slots.
+type CharacterSpellSlot struct {
+	CharacterId int  `json:"character_id"`
+	SlotLevel   int  `json:"slot_level"`
+	Pact        bool `json:"pact"`
+	Expended    int  `json:"expended"`
+}
+
+// CharacterHitDice counts the hit dice of one die size a character has spent.
+type CharacterHitDice struct {
+	CharacterId int    `json:"character_id"`
+	Die         string `json:"die"`
+	Spent       int    `json:"spent"`
+}
+
+// CharacterDeathSaves tracks a character at 0 hitpoints: whether it is dying,
+// stable or dead, and the death saving throws rolled since it went down.
+type CharacterDeathSaves struct {
+	CharacterId int    `json:"character_id"`
+	State       string `json:"state"`
+	Successes   int    `json:"successes"`
+	Failures    int    `json:"failures"`
+}
@@ -1,7 +1,11 @@
 package domain
 
 type CharacterXSpell struct {
-	CharacterSpellId int `json:"character_spell_id"`
-	CharacterId      int `json:"character_id"`
-	SpellId          int `json:"spell_id"`
+	CharacterSpellId int    `json:"character_spell_id"`
+	CharacterId      int    `json:"character_id"`
+	SpellId          int    `json:"spell_id"`
+	Preparation      string `json:"preparation"`
+	// ClassId is the class the spell was learned through, whose limit it
+	// counts against.
+	ClassId *int `json:"class_id"`
 }
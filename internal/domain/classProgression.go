@@ -0,0 +1,22 @@
+package domain
+
+// ClassProgression is what a class, or one of its subclasses when SubclassId
+// is set, grants on reaching Level. SpellSlots is the slot row of single class
+// characters at that level, index 0 being level 1; when empty the class rules
+// decide. Resources override the maximum of the resources named.
+type ClassProgression struct {
+	ClassProgressionId int                   `json:"class_progression_id"`
+	ClassId            int                   `json:"class_id"`
+	SubclassId         *int                  `json:"subclass_id"`
+	Level              int                   `json:"level"`
+	FeatureIds         []int                 `json:"feature_ids"`
+	SpellSlots         []int                 `json:"spell_slots"`
+	Resources          []ProgressionResource `json:"resources"`
+}
+
+// ProgressionResource sets the maximum, a formula as in FeatureResource, of
+// the resource with the same name.
+type ProgressionResource struct {
+	Name    string `json:"name"`
+	Maximum string `json:"maximum"`
+}
@@ -12,4 +12,5 @@ type DiceEvent struct {
 	Description      string    `json:"description"`
 	SessionId        int       `json:"session_id"`
 	TimeStamp        time.Time `json:"time_stamp"`
+	Modifier         *int      `json:"modifier"`
 }
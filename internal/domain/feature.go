@@ -1,7 +1,18 @@
 package domain
 
 type Feature struct {
-	FeatureId   int    `json:"feature_id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	FeatureId   int               `json:"feature_id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	CampaignId  *int              `json:"campaign_id"`
+	Resources   []FeatureResource `json:"resources"`
+}
+
+// FeatureResource declares a limited-use resource a feature grants, such as
+// rage uses or ki points. Maximum is a formula evaluated per character and
+// Recharge says when spent uses come back.
+type FeatureResource struct {
+	Name     string `json:"name"`
+	Maximum  string `json:"maximum"`
+	Recharge string `json:"recharge"`
 }
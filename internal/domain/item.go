@@ -7,4 +7,25 @@ type Item struct{
 	Price int `json:"price"`
 	Description string `json:"description"`
 	Campaign_Id *int `json:"campaign_id"`
-}
\ No newline at end of file
+	// Magic item properties; mundane items leave them empty. Rarity is one of
+	// common, uncommon, rare, very rare, legendary or artifact.
+	Rarity             string `json:"rarity"`
+	RequiresAttunement bool   `json:"requires_attunement"`
+	// AttunementClasses and AttunementAlignments restrict who can attune to the
+	// item, as comma separated class names and alignment words such as "good"
+	// or "lawful". Empty lists allow anyone.
+	AttunementClasses    string `json:"attunement_classes"`
+	AttunementAlignments string `json:"attunement_alignments"`
+	// Charges is the most the item holds. It recharges on the resource rules
+	// (short, long, dawn or manual), regaining RechargeDice charges, or all of
+	// them when RechargeDice is empty.
+	Charges      int    `json:"charges"`
+	Recharge     string `json:"recharge"`
+	RechargeDice string `json:"recharge_dice"`
+	// Bonuses count while the item is attuned, or while it is owned when it
+	// needs no attunement.
+	ArmorClassBonus  int `json:"armor_class_bonus"`
+	SavingThrowBonus int `json:"saving_throw_bonus"`
+	AttackBonus      int `json:"attack_bonus"`
+	DamageBonus      int `json:"damage_bonus"`
+}
@@ -5,4 +5,6 @@ type ItemXCharacterData struct {
 	CharacterData_Id int `json:"character_data_id"`
 	Item Item `json:"item"`
 	Quantity int `json:"quantity"`
+	Attuned bool `json:"attuned"`
+	ChargesUsed int `json:"charges_used"`
 }
\ No newline at end of file
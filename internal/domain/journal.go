@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// JournalEntry is a note a player keeps about what their character learned,
+// optionally tied to the session it happened in. Content is markdown and
+// Attachments holds the URLs of uploaded images.
+type JournalEntry struct {
+	JournalEntryId int       `json:"journal_entry_id"`
+	CharacterId    int       `json:"character_id"`
+	SessionId      *int      `json:"session_id"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	Visibility     string    `json:"visibility"`
+	Attachments    []string  `json:"attachments"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
@@ -11,4 +11,5 @@ type Race struct {
 	Con         int    `json:"con"`
 	Wiz         int    `json:"wiz"`
 	Cha         int    `json:"cha"`
+	CampaignId  *int   `json:"campaign_id"`
 }
@@ -1,7 +1,7 @@
 package domain
 
 type SavingThrow struct {
-	SavingThrowId int  `json:"saving_thow_id"`
+	SavingThrowId int  `json:"saving_throw_id"`
 	ClassId       int  `json:"class_id"`
 	Str           bool `json:"str"`
 	Dex           bool `json:"dex"`
@@ -14,4 +14,5 @@ type Spell struct {
 	DifficultyClass int    `json:"difficulty_class"`
 	Aoe             int    `json:"aoe"`
 	School          string `json:"school"`
+	CampaignId      *int   `json:"campaign_id"`
 }
@@ -0,0 +1,11 @@
+package domain
+
+// Subclass is an archetype of a class, such as a fighter's Champion. Level is
+// the class level at which characters choose it.
+type Subclass struct {
+	SubclassId  int    `json:"subclass_id"`
+	ClassId     int    `json:"class_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Level       int    `json:"level"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+// Subrace refines a race, such as a High Elf of the elves. Its ability bonuses
+// and speed add to those of the race when the scores of a character are
+// chosen; the scores stored on the character already include them. Characters
+// of the subrace gain its proficiencies and its racial traits, which are
+// features.
+type Subrace struct {
+	SubraceId      int    `json:"subrace_id"`
+	RaceId         int    `json:"race_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Speed          int    `json:"speed"`
+	Str            int    `json:"str"`
+	Dex            int    `json:"dex"`
+	Int            int    `json:"int"`
+	Con            int    `json:"con"`
+	Wiz            int    `json:"wiz"`
+	Cha            int    `json:"cha"`
+	ProficiencyIds []int  `json:"proficiency_ids"`
+	FeatureIds     []int  `json:"feature_ids"`
+}
@@ -10,4 +10,5 @@ type TradeEvent struct {
 	Description   string   `json:"description"`
 	Timestamp     *time.Time `json:"timestamp"`
 	TradingItems []CharacterTrade `json:"trading_items"`
+	Currency     *Currency        `json:"currency,omitempty"`
 }
\ No newline at end of file
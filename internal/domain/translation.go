@@ -0,0 +1,13 @@
+package domain
+
+// Translation is the name and description of a catalog entry in one locale.
+// The entry keeps the text it was written in, which is shown in locales
+// without a translation.
+type Translation struct {
+	TranslationId int    `json:"translation_id"`
+	Catalog       string `json:"catalog"`
+	EntryId       int    `json:"entry_id"`
+	Locale        string `json:"locale"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Currency is an amount of coins by denomination.
+type Currency struct {
+	Cp int `json:"cp"`
+	Sp int `json:"sp"`
+	Ep int `json:"ep"`
+	Gp int `json:"gp"`
+	Pp int `json:"pp"`
+}
+
+type Wallet struct {
+	CharacterId int `json:"character_id"`
+	Currency
+}
+
+// WalletTransaction records a change to a wallet. Amounts are negative when
+// coins left the wallet.
+type WalletTransaction struct {
+	WalletTransactionId int       `json:"wallet_transaction_id"`
+	CharacterId         int       `json:"character_id"`
+	Amount              Currency  `json:"amount"`
+	Reason              string    `json:"reason"`
+	TradeEventId        *int      `json:"trade_event_id"`
+	CreatedAt           time.Time `json:"created_at"`
+}
@@ -14,4 +14,8 @@ type Weapon struct {
 	Ammunition int `json:"ammunition"`
 	Damage_Type string `json:"damage_type"`
 	Campaign_Id *int `json:"campaign_id"`
+	TwoHanded bool `json:"two_handed"`
+	Finesse bool `json:"finesse"`
+	Ranged bool `json:"ranged"`
+	RequiresAttunement bool `json:"requires_attunement"`
 }
\ No newline at end of file
@@ -5,4 +5,6 @@ type WeaponXCharacterData struct {
 	CharacterData_Id int `json:"character_data_id"`
 	Weapon Weapon `json:"weapon"`
 	Equipped bool `json:"equipped"`
+	Slot string `json:"slot"`
+	Attuned bool `json:"attuned"`
 }
\ No newline at end of file
@@ -10,6 +10,7 @@ type ResponseCampaignDto struct {
 	Name          string                     `json:"name"`
 	Description   string                     `json:"description"`
 	Image         string                     `json:"image"`
+	ImageUrls     *ImageUrlsDto              `json:"image_urls"`
 	Notes         *string                    `json:"notes"`
 	Status        *string                    `json:"status"`
 	Sessions      []domain.Session           `json:"sessions"`
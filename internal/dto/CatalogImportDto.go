@@ -0,0 +1,25 @@
+package dto
+
+// CatalogImportReportDto tells the caller what a bulk catalog import did with
+// every row of the file, or would do in a dry run.
+type CatalogImportReportDto struct {
+	Catalog    string                `json:"catalog"`
+	CampaignId *int                  `json:"campaign_id"`
+	DryRun     bool                  `json:"dry_run"`
+	Applied    bool                  `json:"applied"`
+	Created    int                   `json:"created"`
+	Updated    int                   `json:"updated"`
+	Invalid    int                   `json:"invalid"`
+	Rows       []CatalogImportRowDto `json:"rows"`
+}
+
+// CatalogImportRowDto is the outcome of one row. Row is the line of the file
+// for CSV and XLSX, counting the header, and the position in the array for
+// JSON. Action is create or update, and is empty when the row has errors.
+type CatalogImportRowDto struct {
+	Row    int      `json:"row"`
+	Name   string   `json:"name"`
+	Action string   `json:"action,omitempty"`
+	Id     int      `json:"id,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
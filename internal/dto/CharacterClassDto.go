@@ -0,0 +1,12 @@
+package dto
+
+type CharacterClassDto struct {
+	ClassId int `json:"class_id"`
+	Level   int `json:"level"`
+}
+
+// CharacterSubclassDto picks the subclass of one of a character's classes; a
+// null subclass clears it.
+type CharacterSubclassDto struct {
+	SubclassId *int `json:"subclass_id"`
+}
@@ -0,0 +1,230 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+// CharacterExportDto is the portable representation of a character. Catalog
+// entries are referenced by slug and carry their full definition so they can
+// be recreated when the target server does not have them. Version 2 added
+// the subrace, every class with its level and subclass, the wallet and the
+// resources; version 1 files leave them out.
+type CharacterExportDto struct {
+	Format     string                  `json:"format"`
+	Version    int                     `json:"version"`
+	ExportedAt time.Time               `json:"exported_at"`
+	Character  CharacterExportSheet    `json:"character"`
+	Race       CharacterExportRace     `json:"race"`
+	Subrace    *CharacterExportSubrace `json:"subrace,omitempty"`
+	// Class is the first of Classes, kept for version 1 readers.
+	Class         CharacterExportClass         `json:"class"`
+	Classes       []CharacterExportClassLevel  `json:"classes,omitempty"`
+	Background    CharacterExportBackground    `json:"background"`
+	Wallet        domain.Currency              `json:"wallet"`
+	Items         []CharacterExportItem        `json:"items"`
+	Weapons       []CharacterExportWeapon      `json:"weapons"`
+	Armor         []CharacterExportArmor       `json:"armor"`
+	Spells        []CharacterExportSpell       `json:"spells"`
+	Features      []CharacterExportFeature     `json:"features"`
+	Resources     []CharacterExportResource    `json:"resources,omitempty"`
+	Proficiencies []CharacterExportProficiency `json:"proficiencies"`
+	Skills        []CharacterExportSkill       `json:"skills"`
+	Journal       []CharacterExportJournal     `json:"journal,omitempty"`
+}
+
+type CharacterExportSheet struct {
+	Name       string `json:"name"`
+	Story      string `json:"story"`
+	Alignment  string `json:"alignment"`
+	Age        int    `json:"age"`
+	Hair       string `json:"hair"`
+	Eyes       string `json:"eyes"`
+	Skin       string `json:"skin"`
+	Height     int    `json:"height"`
+	Weight     int    `json:"weight"`
+	ImgUrl     string `json:"img"`
+	Str        int    `json:"str"`
+	Dex        int    `json:"dex"`
+	Int        int    `json:"int"`
+	Con        int    `json:"con"`
+	Wiz        int    `json:"wiz"`
+	Cha        int    `json:"cha"`
+	Hitpoints  int    `json:"hitpoints"`
+	HitDice    string `json:"hit_dice"`
+	Speed      int    `json:"speed"`
+	ArmorClass int    `json:"armor_class"`
+	Level      int    `json:"level"`
+	Exp        int    `json:"exp"`
+}
+
+type CharacterExportRace struct {
+	Slug string `json:"slug"`
+	CreateRaceDto
+}
+
+// CharacterExportSubrace is the subrace of the exported race. Its
+// proficiencies and traits are already among the character's own.
+type CharacterExportSubrace struct {
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Speed       int    `json:"speed"`
+	Str         int    `json:"str"`
+	Dex         int    `json:"dex"`
+	Int         int    `json:"int"`
+	Con         int    `json:"con"`
+	Wiz         int    `json:"wiz"`
+	Cha         int    `json:"cha"`
+}
+
+type CharacterExportClass struct {
+	Slug string `json:"slug"`
+	ClassDto
+}
+
+type CharacterExportClassLevel struct {
+	CharacterExportClass
+	Level    int                      `json:"level"`
+	Subclass *CharacterExportSubclass `json:"subclass,omitempty"`
+}
+
+type CharacterExportSubclass struct {
+	Slug string `json:"slug"`
+	SubclassDto
+}
+
+type CharacterExportBackground struct {
+	Slug              string `json:"slug"`
+	Name              string `json:"name"`
+	Languages         string `json:"languages"`
+	PersonalityTraits string `json:"personality_traits"`
+	Ideals            string `json:"ideals"`
+	Bond              string `json:"bond"`
+	Flaws             string `json:"flaws"`
+	Trait             string `json:"trait"`
+	ToolProficiencies string `json:"tool_proficiencies"`
+}
+
+type CharacterExportItem struct {
+	Slug                 string `json:"slug"`
+	Name                 string `json:"name"`
+	Weight               int    `json:"weight"`
+	Price                int    `json:"price"`
+	Description          string `json:"description"`
+	Rarity               string `json:"rarity,omitempty"`
+	RequiresAttunement   bool   `json:"requires_attunement,omitempty"`
+	AttunementClasses    string `json:"attunement_classes,omitempty"`
+	AttunementAlignments string `json:"attunement_alignments,omitempty"`
+	Charges              int    `json:"charges,omitempty"`
+	Recharge             string `json:"recharge,omitempty"`
+	RechargeDice         string `json:"recharge_dice,omitempty"`
+	ArmorClassBonus      int    `json:"armor_class_bonus,omitempty"`
+	SavingThrowBonus     int    `json:"saving_throw_bonus,omitempty"`
+	AttackBonus          int    `json:"attack_bonus,omitempty"`
+	DamageBonus          int    `json:"damage_bonus,omitempty"`
+	Quantity             int    `json:"quantity"`
+	Attuned              bool   `json:"attuned,omitempty"`
+	ChargesUsed          int    `json:"charges_used,omitempty"`
+}
+
+type CharacterExportWeapon struct {
+	Slug               string `json:"slug"`
+	WeaponType         string `json:"weapon_type"`
+	Name               string `json:"name"`
+	Weight             int    `json:"weight"`
+	Price              int    `json:"price"`
+	Category           string `json:"category"`
+	Reach              string `json:"reach"`
+	Description        string `json:"description"`
+	Damage             string `json:"damage"`
+	VersatileDamage    string `json:"versatile_damage"`
+	Ammunition         int    `json:"ammunition"`
+	DamageType         string `json:"damage_type"`
+	TwoHanded          bool   `json:"two_handed,omitempty"`
+	Finesse            bool   `json:"finesse,omitempty"`
+	Ranged             bool   `json:"ranged,omitempty"`
+	RequiresAttunement bool   `json:"requires_attunement,omitempty"`
+	Equipped           bool   `json:"equipped"`
+	Slot               string `json:"slot,omitempty"`
+	Attuned            bool   `json:"attuned,omitempty"`
+}
+
+type CharacterExportArmor struct {
+	Slug               string `json:"slug"`
+	Material           string `json:"material"`
+	Name               string `json:"name"`
+	Weight             int    `json:"weight"`
+	Price              int    `json:"price"`
+	Category           string `json:"category"`
+	ProtectionType     string `json:"protection_type"`
+	Description        string `json:"description"`
+	Penalty            string `json:"penalty"`
+	Strength           int    `json:"strength"`
+	ArmorClass         int    `json:"armor_class"`
+	DexBonus           string `json:"dex_bonus"`
+	MaxDexBonus        *int   `json:"max_dex_bonus"`
+	RequiresAttunement bool   `json:"requires_attunement,omitempty"`
+	Equipped           bool   `json:"equipped"`
+	Slot               string `json:"slot,omitempty"`
+	Attuned            bool   `json:"attuned,omitempty"`
+}
+
+type CharacterExportSpell struct {
+	Slug string `json:"slug"`
+	SpellDto
+}
+
+type CharacterExportFeature struct {
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// CharacterExportResource is a limited-use resource. Feature is the slug of
+// the feature declaring it, empty for custom counters.
+type CharacterExportResource struct {
+	Feature  string `json:"feature,omitempty"`
+	Name     string `json:"name"`
+	Maximum  string `json:"maximum"`
+	Recharge string `json:"recharge"`
+	Current  int    `json:"current"`
+}
+
+// CharacterExportJournal is a journal entry as exported. Sessions belong to
+// the server the character was exported from, so they are left out.
+type CharacterExportJournal struct {
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	Visibility  string    `json:"visibility"`
+	Attachments []string  `json:"attachments"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CharacterExportProficiency struct {
+	Slug string `json:"slug"`
+	ProficiencyDto
+}
+
+type CharacterExportSkill struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+	Stat string `json:"stat"`
+}
+
+// CharacterImportReportDto tells the caller how every catalog reference in an
+// imported file was resolved.
+type CharacterImportReportDto struct {
+	Character FullCharacterData         `json:"character"`
+	Matched   []CharacterImportEntryDto `json:"matched"`
+	Created   []CharacterImportEntryDto `json:"created"`
+	Dropped   []CharacterImportEntryDto `json:"dropped"`
+}
+
+type CharacterImportEntryDto struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Id     int    `json:"id,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+type CharacterHistoryEntryDto struct {
+	Version   int                     `json:"version"`
+	AuthorId  *string                 `json:"author_id"`
+	Change    string                  `json:"change"`
+	CreatedAt time.Time               `json:"created_at"`
+	Diffs     []CharacterFieldDiffDto `json:"diffs"`
+}
+
+// CharacterFieldDiffDto describes how a single field changed between two
+// versions. Scalar fields use Before/After, lists use Added/Removed.
+type CharacterFieldDiffDto struct {
+	Field   string      `json:"field"`
+	Before  interface{} `json:"before,omitempty"`
+	After   interface{} `json:"after,omitempty"`
+	Added   []string    `json:"added,omitempty"`
+	Removed []string    `json:"removed,omitempty"`
+}
+
+type CharacterVersionDto struct {
+	Version   int                    `json:"version"`
+	AuthorId  *string                `json:"author_id"`
+	Change    string                 `json:"change"`
+	CreatedAt time.Time              `json:"created_at"`
+	Character FullCharacterData      `json:"character"`
+	Status    *CharacterStatusDto    `json:"status"`
+	Resources []CharacterResourceDto `json:"resources"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+type CharacterResourceDto struct {
+	CharacterResourceId int    `json:"character_resource_id"`
+	CharacterId         int    `json:"character_id"`
+	FeatureId           *int   `json:"feature_id"`
+	Name                string `json:"name"`
+	// Formula is the maximum as declared, Maximum the value for the character.
+	Formula  string `json:"formula"`
+	Recharge string `json:"recharge"`
+	Maximum  int    `json:"maximum"`
+	Current  int    `json:"current"`
+}
+
+type CreateCharacterResourceDto struct {
+	Name     string `json:"name"`
+	Maximum  string `json:"maximum"`
+	Recharge string `json:"recharge"`
+}
+
+type ResourceChangeDto struct {
+	// Amount defaults to one; restoring with a negative amount refills the resource.
+	Amount int `json:"amount"`
+}
+
+type ResourceRechargeDto struct {
+	Recharge string `json:"recharge"`
+}
@@ -0,0 +1,92 @@
+package dto
+
+type CharacterStatusDto struct {
+	CharacterId      int                  `json:"character_id"`
+	CurrentHitpoints int                  `json:"current_hitpoints"`
+	MaxHitpoints     int                  `json:"max_hitpoints"`
+	SpellSlots       []SpellSlotStatusDto `json:"spell_slots"`
+	PactSlots        *SpellSlotStatusDto  `json:"pact_slots"`
+	HitDice          []HitDiceStatusDto   `json:"hit_dice"`
+	DeathSaves       DeathSavesDto        `json:"death_saves"`
+}
+
+type DeathSavesDto struct {
+	State     string `json:"state"`
+	Successes int    `json:"successes"`
+	Failures  int    `json:"failures"`
+}
+
+type SpellSlotStatusDto struct {
+	Level     int `json:"level"`
+	Total     int `json:"total"`
+	Expended  int `json:"expended"`
+	Available int `json:"available"`
+}
+
+type HitDiceStatusDto struct {
+	Die       string `json:"die"`
+	Total     int    `json:"total"`
+	Spent     int    `json:"spent"`
+	Available int    `json:"available"`
+}
+
+type SpellSlotRequestDto struct {
+	Level int  `json:"level"`
+	Pact  bool `json:"pact"`
+	// Count defaults to one; restoring with a negative count restores every slot of the level.
+	Count int `json:"count"`
+}
+
+type HitpointsDto struct {
+	CurrentHitpoints int  `json:"current_hitpoints"`
+	SessionId        *int `json:"session_id"`
+}
+
+type DeathSaveRequestDto struct {
+	SessionId *int `json:"session_id"`
+}
+
+type DeathSaveResultDto struct {
+	Roll    int                `json:"roll"`
+	Outcome string             `json:"outcome"`
+	Status  CharacterStatusDto `json:"status"`
+}
+
+// DeathStateDto is broadcast on the session socket whenever a character goes
+// down, rolls a death save, stabilizes, dies or is brought back up.
+type DeathStateDto struct {
+	CharacterId      int           `json:"character_id"`
+	CurrentHitpoints int           `json:"current_hitpoints"`
+	DeathSaves       DeathSavesDto `json:"death_saves"`
+	Roll             *int          `json:"roll,omitempty"`
+}
+
+type RestRequestDto struct {
+	Type      string            `json:"type"`
+	HitDice   []HitDiceSpendDto `json:"hit_dice"`
+	SessionId *int              `json:"session_id"`
+}
+
+type HitDiceSpendDto struct {
+	Die   string `json:"die"`
+	Count int    `json:"count"`
+}
+
+type RestResultDto struct {
+	Type             string           `json:"type"`
+	Rolls            []HitDiceRollDto `json:"rolls"`
+	Healed           int              `json:"healed"`
+	HitDiceRecovered int              `json:"hit_dice_recovered"`
+	// ResourcesRecharged counts the limited-use resources refilled by the rest.
+	ResourcesRecharged int `json:"resources_recharged"`
+	// ItemsRecharged counts the magic items that regained charges.
+	ItemsRecharged int                `json:"items_recharged"`
+	Status         CharacterStatusDto `json:"status"`
+}
+
+type HitDiceRollDto struct {
+	Die      string `json:"die"`
+	Roll     int    `json:"roll"`
+	Modifier int    `json:"modifier"`
+	Total    int    `json:"total"`
+}
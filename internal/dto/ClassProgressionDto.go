@@ -0,0 +1,20 @@
+package dto
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+// SubclassDto creates or updates a subclass of the class in the path.
+type SubclassDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Level       int    `json:"level"`
+}
+
+// ClassProgressionDto creates or updates a progression row of the class in the
+// path.
+type ClassProgressionDto struct {
+	SubclassId *int                         `json:"subclass_id"`
+	Level      int                          `json:"level"`
+	FeatureIds []int                        `json:"feature_ids"`
+	SpellSlots []int                        `json:"spell_slots"`
+	Resources  []domain.ProgressionResource `json:"resources"`
+}
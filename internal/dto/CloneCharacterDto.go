@@ -0,0 +1,7 @@
+package dto
+
+// CloneCharacterDto is the optional body of a generic character clone. When a
+// campaign is given the new character joins it straight away.
+type CloneCharacterDto struct {
+	CampaignId *int `json:"campaign_id"`
+}
@@ -13,4 +13,6 @@ type CreateArmorDto struct {
 	ArmorClass     int    `json:"armor_class"`
 	DexBonus       string `json:"dex_bonus"`
 	CampaignId     *int    `json:"campaign_id"`
+	MaxDexBonus        *int `json:"max_dex_bonus"`
+	RequiresAttunement bool `json:"requires_attunement"`
 }
@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/proyecto-dnd/backend/internal/domain"
+
 type CreateBackgroundDto struct {
 	BackgroundID      int    `json:"background_id"`
 	Name              string `json:"name"`
@@ -10,4 +12,17 @@ type CreateBackgroundDto struct {
 	Flaws             string `json:"flaws"`
 	Trait             string `json:"trait"`
 	ToolProficiencies string `json:"tool_proficiencies"`
+	CampaignId        *int   `json:"campaign_id"`
+}
+
+// BackgroundGrantsDto is everything a background gives a new character.
+type BackgroundGrantsDto struct {
+	Skills        []domain.Skill               `json:"skills"`
+	Proficiencies []domain.Proficiency         `json:"proficiencies"`
+	Feature       *domain.Feature              `json:"feature"`
+	Equipment     []domain.BackgroundEquipment `json:"equipment"`
+}
+
+type BackgroundFeatureDto struct {
+	FeatureId *int `json:"feature_id"`
 }
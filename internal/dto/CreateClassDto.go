@@ -9,4 +9,5 @@ type ClassDto struct {
 	WeaponProficiencies string `json:"weapon_proficiencies"`
 	ToolProficiencies   string `json:"tool_proficiencies"`
 	SpellcastingAbility string `json:"spellcasting_ability"`
+	CampaignId          *int   `json:"campaign_id"`
 }
@@ -10,4 +10,5 @@ type CreateRaceDto struct {
 	Con         int    `json:"con"`
 	Wiz         int    `json:"wiz"`
 	Cha         int    `json:"cha"`
+	CampaignId  *int   `json:"campaign_id"`
 }
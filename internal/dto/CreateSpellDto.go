@@ -13,4 +13,5 @@ type SpellDto struct {
 	DifficultyClass int    `json:"difficulty_class"`
 	Aoe             int    `json:"aoe"`
 	School          string `json:"school"`
+	CampaignId      *int   `json:"campaign_id"`
 }
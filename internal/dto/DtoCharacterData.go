@@ -7,6 +7,7 @@ type FullCharacterData struct {
 	User_Id       *string                        `json:"userid"`
 	Campaign_Id   int                           `json:"campaignid"`
 	Race          domain.Race                   `json:"race"`
+	Subrace       *domain.Subrace               `json:"subrace"`
 	Class         domain.Class                  `json:"class"`
 	Background    domain.Background             `json:"background"`
 	Name          string                        `json:"name"`
@@ -19,6 +20,8 @@ type FullCharacterData struct {
 	Height        int                           `json:"height"`
 	Weight        int                           `json:"weight"`
 	ImgUrl        string                        `json:"img"`
+	ImgUrls       *ImageUrlsDto                 `json:"img_urls"`
+	// Scores and speed include the race and subrace bonuses, see domain.CharacterData.
 	Str           int                           `json:"str"`
 	Dex           int                           `json:"dex"`
 	Int           int                           `json:"int"`
@@ -38,4 +41,14 @@ type FullCharacterData struct {
 	Features      []domain.Feature              `json:"features"`
 	Spells        []domain.Spell                `json:"spells"`
 	Proficiencies []domain.Proficiency          `json:"proficiencies"`
+	Classes       []domain.CharacterClass       `json:"classes"`
+	HitDicePool   []domain.HitDicePool          `json:"hit_dice_pool"`
+	SpellSlots    domain.SpellSlots             `json:"spell_slots"`
+	Encumbrance   EncumbranceDto                `json:"encumbrance"`
+	Wallet        domain.Currency               `json:"wallet"`
+	Equipment     EquipmentDto                  `json:"equipment"`
+	SavingThrows  []SavingThrowBonusDto         `json:"saving_throws"`
+	// ResourceMaxima are the resource maximum formulas the class progression
+	// sets at the character's levels, by lower case resource name.
+	ResourceMaxima map[string]string `json:"resource_maxima"`
 }
@@ -0,0 +1,49 @@
+package dto
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+// EncumbranceDto describes the load a character carries. Weights are in pounds.
+type EncumbranceDto struct {
+	CarriedWeight           int                         `json:"carried_weight"`
+	CarryingCapacity        int                         `json:"carrying_capacity"`
+	VariantRules            bool                        `json:"variant_rules"`
+	Encumbered              bool                        `json:"encumbered"`
+	HeavilyEncumbered       bool                        `json:"heavily_encumbered"`
+	OverCapacity            bool                        `json:"over_capacity"`
+	SpeedPenalty            int                         `json:"speed_penalty"`
+	ArmorStrengthViolations []ArmorStrengthViolationDto `json:"armor_strength_violations"`
+}
+
+type ArmorStrengthViolationDto struct {
+	ArmorId      int    `json:"armor_id"`
+	Name         string `json:"name"`
+	Strength     int    `json:"strength"`
+	SpeedPenalty int    `json:"speed_penalty"`
+}
+
+// EncumbranceWarningDto flags a character an inventory change left encumbered
+// or over its carrying capacity.
+type EncumbranceWarningDto struct {
+	CharacterId int `json:"character_id"`
+	EncumbranceDto
+}
+
+type ItemXCharacterDataResponseDto struct {
+	domain.ItemXCharacterData
+	EncumbranceWarning *EncumbranceWarningDto `json:"encumbrance_warning,omitempty"`
+}
+
+type WeaponXCharacterDataResponseDto struct {
+	domain.WeaponXCharacterData
+	EncumbranceWarning *EncumbranceWarningDto `json:"encumbrance_warning,omitempty"`
+}
+
+type ArmorXCharacterDataResponseDto struct {
+	domain.ArmorXCharacterData
+	EncumbranceWarning *EncumbranceWarningDto `json:"encumbrance_warning,omitempty"`
+}
+
+type TradeEventResponseDto struct {
+	domain.TradeEvent
+	EncumbranceWarnings []EncumbranceWarningDto `json:"encumbrance_warnings,omitempty"`
+}
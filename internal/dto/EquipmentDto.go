@@ -0,0 +1,48 @@
+package dto
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+// EquipRequestDto puts a weapon or armor the character owns into a slot. Kind
+// is "weapon" or "armor"; an empty slot picks the natural one for the item.
+type EquipRequestDto struct {
+	Kind string `json:"kind"`
+	Id   int    `json:"id"`
+	Slot string `json:"slot"`
+}
+
+// EquipmentDto is the equipped set of a character and the stats derived from it.
+type EquipmentDto struct {
+	BodyArmor  *domain.ArmorXCharacterData  `json:"body_armor"`
+	Shield     *domain.ArmorXCharacterData  `json:"shield"`
+	MainHand   *domain.WeaponXCharacterData `json:"main_hand"`
+	OffHand    *domain.WeaponXCharacterData `json:"off_hand"`
+	TwoHanded  bool                         `json:"two_handed"`
+	Attuned    []AttunedItemDto             `json:"attuned"`
+	ArmorClass int                          `json:"armor_class"`
+	Attacks    []WeaponAttackDto            `json:"attacks"`
+	// Bonuses add up the magic items whose bonuses apply, already included in
+	// the armor class and attacks.
+	Bonuses ItemBonusesDto `json:"bonuses"`
+}
+
+type ItemBonusesDto struct {
+	ArmorClass  int `json:"armor_class"`
+	SavingThrow int `json:"saving_throw"`
+	Attack      int `json:"attack"`
+	Damage      int `json:"damage"`
+}
+
+type AttunedItemDto struct {
+	Kind string `json:"kind"`
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type WeaponAttackDto struct {
+	CharacterWeaponId int    `json:"character_weapon_id"`
+	Name              string `json:"name"`
+	Hand              string `json:"hand"`
+	AttackBonus       int    `json:"attack_bonus"`
+	Damage            string `json:"damage"`
+	DamageType        string `json:"damage_type"`
+}
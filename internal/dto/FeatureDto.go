@@ -3,9 +3,11 @@ package dto
 import "github.com/proyecto-dnd/backend/internal/domain"
 
 type CreateFeatureDto struct {
-	CharacterId int    `json:"character_id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	CharacterId int                      `json:"character_id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	CampaignId  *int                     `json:"campaign_id"`
+	Resources   []domain.FeatureResource `json:"resources"`
 }
 
 type FeatureFullResponseDto struct {
@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/proyecto-dnd/backend/pkg/storage"
+
+// ImageUrlsDto lists the sizes an uploaded image is served in. Images that
+// were not uploaded here, such as external links, use the same URL for all.
+type ImageUrlsDto struct {
+	Thumbnail string `json:"thumbnail"`
+	Card      string `json:"card"`
+	Full      string `json:"full"`
+}
+
+func NewImageUrlsDto(url string) *ImageUrlsDto {
+	if url == "" {
+		return nil
+	}
+	return &ImageUrlsDto{
+		Thumbnail: storage.VariantURL(url, storage.SizeThumbnail),
+		Card:      storage.VariantURL(url, storage.SizeCard),
+		Full:      url,
+	}
+}
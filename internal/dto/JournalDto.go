@@ -0,0 +1,10 @@
+package dto
+
+type JournalEntryDto struct {
+	SessionId  *int   `json:"session_id"`
+	Title      string `json:"title"`
+	Content    string `json:"content"`
+	Visibility string `json:"visibility"`
+	// Attachments are URLs to keep or base64 data URIs to upload.
+	Attachments []string `json:"attachments"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+// SeedReportDto counts what loading seed content did to each table, in the
+// order they were loaded.
+type SeedReportDto struct {
+	Tables []SeedTableDto `json:"tables"`
+}
+
+// SeedTableDto counts the rows of one table. Existing rows were already there:
+// catalog entries are overwritten with the seed values, links are kept as is.
+type SeedTableDto struct {
+	Table    string `json:"table"`
+	Created  int    `json:"created"`
+	Existing int    `json:"existing"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+// SubraceDto creates or updates a subrace of the race in the path.
+type SubraceDto struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Speed          int    `json:"speed"`
+	Str            int    `json:"str"`
+	Dex            int    `json:"dex"`
+	Int            int    `json:"int"`
+	Con            int    `json:"con"`
+	Wiz            int    `json:"wiz"`
+	Cha            int    `json:"cha"`
+	ProficiencyIds []int  `json:"proficiency_ids"`
+	FeatureIds     []int  `json:"feature_ids"`
+}
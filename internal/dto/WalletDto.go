@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+// WalletAdjustmentDto adds coins to a wallet ("credit") or pays them out of it
+// ("debit"), making change as needed.
+type WalletAdjustmentDto struct {
+	Type   string          `json:"type"`
+	Amount domain.Currency `json:"amount"`
+	Reason string          `json:"reason"`
+}
+
+type WalletDto struct {
+	domain.Wallet
+	TotalCp int `json:"total_cp"`
+}
@@ -9,3 +9,12 @@ type SavingThrowDto struct {
 	Wiz     bool `json:"wiz"`
 	Cha     bool `json:"cha"`
 }
+
+// SavingThrowBonusDto is a saving throw of a character. Bonus adds the
+// ability modifier, the proficiency bonus when proficient, and magic items.
+type SavingThrowBonusDto struct {
+	Ability    string `json:"ability"`
+	Modifier   int    `json:"modifier"`
+	Proficient bool   `json:"proficient"`
+	Bonus      int    `json:"bonus"`
+}
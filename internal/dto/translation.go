@@ -0,0 +1,16 @@
+package dto
+
+// TranslationDto is the text of a catalog entry in one locale. An empty
+// description falls back to the one of the entry.
+type TranslationDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TranslationsDto holds the translations of a catalog entry by locale.
+type TranslationsDto map[string]TranslationDto
+
+// UserLocaleDto is the locale a user prefers catalog content in.
+type UserLocaleDto struct {
+	Locale string `json:"locale"`
+}
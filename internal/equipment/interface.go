@@ -0,0 +1,10 @@
+package equipment
+
+import "github.com/proyecto-dnd/backend/internal/dto"
+
+type ServiceEquipment interface {
+	Equip(characterId int, request dto.EquipRequestDto) error
+	Unequip(characterId int, request dto.EquipRequestDto) error
+	AttuneItem(character dto.FullCharacterData, characterItemId int) error
+	UnattuneItem(characterId int, characterItemId int) error
+}
@@ -0,0 +1,264 @@
+package equipment
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/pkg/stats"
+)
+
+const (
+	SlotBodyArmor  = "body_armor"
+	SlotShield     = "shield"
+	SlotMainHand   = "main_hand"
+	SlotOffHand    = "off_hand"
+	SlotAttunement = "attunement"
+
+	KindWeapon = "weapon"
+	KindArmor  = "armor"
+	KindItem   = "item"
+
+	MaxAttunedItems = 3
+
+	unarmoredBaseAC = 10
+)
+
+// Shields are told apart by their category or name, in English and Spanish.
+var shieldKeywords = []string{"shield", "escudo"}
+
+func containsAny(text string, keywords []string) bool {
+	text = strings.ToLower(text)
+	for _, keyword := range keywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func IsShield(armor domain.Armor) bool {
+	return containsAny(armor.Category+" "+armor.Name, shieldKeywords)
+}
+
+// WeaponSlot is the slot an equipped weapon occupies. Weapons equipped before
+// slots existed are treated as held in the main hand.
+func WeaponSlot(weapon domain.WeaponXCharacterData) string {
+	if !weapon.Equipped {
+		return ""
+	}
+	if weapon.Slot == "" {
+		return SlotMainHand
+	}
+	return weapon.Slot
+}
+
+// ArmorSlot is the slot equipped armor occupies, which only depends on whether
+// it is a shield.
+func ArmorSlot(armor domain.ArmorXCharacterData) string {
+	if !armor.Equipped {
+		return ""
+	}
+	if IsShield(armor.Armor) {
+		return SlotShield
+	}
+	return SlotBodyArmor
+}
+
+// Loadout works out the equipped set of a character along with its armor class
+// and weapon attacks. When old data has several items in one slot the first
+// one wins.
+func Loadout(character dto.FullCharacterData) dto.EquipmentDto {
+	loadout := dto.EquipmentDto{Attuned: []dto.AttunedItemDto{}, Attacks: []dto.WeaponAttackDto{}}
+	for i := range character.Armor {
+		armor := &character.Armor[i]
+		switch ArmorSlot(*armor) {
+		case SlotBodyArmor:
+			if loadout.BodyArmor == nil {
+				loadout.BodyArmor = armor
+			}
+		case SlotShield:
+			if loadout.Shield == nil {
+				loadout.Shield = armor
+			}
+		}
+		if armor.Attuned {
+			loadout.Attuned = append(loadout.Attuned, dto.AttunedItemDto{Kind: KindArmor, Id: armor.ArmorXCharacterData_Id, Name: armor.Armor.Name})
+		}
+	}
+	for i := range character.Weapons {
+		weapon := &character.Weapons[i]
+		switch WeaponSlot(*weapon) {
+		case SlotMainHand:
+			if loadout.MainHand == nil {
+				loadout.MainHand = weapon
+			} else if loadout.OffHand == nil {
+				loadout.OffHand = weapon
+			}
+		case SlotOffHand:
+			if loadout.OffHand == nil {
+				loadout.OffHand = weapon
+			}
+		}
+		if weapon.Attuned {
+			loadout.Attuned = append(loadout.Attuned, dto.AttunedItemDto{Kind: KindWeapon, Id: weapon.Character_Weapon_Id, Name: weapon.Weapon.Name})
+		}
+	}
+	for _, item := range character.Items {
+		if item.Attuned {
+			loadout.Attuned = append(loadout.Attuned, dto.AttunedItemDto{Kind: KindItem, Id: item.Character_Item_Id, Name: item.Item.Name})
+		}
+	}
+	loadout.TwoHanded = loadout.MainHand != nil && loadout.MainHand.Weapon.TwoHanded
+	loadout.Bonuses = itemBonuses(character.Items)
+
+	loadout.ArmorClass = armorClass(character, loadout)
+	if loadout.MainHand != nil {
+		loadout.Attacks = append(loadout.Attacks, weaponAttack(character, loadout, *loadout.MainHand, SlotMainHand))
+	}
+	if loadout.OffHand != nil {
+		loadout.Attacks = append(loadout.Attacks, weaponAttack(character, loadout, *loadout.OffHand, SlotOffHand))
+	}
+	return loadout
+}
+
+func armorClass(character dto.FullCharacterData, loadout dto.EquipmentDto) int {
+	dex := stats.Modifier(character.Dex)
+	ac := unarmoredBaseAC + dex
+	if loadout.BodyArmor != nil {
+		armor := loadout.BodyArmor.Armor
+		ac = armor.ArmorClass + min(dex, maxDexBonus(armor))
+	}
+	if loadout.Shield != nil {
+		ac += loadout.Shield.Armor.ArmorClass
+	}
+	return ac + loadout.Bonuses.ArmorClass
+}
+
+// maxDexBonus is the cap the armor puts on the dexterity modifier, if any.
+func maxDexBonus(armor domain.Armor) int {
+	if armor.MaxDexBonus == nil {
+		return math.MaxInt
+	}
+	return *armor.MaxDexBonus
+}
+
+func weaponAttack(character dto.FullCharacterData, loadout dto.EquipmentDto, weapon domain.WeaponXCharacterData, hand string) dto.WeaponAttackDto {
+	modifier := stats.Modifier(character.Str)
+	dex := stats.Modifier(character.Dex)
+	switch {
+	case weapon.Weapon.Ranged:
+		modifier = dex
+	case weapon.Weapon.Finesse:
+		modifier = max(modifier, dex)
+	}
+
+	attackBonus := modifier + loadout.Bonuses.Attack
+	if proficientWith(character.Proficiencies, weapon.Weapon) {
+		attackBonus += stats.ProficiencyBonus(character.Level)
+	}
+
+	damage := weapon.Weapon.Damage
+	if hand == SlotMainHand && weapon.Weapon.Versatile_Damage != "" && loadout.OffHand == nil && loadout.Shield == nil {
+		damage = weapon.Weapon.Versatile_Damage
+	}
+	// Off hand attacks only add the ability modifier when it is a penalty.
+	damageModifier := modifier
+	if hand == SlotOffHand && damageModifier > 0 {
+		damageModifier = 0
+	}
+	damageModifier += loadout.Bonuses.Damage
+	if damageModifier != 0 {
+		damage = fmt.Sprintf("%s%+d", damage, damageModifier)
+	}
+
+	return dto.WeaponAttackDto{
+		CharacterWeaponId: weapon.Character_Weapon_Id,
+		Name:              weapon.Weapon.Name,
+		Hand:              hand,
+		AttackBonus:       attackBonus,
+		Damage:            damage,
+		DamageType:        weapon.Weapon.Damage_Type,
+	}
+}
+
+// ItemActive reports whether the bonuses of an owned item apply: items that
+// need attunement only work while attuned.
+func ItemActive(item domain.ItemXCharacterData) bool {
+	return !item.Item.RequiresAttunement || item.Attuned
+}
+
+// itemBonuses adds up the bonuses of the active items. A stack of the same
+// item only counts once.
+func itemBonuses(items []domain.ItemXCharacterData) dto.ItemBonusesDto {
+	bonuses := dto.ItemBonusesDto{}
+	for _, item := range items {
+		if !ItemActive(item) {
+			continue
+		}
+		bonuses.ArmorClass += item.Item.ArmorClassBonus
+		bonuses.SavingThrow += item.Item.SavingThrowBonus
+		bonuses.Attack += item.Item.AttackBonus
+		bonuses.Damage += item.Item.DamageBonus
+	}
+	return bonuses
+}
+
+// canAttune checks the class and alignment restrictions of an item. Any of
+// the character's classes qualifies, and an alignment entry such as "good"
+// matches every alignment containing it.
+func canAttune(character dto.FullCharacterData, item domain.Item) error {
+	if classes := splitList(item.AttunementClasses); len(classes) > 0 {
+		names := []string{strings.ToLower(character.Class.Name)}
+		for _, class := range character.Classes {
+			names = append(names, strings.ToLower(class.Class.Name))
+		}
+		if !anyMatch(classes, func(class string) bool { return slices.Contains(names, class) }) {
+			return ErrAttunementClass
+		}
+	}
+	if alignments := splitList(item.AttunementAlignments); len(alignments) > 0 {
+		alignment := strings.ToLower(character.Alignment)
+		if !anyMatch(alignments, func(entry string) bool { return strings.Contains(alignment, entry) }) {
+			return ErrAttunementAlignment
+		}
+	}
+	return nil
+}
+
+func splitList(list string) []string {
+	entries := []string{}
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func anyMatch(entries []string, match func(string) bool) bool {
+	for _, entry := range entries {
+		if match(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// proficientWith matches the character's proficiencies against the weapon name
+// and category, so both "Longsword" and "Martial" proficiencies count.
+func proficientWith(proficiencies []domain.Proficiency, weapon domain.Weapon) bool {
+	for _, proficiency := range proficiencies {
+		name := strings.ToLower(proficiency.Name)
+		if name == "" {
+			continue
+		}
+		if (weapon.Name != "" && strings.Contains(name, strings.ToLower(weapon.Name))) || (weapon.Category != "" && strings.Contains(name, strings.ToLower(weapon.Category))) {
+			return true
+		}
+	}
+	return false
+}
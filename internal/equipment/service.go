@@ -0,0 +1,337 @@
+package equipment
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
+)
+
+var (
+	ErrInvalidKind      = errors.New("kind must be weapon or armor")
+	ErrInvalidSlot      = errors.New("the item cannot go in that slot")
+	ErrNotOwned         = errors.New("the item does not belong to the character")
+	ErrTwoHandedOffHand = errors.New("two-handed weapons must be wielded in the main hand")
+	ErrAttunementFull   = errors.New("the character is already attuned to three items")
+
+	ErrNotAttunable        = errors.New("the item does not require attunement")
+	ErrAttunementClass     = errors.New("the character's classes cannot attune to the item")
+	ErrAttunementAlignment = errors.New("the character's alignment cannot attune to the item")
+)
+
+type service struct {
+	weaponXCharacterService weaponxcharacterdata.ServiceWeaponXCharacterData
+	armorXCharacterService  armorXCharacterData.ServiceArmorXCharacterData
+	itemXCharacterService   itemxcharacterdata.ServiceItemXCharacterData
+}
+
+func NewEquipmentService(weaponXCharacterService weaponxcharacterdata.ServiceWeaponXCharacterData, armorXCharacterService armorXCharacterData.ServiceArmorXCharacterData, itemXCharacterService itemxcharacterdata.ServiceItemXCharacterData) ServiceEquipment {
+	return &service{weaponXCharacterService: weaponXCharacterService, armorXCharacterService: armorXCharacterService, itemXCharacterService: itemXCharacterService}
+}
+
+// loadout is what a character owns, loaded once per request.
+type loadout struct {
+	weapons []domain.WeaponXCharacterData
+	armor   []domain.ArmorXCharacterData
+	items   []domain.ItemXCharacterData
+}
+
+func (s *service) load(characterId int) (loadout, error) {
+	weapons, err := s.weaponXCharacterService.GetByCharacterDataId(characterId)
+	if err != nil {
+		return loadout{}, err
+	}
+	armor, err := s.armorXCharacterService.GetByCharacterDataIdArmor(characterId)
+	if err != nil {
+		return loadout{}, err
+	}
+	items, err := s.itemXCharacterService.GetByCharacterDataId(characterId)
+	if err != nil {
+		return loadout{}, err
+	}
+	return loadout{weapons: weapons, armor: armor, items: items}, nil
+}
+
+func (l loadout) weapon(id int) (domain.WeaponXCharacterData, bool) {
+	for _, weapon := range l.weapons {
+		if weapon.Character_Weapon_Id == id {
+			return weapon, true
+		}
+	}
+	return domain.WeaponXCharacterData{}, false
+}
+
+func (l loadout) armorPiece(id int) (domain.ArmorXCharacterData, bool) {
+	for _, armor := range l.armor {
+		if armor.ArmorXCharacterData_Id == id {
+			return armor, true
+		}
+	}
+	return domain.ArmorXCharacterData{}, false
+}
+
+func (l loadout) item(id int) (domain.ItemXCharacterData, bool) {
+	for _, item := range l.items {
+		if item.Character_Item_Id == id {
+			return item, true
+		}
+	}
+	return domain.ItemXCharacterData{}, false
+}
+
+func (l loadout) attunedCount() int {
+	count := 0
+	for _, weapon := range l.weapons {
+		if weapon.Attuned {
+			count++
+		}
+	}
+	for _, armor := range l.armor {
+		if armor.Attuned {
+			count++
+		}
+	}
+	for _, item := range l.items {
+		if item.Attuned {
+			count++
+		}
+	}
+	return count
+}
+
+// mainHandTwoHanded reports whether a two-handed weapon other than skip is in
+// the main hand.
+func (l loadout) mainHandTwoHanded(skip int) bool {
+	for _, weapon := range l.weapons {
+		if weapon.Character_Weapon_Id != skip && WeaponSlot(weapon) == SlotMainHand && weapon.Weapon.TwoHanded {
+			return true
+		}
+	}
+	return false
+}
+
+// Equip implements ServiceEquipment. Whatever already holds the slots the item
+// needs is unequipped, so equipping swaps items rather than failing.
+func (s *service) Equip(characterId int, request dto.EquipRequestDto) error {
+	owned, err := s.load(characterId)
+	if err != nil {
+		return err
+	}
+	switch request.Kind {
+	case KindWeapon:
+		weapon, ok := owned.weapon(request.Id)
+		if !ok {
+			return ErrNotOwned
+		}
+		if request.Slot == SlotAttunement {
+			if !weapon.Weapon.RequiresAttunement {
+				return ErrNotAttunable
+			}
+			if weapon.Attuned {
+				return nil
+			}
+			if owned.attunedCount() >= MaxAttunedItems {
+				return ErrAttunementFull
+			}
+			weapon.Attuned = true
+			_, err := s.weaponXCharacterService.Update(weapon)
+			return err
+		}
+		return s.equipWeapon(owned, weapon, request.Slot)
+	case KindArmor:
+		armor, ok := owned.armorPiece(request.Id)
+		if !ok {
+			return ErrNotOwned
+		}
+		if request.Slot == SlotAttunement {
+			if !armor.Armor.RequiresAttunement {
+				return ErrNotAttunable
+			}
+			if armor.Attuned {
+				return nil
+			}
+			if owned.attunedCount() >= MaxAttunedItems {
+				return ErrAttunementFull
+			}
+			armor.Attuned = true
+			_, err := s.armorXCharacterService.UpdateArmorXCharacterData(armor)
+			return err
+		}
+		return s.equipArmor(owned, armor, request.Slot)
+	}
+	return ErrInvalidKind
+}
+
+func (s *service) equipWeapon(owned loadout, weapon domain.WeaponXCharacterData, slot string) error {
+	twoHanded := weapon.Weapon.TwoHanded
+	if slot == "" {
+		slot = SlotMainHand
+	}
+	switch slot {
+	case SlotMainHand:
+	case SlotOffHand:
+		if twoHanded {
+			return ErrTwoHandedOffHand
+		}
+	default:
+		return ErrInvalidSlot
+	}
+
+	// A two-handed weapon needs both hands free, and the off hand is also where
+	// a shield is carried.
+	freeSlots := map[string]bool{slot: true}
+	if twoHanded {
+		freeSlots[SlotOffHand] = true
+		freeSlots[SlotShield] = true
+	}
+	if slot == SlotOffHand {
+		freeSlots[SlotShield] = true
+		if owned.mainHandTwoHanded(weapon.Character_Weapon_Id) {
+			freeSlots[SlotMainHand] = true
+		}
+	}
+	if err := s.unequipSlots(owned, freeSlots, weapon.Character_Weapon_Id, 0); err != nil {
+		return err
+	}
+
+	weapon.Equipped = true
+	weapon.Slot = slot
+	_, err := s.weaponXCharacterService.Update(weapon)
+	return err
+}
+
+func (s *service) equipArmor(owned loadout, armor domain.ArmorXCharacterData, slot string) error {
+	natural := SlotBodyArmor
+	if IsShield(armor.Armor) {
+		natural = SlotShield
+	}
+	if slot == "" {
+		slot = natural
+	}
+	if slot != natural {
+		return ErrInvalidSlot
+	}
+
+	freeSlots := map[string]bool{slot: true}
+	if slot == SlotShield {
+		freeSlots[SlotOffHand] = true
+		if owned.mainHandTwoHanded(0) {
+			freeSlots[SlotMainHand] = true
+		}
+	}
+	if err := s.unequipSlots(owned, freeSlots, 0, armor.ArmorXCharacterData_Id); err != nil {
+		return err
+	}
+
+	armor.Equipped = true
+	armor.Slot = slot
+	_, err := s.armorXCharacterService.UpdateArmorXCharacterData(armor)
+	return err
+}
+
+// unequipSlots takes every item other than the one being equipped out of the
+// given slots.
+func (s *service) unequipSlots(owned loadout, slots map[string]bool, keepWeapon int, keepArmor int) error {
+	for _, weapon := range owned.weapons {
+		if weapon.Character_Weapon_Id == keepWeapon || !slots[WeaponSlot(weapon)] {
+			continue
+		}
+		weapon.Equipped = false
+		weapon.Slot = ""
+		if _, err := s.weaponXCharacterService.Update(weapon); err != nil {
+			return err
+		}
+	}
+	for _, armor := range owned.armor {
+		if armor.ArmorXCharacterData_Id == keepArmor || !slots[ArmorSlot(armor)] {
+			continue
+		}
+		armor.Equipped = false
+		armor.Slot = ""
+		if _, err := s.armorXCharacterService.UpdateArmorXCharacterData(armor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unequip implements ServiceEquipment. With the attunement slot it ends the
+// attunement and leaves the item equipped.
+func (s *service) Unequip(characterId int, request dto.EquipRequestDto) error {
+	owned, err := s.load(characterId)
+	if err != nil {
+		return err
+	}
+	switch request.Kind {
+	case KindWeapon:
+		weapon, ok := owned.weapon(request.Id)
+		if !ok {
+			return ErrNotOwned
+		}
+		if request.Slot == SlotAttunement {
+			weapon.Attuned = false
+		} else {
+			weapon.Equipped = false
+			weapon.Slot = ""
+		}
+		_, err := s.weaponXCharacterService.Update(weapon)
+		return err
+	case KindArmor:
+		armor, ok := owned.armorPiece(request.Id)
+		if !ok {
+			return ErrNotOwned
+		}
+		if request.Slot == SlotAttunement {
+			armor.Attuned = false
+		} else {
+			armor.Equipped = false
+			armor.Slot = ""
+		}
+		_, err := s.armorXCharacterService.UpdateArmorXCharacterData(armor)
+		return err
+	}
+	return ErrInvalidKind
+}
+
+// AttuneItem implements ServiceEquipment. Attuned items share the limit with
+// attuned weapons and armor.
+func (s *service) AttuneItem(character dto.FullCharacterData, characterItemId int) error {
+	owned, err := s.load(character.Character_Id)
+	if err != nil {
+		return err
+	}
+	item, ok := owned.item(characterItemId)
+	if !ok {
+		return ErrNotOwned
+	}
+	if !item.Item.RequiresAttunement {
+		return ErrNotAttunable
+	}
+	if item.Attuned {
+		return nil
+	}
+	if owned.attunedCount() >= MaxAttunedItems {
+		return ErrAttunementFull
+	}
+	if err := canAttune(character, item.Item); err != nil {
+		return err
+	}
+	_, err = s.itemXCharacterService.SetAttuned(characterItemId, true)
+	return err
+}
+
+// UnattuneItem implements ServiceEquipment.
+func (s *service) UnattuneItem(characterId int, characterItemId int) error {
+	owned, err := s.load(characterId)
+	if err != nil {
+		return err
+	}
+	if _, ok := owned.item(characterItemId); !ok {
+		return ErrNotOwned
+	}
+	_, err = s.itemXCharacterService.SetAttuned(characterItemId, false)
+	return err
+}
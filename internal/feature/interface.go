@@ -2,6 +2,7 @@ package feature
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -22,6 +23,7 @@ type FeatureService interface {
 	GetFeatureById(id int) (domain.Feature, error)
 	UpdateFeature(feature dto.CreateFeatureDto, id int) (domain.Feature, error)
 	DeleteFeature(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Feature], error)
 }
 
 type FeatureRepository interface {
@@ -31,4 +33,5 @@ type FeatureRepository interface {
 	GetById(id int) (domain.Feature, error)
 	Update(feature domain.Feature, id int) (domain.Feature, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Feature], error)
 }
\ No newline at end of file
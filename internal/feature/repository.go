@@ -4,8 +4,10 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -25,6 +27,7 @@ func (r *featureMySqlRepository) Create(feature dto.CreateFeatureDto) (domain.Fe
 	var newFeature domain.Feature
 	newFeature.Name = feature.Name
 	newFeature.Description = feature.Description
+	newFeature.CampaignId = feature.CampaignId
 
 	statement, err := r.db.Prepare(QueryCreateFeature)
 	if err != nil {
@@ -35,6 +38,7 @@ func (r *featureMySqlRepository) Create(feature dto.CreateFeatureDto) (domain.Fe
 	result, err := statement.Exec(
 		newFeature.Name,
 		newFeature.Description,
+		newFeature.CampaignId,
 	)
 	if err != nil {
 		return domain.Feature{}, err
@@ -45,6 +49,10 @@ func (r *featureMySqlRepository) Create(feature dto.CreateFeatureDto) (domain.Fe
 		return domain.Feature{}, ErrGettingLastInsertId
 	}
 	newFeature.FeatureId = int(lastId)
+	newFeature.Resources, err = r.setResources(newFeature.FeatureId, feature.Resources)
+	if err != nil {
+		return domain.Feature{}, err
+	}
 
 	statement, err = r.db.Prepare(QueryCreateCharacterFeature)
 	if err != nil {
@@ -73,6 +81,7 @@ func (r *featureMySqlRepository) GetAll() ([]domain.Feature, error) {
 			&feature.FeatureId,
 			&feature.Name,
 			&feature.Description,
+			&feature.CampaignId,
 		)
 		if err != nil {
 			return nil, err
@@ -80,7 +89,7 @@ func (r *featureMySqlRepository) GetAll() ([]domain.Feature, error) {
 		features = append(features, feature)
 	}
 	
-	return features, nil
+	return r.attachResources(features, QueryGetAllResources)
 }
 
 func (r *featureMySqlRepository) GetAllByCharacterId(id int) ([]domain.Feature, error) {
@@ -97,6 +106,7 @@ func (r *featureMySqlRepository) GetAllByCharacterId(id int) ([]domain.Feature,
 			&feature.FeatureId,
 			&feature.Name,
 			&feature.Description,
+			&feature.CampaignId,
 		)
 		if err != nil {
 			return nil, err
@@ -104,7 +114,7 @@ func (r *featureMySqlRepository) GetAllByCharacterId(id int) ([]domain.Feature,
 		features = append(features, feature)
 	}
 	
-	return features, nil
+	return r.attachResources(features, QueryGetResourcesByCharacterId, id)
 }
 
 func (r *featureMySqlRepository) GetById(id int) (domain.Feature, error) {
@@ -114,12 +124,17 @@ func (r *featureMySqlRepository) GetById(id int) (domain.Feature, error) {
 		&feature.FeatureId,
 		&feature.Name,
 		&feature.Description,
+		&feature.CampaignId,
 	)
 	if err != nil {
 		return domain.Feature{}, err
 	}
 	
-	return feature, nil
+	features, err := r.attachResources([]domain.Feature{feature}, QueryGetResourcesByFeatureId, id)
+	if err != nil {
+		return domain.Feature{}, err
+	}
+	return features[0], nil
 }
 
 func (r *featureMySqlRepository) Update(feature domain.Feature, id int) (domain.Feature, error) {
@@ -129,23 +144,110 @@ func (r *featureMySqlRepository) Update(feature domain.Feature, id int) (domain.
 	}
 	defer statement.Close()
 
-	_, err = statement.Exec(feature.Name, feature.Description, id)
+	_, err = statement.Exec(feature.Name, feature.Description, feature.CampaignId, id)
 	if err != nil {
 		return domain.Feature{}, err
 	}
 
 	feature.FeatureId = id
+	if feature.Resources == nil {
+		features, err := r.attachResources([]domain.Feature{feature}, QueryGetResourcesByFeatureId, id)
+		if err != nil {
+			return domain.Feature{}, err
+		}
+		return features[0], nil
+	}
+	feature.Resources, err = r.setResources(id, feature.Resources)
+	if err != nil {
+		return domain.Feature{}, err
+	}
 
 	return feature, nil
 }
 
+// Delete implements FeatureRepository. The resources and translations of the
+// feature go with it.
 func (r *featureMySqlRepository) Delete(id int) error {
-	statement, err := r.db.Prepare(QueryDelete)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return ErrPrepareStatement
+		return err
 	}
-	defer statement.Close()
-	
-	_, err = statement.Exec(id)
-	return err
-}
\ No newline at end of file
+	defer tx.Rollback()
+
+	for _, query := range []string{QueryDeleteResources, QueryDelete} {
+		if _, err := tx.Exec(query, id); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// setResources replaces the resources a feature declares.
+func (r *featureMySqlRepository) setResources(featureId int, resources []domain.FeatureResource) ([]domain.FeatureResource, error) {
+	if _, err := r.db.Exec(QueryDeleteResources, featureId); err != nil {
+		return nil, err
+	}
+	for _, resource := range resources {
+		if _, err := r.db.Exec(QueryCreateResource, featureId, resource.Name, resource.Maximum, resource.Recharge); err != nil {
+			return nil, err
+		}
+	}
+	if resources == nil {
+		return []domain.FeatureResource{}, nil
+	}
+	return resources, nil
+}
+
+// attachResources fills in the resources of each feature from a query
+// returning feature_id, name, maximum and recharge.
+func (r *featureMySqlRepository) attachResources(features []domain.Feature, query string, args ...any) ([]domain.Feature, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resources := map[int][]domain.FeatureResource{}
+	for rows.Next() {
+		var featureId int
+		var resource domain.FeatureResource
+		if err := rows.Scan(&featureId, &resource.Name, &resource.Maximum, &resource.Recharge); err != nil {
+			return nil, err
+		}
+		resources[featureId] = append(resources[featureId], resource)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range features {
+		features[i].Resources = resources[features[i].FeatureId]
+		if features[i].Resources == nil {
+			features[i].Resources = []domain.FeatureResource{}
+		}
+	}
+	return features, nil
+}
+
+// Search implements FeatureRepository.
+func (r *featureMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Feature], error) {
+	page, err := catalog.Search(r.db, CatalogTable, params, scanFeature)
+	if err != nil {
+		return catalog.Page[domain.Feature]{}, err
+	}
+	page.Items, err = r.attachResources(page.Items, QueryGetAllResources)
+	return page, err
+}
+
+func scanFeature(row catalog.Scannable) (domain.Feature, error) {
+	var feature domain.Feature
+	err := row.Scan(
+		&feature.FeatureId,
+		&feature.Name,
+		&feature.Description,
+		&feature.CampaignId,
+	)
+	return feature, err
+}
@@ -1,8 +1,10 @@
 package feature
 
 import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/resource"
 )
 
 type service struct {
@@ -18,6 +20,9 @@ func (s *service) CreateFeature(featureDto dto.CreateFeatureDto) (domain.Feature
 	// 	Name:        featureDto.Name,
 	// 	Description: featureDto.Description,
 	// }
+	if err := validateResources(featureDto.Resources); err != nil {
+		return domain.Feature{}, err
+	}
 
 	createdFeature, err := s.repo.Create(featureDto)
 	if err != nil {
@@ -63,6 +68,11 @@ func (s *service) UpdateFeature(featureDto dto.CreateFeatureDto, id int) (domain
 	featureDomain := domain.Feature{
 		Name:        featureDto.Name,
 		Description: featureDto.Description,
+		CampaignId:  featureDto.CampaignId,
+		Resources:   featureDto.Resources,
+	}
+	if err := validateResources(featureDto.Resources); err != nil {
+		return domain.Feature{}, err
 	}
 
 	updatedFeature, err := s.repo.Update(featureDomain, id)
@@ -81,3 +91,17 @@ func (s *service) DeleteFeature(id int) error {
 
 	return nil
 }
+
+func validateResources(resources []domain.FeatureResource) error {
+	for _, definition := range resources {
+		if err := resource.Validate(definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Search implements FeatureService.
+func (s *service) Search(params catalog.Params) (catalog.Page[domain.Feature], error) {
+	return s.repo.Search(params)
+}
@@ -1,9 +1,11 @@
 package feature
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
 	QueryCreateFeature = `
-		INSERT INTO feature (name, description)
-		VALUES (?, ?)
+		INSERT INTO feature (name, description, campaign_id)
+		VALUES (?, ?, ?)
 	`
 
 	QueryCreateCharacterFeature = `
@@ -29,11 +31,51 @@ var (
 
 	QueryUpdate = `
 		UPDATE feature
-		SET name = ?, description = ?
+		SET name = ?, description = ?, campaign_id = ?
 		WHERE feature_id = ?;
 	`
 
 	QueryDelete = `
 		DELETE FROM feature WHERE feature_id = ?;
 	`
-)
\ No newline at end of file
+
+	QueryCreateResource = `
+		INSERT INTO feature_resource (feature_id, name, maximum, recharge)
+		VALUES (?, ?, ?, ?);
+	`
+
+	QueryDeleteResources = `
+		DELETE FROM feature_resource WHERE feature_id = ?;
+	`
+
+	QueryGetAllResources = `
+		SELECT feature_id, name, maximum, recharge
+		FROM feature_resource
+		ORDER BY feature_resource_id;
+	`
+
+	QueryGetResourcesByCharacterId = `
+		SELECT fr.feature_id, fr.name, fr.maximum, fr.recharge
+		FROM feature_resource fr
+		INNER JOIN character_feature cf ON fr.feature_id = cf.feature_id
+		WHERE cf.character_id = ?
+		ORDER BY fr.feature_resource_id;
+	`
+
+	QueryGetResourcesByFeatureId = `
+		SELECT feature_id, name, maximum, recharge
+		FROM feature_resource
+		WHERE feature_id = ?
+		ORDER BY feature_resource_id;
+	`
+)
+
+// CatalogTable describes how GET /feature searches and sorts features.
+var CatalogTable = catalog.Table{
+	Name:           "feature",
+	Id:             "feature_id",
+	Search:         []string{"name", "description"},
+	Sorts:          map[string]string{"name": "name"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
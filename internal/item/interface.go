@@ -1,6 +1,9 @@
 package item
 
-import "github.com/proyecto-dnd/backend/internal/domain"
+import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
 
 type RepositoryItem interface {
 	Create(item domain.Item) (domain.Item, error)
@@ -10,6 +13,7 @@ type RepositoryItem interface {
 	GetAllGeneric() ([]domain.Item, error)
 	Update(item domain.Item) (domain.Item, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Item], error)
 }
 
 type ServiceItem interface {
@@ -20,4 +24,5 @@ type ServiceItem interface {
 	GetAllGeneric() ([]domain.Item, error)
 	Update(item domain.Item) (domain.Item, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Item], error)
 }
\ No newline at end of file
@@ -3,7 +3,10 @@ package item
 import (
 	"database/sql"
 	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -30,15 +33,7 @@ func (r *itemMySqlRepository) GetAllGeneric() ([]domain.Item, error) {
 	var items []domain.Item
 
 	for rows.Next() {
-		var item domain.Item
-		err := rows.Scan(
-			&item.Item_Id,
-			&item.Name,
-			&item.Weight,
-			&item.Price,
-			&item.Description,
-			&item.Campaign_Id,
-		)
+		item, err := scanItem(rows)
 		if err != nil {
 			return []domain.Item{}, err
 		}
@@ -60,13 +55,8 @@ func (r *itemMySqlRepository) Create(item domain.Item) (domain.Item, error) {
 	}
 	defer statement.Close()
 
-	result, err := statement.Exec(
-		item.Name,
-		item.Weight,
-		item.Price,
-		item.Description,
-		item.Campaign_Id,
-	)
+	values := append([]any{item.Name, item.Weight, item.Price, item.Description, item.Campaign_Id}, magicValues(item)...)
+	result, err := statement.Exec(values...)
 
 	if err != nil {
 		return domain.Item{}, err
@@ -84,7 +74,13 @@ func (r *itemMySqlRepository) Create(item domain.Item) (domain.Item, error) {
 
 // Delete implements RepositoryItem.
 func (r *itemMySqlRepository) Delete(id int) error {
-	result, err := r.db.Exec(QueryDelete, id)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(QueryDelete, id)
 	if err != nil {
 		return err
 	}
@@ -95,7 +91,10 @@ func (r *itemMySqlRepository) Delete(id int) error {
 	if rowsAffected < 1 {
 		return ErrNotFound
 	}
-	return nil
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // GetAll implements RepositoryItem.
@@ -108,15 +107,7 @@ func (r *itemMySqlRepository) GetAll() ([]domain.Item, error) {
 	var items []domain.Item
 
 	for rows.Next() {
-		var item domain.Item
-		err := rows.Scan(
-			&item.Item_Id,
-			&item.Name,
-			&item.Weight,
-			&item.Price,
-			&item.Description,
-			&item.Campaign_Id,
-		)
+		item, err := scanItem(rows)
 		if err != nil {
 			return []domain.Item{}, err
 		}
@@ -138,15 +129,7 @@ func (r *itemMySqlRepository) GetByCampaignId(campaignId int) ([]domain.Item, er
 	var items []domain.Item
 
 	for rows.Next() {
-		var item domain.Item
-		err := rows.Scan(
-			&item.Item_Id,
-			&item.Name,
-			&item.Weight,
-			&item.Price,
-			&item.Description,
-			&item.Campaign_Id,
-		)
+		item, err := scanItem(rows)
 		if err != nil {
 			return []domain.Item{}, err
 		}
@@ -160,17 +143,7 @@ func (r *itemMySqlRepository) GetByCampaignId(campaignId int) ([]domain.Item, er
 
 // GetById implements RepositoryItem.
 func (r *itemMySqlRepository) GetById(id int) (domain.Item, error) {
-	row := r.db.QueryRow(QueryGetById, id)
-
-	var item domain.Item
-	err := row.Scan(
-		&item.Item_Id,
-		&item.Name,
-		&item.Weight,
-		&item.Price,
-		&item.Description,
-		&item.Campaign_Id,
-	)
+	item, err := scanItem(r.db.QueryRow(QueryGetById, id))
 	if err != nil {
 		return domain.Item{}, ErrNotFound
 	}
@@ -186,14 +159,8 @@ func (r *itemMySqlRepository) Update(item domain.Item) (domain.Item, error) {
 	}
 	defer statement.Close()
 
-	_, err = statement.Exec(
-		item.Name,
-		item.Weight,
-		item.Price,
-		item.Description,
-		item.Campaign_Id,
-		item.Item_Id,
-	)
+	values := append([]any{item.Name, item.Weight, item.Price, item.Description, item.Campaign_Id}, magicValues(item)...)
+	_, err = statement.Exec(append(values, item.Item_Id)...)
 
 	if err != nil {
 		return domain.Item{}, err
@@ -201,3 +168,50 @@ func (r *itemMySqlRepository) Update(item domain.Item) (domain.Item, error) {
 
 	return item, nil
 }
+
+// Search implements RepositoryItem.
+func (r *itemMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Item], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanItem)
+}
+
+func scanItem(row catalog.Scannable) (domain.Item, error) {
+	var item domain.Item
+	err := row.Scan(
+		&item.Item_Id,
+		&item.Name,
+		&item.Weight,
+		&item.Price,
+		&item.Description,
+		&item.Campaign_Id,
+		&item.Rarity,
+		&item.RequiresAttunement,
+		&item.AttunementClasses,
+		&item.AttunementAlignments,
+		&item.Charges,
+		&item.Recharge,
+		&item.RechargeDice,
+		&item.ArmorClassBonus,
+		&item.SavingThrowBonus,
+		&item.AttackBonus,
+		&item.DamageBonus,
+	)
+	return item, err
+}
+
+// magicValues are the magic item columns in the order the insert and update
+// queries take them.
+func magicValues(item domain.Item) []any {
+	return []any{
+		item.Rarity,
+		item.RequiresAttunement,
+		item.AttunementClasses,
+		item.AttunementAlignments,
+		item.Charges,
+		item.Recharge,
+		item.RechargeDice,
+		item.ArmorClassBonus,
+		item.SavingThrowBonus,
+		item.AttackBonus,
+		item.DamageBonus,
+	}
+}
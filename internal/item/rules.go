@@ -0,0 +1,43 @@
+package item
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/resource"
+	"github.com/proyecto-dnd/backend/pkg/dice"
+)
+
+var (
+	ErrInvalidRarity       = errors.New("rarity must be common, uncommon, rare, very rare, legendary or artifact")
+	ErrInvalidCharges      = errors.New("charges can not be negative")
+	ErrInvalidRechargeDice = errors.New("recharge dice must be dice notation such as 1d6+1")
+	ErrAttunementRules     = errors.New("only items that require attunement can restrict who attunes to them")
+)
+
+var rarities = []string{"common", "uncommon", "rare", "very rare", "legendary", "artifact"}
+
+// Validate checks the magic item properties of an item. Items without
+// charges need no recharge rule.
+func Validate(item domain.Item) error {
+	if item.Rarity != "" && !slices.Contains(rarities, strings.ToLower(item.Rarity)) {
+		return ErrInvalidRarity
+	}
+	if item.Charges < 0 {
+		return ErrInvalidCharges
+	}
+	if item.Charges > 0 && !resource.ValidRecharge(item.Recharge) {
+		return resource.ErrInvalidRecharge
+	}
+	if item.RechargeDice != "" {
+		if _, err := dice.Parse(item.RechargeDice); err != nil {
+			return ErrInvalidRechargeDice
+		}
+	}
+	if !item.RequiresAttunement && (strings.TrimSpace(item.AttunementClasses) != "" || strings.TrimSpace(item.AttunementAlignments) != "") {
+		return ErrAttunementRules
+	}
+	return nil
+}
@@ -1,6 +1,11 @@
 package item
 
-import "github.com/proyecto-dnd/backend/internal/domain"
+import (
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
 
 type service struct {
 	repo RepositoryItem
@@ -22,6 +27,10 @@ func (s *service) GetAllGeneric() ([]domain.Item, error) {
 
 // Create implements ServiceItem.
 func (s *service) Create(item domain.Item) (domain.Item, error) {
+	if err := Validate(item); err != nil {
+		return domain.Item{}, err
+	}
+	item.Rarity = strings.ToLower(item.Rarity)
 	newItem, err := s.repo.Create(item)
 	if err != nil {
 		return domain.Item{}, err
@@ -67,9 +76,18 @@ func (s *service) GetById(id int) (domain.Item, error) {
 
 // Update implements ServiceItem.
 func (s *service) Update(item domain.Item) (domain.Item, error) {
+	if err := Validate(item); err != nil {
+		return domain.Item{}, err
+	}
+	item.Rarity = strings.ToLower(item.Rarity)
 	item, err := s.repo.Update(item)
 	if err != nil {
 		return domain.Item{}, err
 	}
 	return item, nil
 }
+
+// Search implements ServiceItem.
+func (s *service) Search(params catalog.Params) (catalog.Page[domain.Item], error) {
+	return s.repo.Search(params)
+}
@@ -1,11 +1,27 @@
 package item
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
-	QueryCreateItem      = `INSERT INTO item (name, weight, price, description, campaign_id) VALUES (?, ?, ?, ?, ?);`
+	QueryCreateItem      = `INSERT INTO item (name, weight, price, description, campaign_id, rarity, requires_attunement, attunement_classes, attunement_alignments, charges, recharge, recharge_dice, armor_class_bonus, saving_throw_bonus, attack_bonus, damage_bonus) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
 	QueryGetAll          = `SELECT * FROM item;`
 	QueryGetAllGeneric   = `SELECT * FROM item WHERE campaign_id IS NULL;`
 	QueryGetByCampaignId = `SELECT * FROM item WHERE campaign_id = ?;`
 	QueryGetById         = `SELECT * FROM item WHERE item_id = ?;`
-	QueryUpdate          = `UPDATE item SET name = ?, weight = ? , price = ? , description = ? , campaign_id = ? WHERE item_id = ? ;`
+	QueryUpdate          = `UPDATE item SET name = ?, weight = ? , price = ? , description = ? , campaign_id = ?, rarity = ?, requires_attunement = ?, attunement_classes = ?, attunement_alignments = ?, charges = ?, recharge = ?, recharge_dice = ?, armor_class_bonus = ?, saving_throw_bonus = ?, attack_bonus = ?, damage_bonus = ? WHERE item_id = ? ;`
 	QueryDelete          = `DELETE from item where item_id = ?;`
 )
+
+// CatalogTable describes how GET /item searches, filters and sorts items.
+var CatalogTable = catalog.Table{
+	Name:   "item",
+	Id:     "item_id",
+	Search: []string{"name", "description"},
+	Filters: map[string]catalog.Filter{
+		"rarity":              {Condition: "rarity = ?", Kind: catalog.Text},
+		"requires_attunement": {Condition: "requires_attunement = ?", Kind: catalog.Boolean},
+	},
+	Sorts:          map[string]string{"name": "name", "price": "price", "weight": "weight"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
@@ -0,0 +1,105 @@
+package itemxcharacterdata
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/resource"
+	"github.com/proyecto-dnd/backend/pkg/dice"
+)
+
+var (
+	ErrNoCharges        = errors.New("the item has no charges")
+	ErrNotEnoughCharges = errors.New("the item does not have enough charges left")
+	ErrInvalidAmount    = errors.New("amount must be greater than 0")
+)
+
+// SetAttuned implements ServiceItemXCharacterData. The attunement limit and
+// restrictions are checked by the equipment service before calling it.
+func (s *service) SetAttuned(id int, attuned bool) (domain.ItemXCharacterData, error) {
+	stored, err := s.itemXCharacterDataRepo.GetById(id)
+	if err != nil {
+		return domain.ItemXCharacterData{}, err
+	}
+	stored.Attuned = attuned
+	return s.itemXCharacterDataRepo.Update(stored)
+}
+
+// SpendCharges implements ServiceItemXCharacterData. Amount defaults to one
+// charge.
+func (s *service) SpendCharges(id int, amount int) (domain.ItemXCharacterData, error) {
+	if amount == 0 {
+		amount = 1
+	}
+	if amount < 0 {
+		return domain.ItemXCharacterData{}, ErrInvalidAmount
+	}
+	stored, err := s.itemXCharacterDataRepo.GetById(id)
+	if err != nil {
+		return domain.ItemXCharacterData{}, err
+	}
+	if stored.Item.Charges == 0 {
+		return domain.ItemXCharacterData{}, ErrNoCharges
+	}
+	if stored.ChargesUsed+amount > stored.Item.Charges {
+		return domain.ItemXCharacterData{}, ErrNotEnoughCharges
+	}
+	stored.ChargesUsed += amount
+	return s.itemXCharacterDataRepo.Update(stored)
+}
+
+// RestoreCharges implements ServiceItemXCharacterData. Amount defaults to one
+// charge and a negative amount refills the item.
+func (s *service) RestoreCharges(id int, amount int) (domain.ItemXCharacterData, error) {
+	stored, err := s.itemXCharacterDataRepo.GetById(id)
+	if err != nil {
+		return domain.ItemXCharacterData{}, err
+	}
+	if stored.Item.Charges == 0 {
+		return domain.ItemXCharacterData{}, ErrNoCharges
+	}
+	if amount == 0 {
+		amount = 1
+	}
+	if amount < 0 {
+		amount = stored.ChargesUsed
+	}
+	stored.ChargesUsed = max(stored.ChargesUsed-amount, 0)
+	return s.itemXCharacterDataRepo.Update(stored)
+}
+
+// Recharge implements ServiceItemXCharacterData. Every item of the character
+// that recharges by one of the given rules regains its recharge dice, or all
+// of its charges, and the number of items recharged is returned.
+func (s *service) Recharge(characterId int, recharges []string) (int, error) {
+	for _, recharge := range recharges {
+		if !resource.ValidRecharge(recharge) {
+			return 0, resource.ErrInvalidRecharge
+		}
+	}
+	items, err := s.itemXCharacterDataRepo.GetByCharacterDataId(characterId)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, owned := range items {
+		if owned.ChargesUsed == 0 || !slices.Contains(recharges, owned.Item.Recharge) {
+			continue
+		}
+		regained := owned.ChargesUsed
+		if owned.Item.RechargeDice != "" {
+			roll, err := dice.Parse(owned.Item.RechargeDice)
+			if err != nil {
+				return total, err
+			}
+			_, regained = roll.Roll()
+		}
+		owned.ChargesUsed = max(owned.ChargesUsed-regained, 0)
+		if _, err := s.itemXCharacterDataRepo.Update(owned); err != nil {
+			return total, err
+		}
+		total++
+	}
+	return total, nil
+}
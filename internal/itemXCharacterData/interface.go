@@ -22,4 +22,8 @@ type ServiceItemXCharacterData interface {
 	UpdateOwnership(itemXCharacterData domain.ItemXCharacterData) (error)
 	Delete(id int) error
 	DeleteByCharacterDataId(id int) error
+	SetAttuned(id int, attuned bool) (domain.ItemXCharacterData, error)
+	SpendCharges(id int, amount int) (domain.ItemXCharacterData, error)
+	RestoreCharges(id int, amount int) (domain.ItemXCharacterData, error)
+	Recharge(characterId int, recharges []string) (int, error)
 }
\ No newline at end of file
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 )
 
@@ -32,6 +34,8 @@ func (r *itemXCharacterDataSqlRepository) Create(itemXCharacterData domain.ItemX
 		&tempItemXCharacterData.CharacterData_Id,
 		&tempItemXCharacterData.Item.Item_Id,
 		&tempItemXCharacterData.Quantity,
+		&tempItemXCharacterData.Attuned,
+		&tempItemXCharacterData.ChargesUsed,
 	)
 	if err != nil {
 		statement, err := tx.PrepareContext(tempContext, QueryCreateItemXCharacterData)
@@ -65,10 +69,14 @@ func (r *itemXCharacterDataSqlRepository) Create(itemXCharacterData domain.ItemX
 			itemXCharacterData.CharacterData_Id,
 			tempItemXCharacterData.Item.Item_Id,
 			itemXCharacterData.Quantity+tempItemXCharacterData.Quantity,
+			tempItemXCharacterData.Attuned,
+			tempItemXCharacterData.ChargesUsed,
 			tempItemXCharacterData.Character_Item_Id,
 		)
 		itemXCharacterData.Character_Item_Id = tempItemXCharacterData.Character_Item_Id
 		itemXCharacterData.Quantity = itemXCharacterData.Quantity + tempItemXCharacterData.Quantity
+		itemXCharacterData.Attuned = tempItemXCharacterData.Attuned
+		itemXCharacterData.ChargesUsed = tempItemXCharacterData.ChargesUsed
 		if err != nil {
 			return domain.ItemXCharacterData{}, err
 		}
@@ -127,18 +135,7 @@ func (r *itemXCharacterDataSqlRepository) GetAll() ([]domain.ItemXCharacterData,
 	itemXCharacterDataList := []domain.ItemXCharacterData{}
 
 	for rows.Next() {
-		var itemXCharacterData domain.ItemXCharacterData
-		err := rows.Scan(
-			&itemXCharacterData.Character_Item_Id,
-			&itemXCharacterData.CharacterData_Id,
-			&itemXCharacterData.Item.Item_Id,
-			&itemXCharacterData.Item.Name,
-			&itemXCharacterData.Item.Weight,
-			&itemXCharacterData.Item.Price,
-			&itemXCharacterData.Item.Description,
-			&itemXCharacterData.Item.Campaign_Id,
-			&itemXCharacterData.Quantity,
-		)
+		itemXCharacterData, err := scanItemXCharacterData(rows)
 		if err != nil {
 			return []domain.ItemXCharacterData{}, err
 		}
@@ -152,19 +149,7 @@ func (r *itemXCharacterDataSqlRepository) GetAll() ([]domain.ItemXCharacterData,
 
 // GetById implements RepositoryItemXTableCharacter.
 func (r *itemXCharacterDataSqlRepository) GetById(id int) (domain.ItemXCharacterData, error) {
-	row := r.db.QueryRow(QueryGetById, id)
-	var itemXCharacterData domain.ItemXCharacterData
-	err := row.Scan(
-		&itemXCharacterData.Character_Item_Id,
-		&itemXCharacterData.CharacterData_Id,
-		&itemXCharacterData.Item.Item_Id,
-		&itemXCharacterData.Item.Name,
-		&itemXCharacterData.Item.Weight,
-		&itemXCharacterData.Item.Price,
-		&itemXCharacterData.Item.Description,
-		&itemXCharacterData.Item.Campaign_Id,
-		&itemXCharacterData.Quantity,
-	)
+	itemXCharacterData, err := scanItemXCharacterData(r.db.QueryRow(QueryGetById, id))
 	if err != nil {
 		return domain.ItemXCharacterData{}, ErrNotFound
 	}
@@ -185,18 +170,7 @@ func (r *itemXCharacterDataSqlRepository) GetByCharacterDataId(id int) ([]domain
 	itemXCharacterDataList := []domain.ItemXCharacterData{}
 
 	for rows.Next() {
-		var itemXCharacterData domain.ItemXCharacterData
-		err := rows.Scan(
-			&itemXCharacterData.Character_Item_Id,
-			&itemXCharacterData.CharacterData_Id,
-			&itemXCharacterData.Item.Item_Id,
-			&itemXCharacterData.Item.Name,
-			&itemXCharacterData.Item.Weight,
-			&itemXCharacterData.Item.Price,
-			&itemXCharacterData.Item.Description,
-			&itemXCharacterData.Item.Campaign_Id,
-			&itemXCharacterData.Quantity,
-		)
+		itemXCharacterData, err := scanItemXCharacterData(rows)
 		if err != nil {
 			return []domain.ItemXCharacterData{}, err
 		}
@@ -220,6 +194,8 @@ func (r *itemXCharacterDataSqlRepository) Update(itemXCharacterData domain.ItemX
 		itemXCharacterData.CharacterData_Id,
 		itemXCharacterData.Item.Item_Id,
 		itemXCharacterData.Quantity,
+		itemXCharacterData.Attuned,
+		itemXCharacterData.ChargesUsed,
 		itemXCharacterData.Character_Item_Id,
 	)
 
@@ -244,6 +220,8 @@ func (r *itemXCharacterDataSqlRepository) UpdateOwnership(itemXCharacterData dom
 		&tempItemXCharacterData.CharacterData_Id,
 		&tempItemXCharacterData.Item.Item_Id,
 		&tempItemXCharacterData.Quantity,
+		&tempItemXCharacterData.Attuned,
+		&tempItemXCharacterData.ChargesUsed,
 	)
 	if err != nil {
 		statement, err := tx.PrepareContext(tempContext, QueryUpdateOwnership)
@@ -297,6 +275,35 @@ func (r *itemXCharacterDataSqlRepository) UpdateOwnership(itemXCharacterData dom
 	return nil
 }
 
+func scanItemXCharacterData(row catalog.Scannable) (domain.ItemXCharacterData, error) {
+	var itemXCharacterData domain.ItemXCharacterData
+	err := row.Scan(
+		&itemXCharacterData.Character_Item_Id,
+		&itemXCharacterData.CharacterData_Id,
+		&itemXCharacterData.Item.Item_Id,
+		&itemXCharacterData.Item.Name,
+		&itemXCharacterData.Item.Weight,
+		&itemXCharacterData.Item.Price,
+		&itemXCharacterData.Item.Description,
+		&itemXCharacterData.Item.Campaign_Id,
+		&itemXCharacterData.Item.Rarity,
+		&itemXCharacterData.Item.RequiresAttunement,
+		&itemXCharacterData.Item.AttunementClasses,
+		&itemXCharacterData.Item.AttunementAlignments,
+		&itemXCharacterData.Item.Charges,
+		&itemXCharacterData.Item.Recharge,
+		&itemXCharacterData.Item.RechargeDice,
+		&itemXCharacterData.Item.ArmorClassBonus,
+		&itemXCharacterData.Item.SavingThrowBonus,
+		&itemXCharacterData.Item.AttackBonus,
+		&itemXCharacterData.Item.DamageBonus,
+		&itemXCharacterData.Quantity,
+		&itemXCharacterData.Attuned,
+		&itemXCharacterData.ChargesUsed,
+	)
+	return itemXCharacterData, err
+}
+
 func NewItemXCharacterDataSqlRepository(db *sql.DB) RepositoryItemXCharacterData {
 	return &itemXCharacterDataSqlRepository{db}
 }
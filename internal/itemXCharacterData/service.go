@@ -76,8 +76,13 @@ func (s *service) GetById(id int) (domain.ItemXCharacterData, error) {
 	return itemRelationship, nil
 }
 
-// Update implements ServiceItemXTableCharacter.
+// Update implements ServiceItemXTableCharacter. Attunement and spent charges
+// keep their stored values, they only change through their own endpoints.
 func (s *service) Update(itemXCharacterData domain.ItemXCharacterData) (domain.ItemXCharacterData, error) {
+	if stored, err := s.itemXCharacterDataRepo.GetById(itemXCharacterData.Character_Item_Id); err == nil {
+		itemXCharacterData.Attuned = stored.Attuned
+		itemXCharacterData.ChargesUsed = stored.ChargesUsed
+	}
     newItemRelationship, err := s.itemXCharacterDataRepo.Update(itemXCharacterData)
 	if err != nil {
         return domain.ItemXCharacterData{}, err
@@ -0,0 +1,28 @@
+package journal
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryJournal interface {
+	Create(entry domain.JournalEntry) (domain.JournalEntry, error)
+	Update(entry domain.JournalEntry) error
+	Delete(id int) error
+	DeleteByCharacterId(characterId int) error
+	GetById(id int) (domain.JournalEntry, error)
+	GetByCharacterId(characterId int, viewerId string) ([]domain.JournalEntry, error)
+	Search(viewerId string, query string, characterId *int, sessionId *int) ([]domain.JournalEntry, error)
+	IsVisible(id int, viewerId string) (bool, error)
+	GetCharacterOwner(characterId int) (*string, error)
+}
+
+type ServiceJournal interface {
+	Create(characterId int, entry dto.JournalEntryDto, cookie string) (domain.JournalEntry, error)
+	Update(id int, entry dto.JournalEntryDto, cookie string) (domain.JournalEntry, error)
+	Delete(id int, cookie string) error
+	DeleteByCharacterId(characterId int) error
+	GetById(id int, cookie string) (domain.JournalEntry, error)
+	GetByCharacterId(characterId int, cookie string) ([]domain.JournalEntry, error)
+	Search(query string, characterId *int, sessionId *int, cookie string) ([]domain.JournalEntry, error)
+}
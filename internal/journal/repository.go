@@ -0,0 +1,195 @@
+package journal
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrNotFound          = errors.New("journal entry not found")
+	ErrCharacterNotFound = errors.New("character not found")
+)
+
+// likeEscaper keeps wildcards typed by users from acting as LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+type journalMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewJournalRepository(db *sql.DB) RepositoryJournal {
+	return &journalMySqlRepository{db: db}
+}
+
+// Create implements RepositoryJournal.
+func (r *journalMySqlRepository) Create(entry domain.JournalEntry) (domain.JournalEntry, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(QueryCreate, entry.CharacterId, entry.SessionId, entry.Title, entry.Content, entry.Visibility, entry.CreatedAt, entry.UpdatedAt)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	entry.JournalEntryId = int(id)
+	if err := insertAttachments(tx, entry); err != nil {
+		return domain.JournalEntry{}, err
+	}
+	return entry, tx.Commit()
+}
+
+// Update implements RepositoryJournal. The attachments are replaced.
+func (r *journalMySqlRepository) Update(entry domain.JournalEntry) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryUpdate, entry.SessionId, entry.Title, entry.Content, entry.Visibility, entry.UpdatedAt, entry.JournalEntryId); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(QueryDeleteAttachments, entry.JournalEntryId); err != nil {
+		return err
+	}
+	if err := insertAttachments(tx, entry); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Delete implements RepositoryJournal.
+func (r *journalMySqlRepository) Delete(id int) error {
+	if _, err := r.db.Exec(QueryDeleteAttachments, id); err != nil {
+		return err
+	}
+	_, err := r.db.Exec(QueryDelete, id)
+	return err
+}
+
+// DeleteByCharacterId implements RepositoryJournal.
+func (r *journalMySqlRepository) DeleteByCharacterId(characterId int) error {
+	if _, err := r.db.Exec(QueryDeleteCharacterAttachments, characterId); err != nil {
+		return err
+	}
+	_, err := r.db.Exec(QueryDeleteByCharacterId, characterId)
+	return err
+}
+
+// GetById implements RepositoryJournal.
+func (r *journalMySqlRepository) GetById(id int) (domain.JournalEntry, error) {
+	entries, err := r.query(QueryGetById, id)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	if len(entries) == 0 {
+		return domain.JournalEntry{}, ErrNotFound
+	}
+	return entries[0], nil
+}
+
+// GetByCharacterId implements RepositoryJournal. Only the entries the viewer
+// may read are returned, newest first.
+func (r *journalMySqlRepository) GetByCharacterId(characterId int, viewerId string) ([]domain.JournalEntry, error) {
+	return r.query(QueryGetByCharacterId, characterId, viewerId, viewerId, viewerId)
+}
+
+// Search implements RepositoryJournal. It matches the query against titles
+// and content of the entries the viewer may read.
+func (r *journalMySqlRepository) Search(viewerId string, query string, characterId *int, sessionId *int) ([]domain.JournalEntry, error) {
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+	return r.query(QuerySearch, viewerId, viewerId, viewerId, pattern, pattern, characterId, characterId, sessionId, sessionId)
+}
+
+// IsVisible implements RepositoryJournal.
+func (r *journalMySqlRepository) IsVisible(id int, viewerId string) (bool, error) {
+	var count int
+	if err := r.db.QueryRow(QueryIsVisible, id, viewerId, viewerId, viewerId).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetCharacterOwner implements RepositoryJournal. Generic characters have no
+// owner.
+func (r *journalMySqlRepository) GetCharacterOwner(characterId int) (*string, error) {
+	var owner sql.NullString
+	err := r.db.QueryRow(QueryGetCharacterOwner, characterId).Scan(&owner)
+	if err == sql.ErrNoRows {
+		return nil, ErrCharacterNotFound
+	}
+	if err != nil || !owner.Valid {
+		return nil, err
+	}
+	return &owner.String, nil
+}
+
+func (r *journalMySqlRepository) query(query string, args ...any) ([]domain.JournalEntry, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []domain.JournalEntry{}
+	for rows.Next() {
+		var entry domain.JournalEntry
+		var sessionId sql.NullInt64
+		if err := rows.Scan(&entry.JournalEntryId, &entry.CharacterId, &sessionId, &entry.Title, &entry.Content, &entry.Visibility, &entry.CreatedAt, &entry.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if sessionId.Valid {
+			id := int(sessionId.Int64)
+			entry.SessionId = &id
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range entries {
+		entries[i].Attachments, err = r.attachments(entries[i].JournalEntryId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func (r *journalMySqlRepository) attachments(entryId int) ([]string, error) {
+	rows, err := r.db.Query(QueryGetAttachments, entryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	urls := []string{}
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, err
+		}
+		urls = append(urls, url)
+	}
+	return urls, rows.Err()
+}
+
+func insertAttachments(tx *sql.Tx, entry domain.JournalEntry) error {
+	for _, url := range entry.Attachments {
+		if _, err := tx.Exec(QueryCreateAttachment, entry.JournalEntryId, url); err != nil {
+			return err
+		}
+	}
+	return nil
+}
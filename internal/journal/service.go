@@ -0,0 +1,216 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/user"
+	"github.com/proyecto-dnd/backend/pkg/storage"
+)
+
+const (
+	VisibilityPrivate = "private"
+	VisibilityDM      = "dm"
+	VisibilityParty   = "party"
+
+	attachmentFolder = "journal"
+)
+
+var (
+	ErrInvalidVisibility = errors.New("visibility must be private, dm or party")
+	ErrEmptyEntry        = errors.New("a journal entry needs a title or content")
+	ErrInvalidAttachment = errors.New("attachments must be image URLs or base64 encoded images")
+	ErrNotOwner          = errors.New("only the owner of the character can write its journal")
+	ErrEmptySearch       = errors.New("a search query is required")
+)
+
+type service struct {
+	repository  RepositoryJournal
+	userService user.ServiceUsers
+	store       storage.Storage
+}
+
+func NewJournalService(repository RepositoryJournal, userService user.ServiceUsers, store storage.Storage) ServiceJournal {
+	return &service{repository: repository, userService: userService, store: store}
+}
+
+// Create implements ServiceJournal. Entries are private unless another
+// visibility is given.
+func (s *service) Create(characterId int, request dto.JournalEntryDto, cookie string) (domain.JournalEntry, error) {
+	if err := s.checkOwner(characterId, cookie); err != nil {
+		return domain.JournalEntry{}, err
+	}
+	entry, err := s.build(request)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	entry.CharacterId = characterId
+	entry.CreatedAt = entry.UpdatedAt
+	return s.repository.Create(entry)
+}
+
+// Update implements ServiceJournal.
+func (s *service) Update(id int, request dto.JournalEntryDto, cookie string) (domain.JournalEntry, error) {
+	current, err := s.repository.GetById(id)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	if err := s.checkOwner(current.CharacterId, cookie); err != nil {
+		return domain.JournalEntry{}, err
+	}
+	entry, err := s.build(request)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	entry.JournalEntryId = current.JournalEntryId
+	entry.CharacterId = current.CharacterId
+	entry.CreatedAt = current.CreatedAt
+	if err := s.repository.Update(entry); err != nil {
+		s.deleteAttachments(entry.Attachments, current.Attachments)
+		return domain.JournalEntry{}, err
+	}
+	s.deleteAttachments(current.Attachments, entry.Attachments)
+	return entry, nil
+}
+
+// Delete implements ServiceJournal.
+func (s *service) Delete(id int, cookie string) error {
+	current, err := s.repository.GetById(id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkOwner(current.CharacterId, cookie); err != nil {
+		return err
+	}
+	if err := s.repository.Delete(id); err != nil {
+		return err
+	}
+	s.deleteAttachments(current.Attachments, nil)
+	return nil
+}
+
+// DeleteByCharacterId implements ServiceJournal.
+func (s *service) DeleteByCharacterId(characterId int) error {
+	return s.repository.DeleteByCharacterId(characterId)
+}
+
+// GetById implements ServiceJournal. Entries the user may not read are
+// reported as not found.
+func (s *service) GetById(id int, cookie string) (domain.JournalEntry, error) {
+	viewer, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	visible, err := s.repository.IsVisible(id, viewer.Id)
+	if err != nil {
+		return domain.JournalEntry{}, err
+	}
+	if !visible {
+		return domain.JournalEntry{}, ErrNotFound
+	}
+	return s.repository.GetById(id)
+}
+
+// GetByCharacterId implements ServiceJournal.
+func (s *service) GetByCharacterId(characterId int, cookie string) ([]domain.JournalEntry, error) {
+	viewer, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.GetByCharacterId(characterId, viewer.Id)
+}
+
+// Search implements ServiceJournal.
+func (s *service) Search(query string, characterId *int, sessionId *int, cookie string) ([]domain.JournalEntry, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptySearch
+	}
+	viewer, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.Search(viewer.Id, query, characterId, sessionId)
+}
+
+func (s *service) checkOwner(characterId int, cookie string) error {
+	viewer, err := s.userService.GetJwtInfo(cookie)
+	if err != nil {
+		return err
+	}
+	owner, err := s.repository.GetCharacterOwner(characterId)
+	if err != nil {
+		return err
+	}
+	if owner == nil || *owner != viewer.Id {
+		return ErrNotOwner
+	}
+	return nil
+}
+
+// build validates a request and uploads its new attachments. If any of them
+// fails, the ones already uploaded are removed again.
+func (s *service) build(request dto.JournalEntryDto) (domain.JournalEntry, error) {
+	entry := domain.JournalEntry{
+		SessionId:   request.SessionId,
+		Title:       strings.TrimSpace(request.Title),
+		Content:     request.Content,
+		Visibility:  request.Visibility,
+		Attachments: []string{},
+		UpdatedAt:   time.Now(),
+	}
+	if entry.Visibility == "" {
+		entry.Visibility = VisibilityPrivate
+	}
+	switch entry.Visibility {
+	case VisibilityPrivate, VisibilityDM, VisibilityParty:
+	default:
+		return domain.JournalEntry{}, ErrInvalidVisibility
+	}
+	if entry.Title == "" && strings.TrimSpace(entry.Content) == "" {
+		return domain.JournalEntry{}, ErrEmptyEntry
+	}
+
+	for _, attachment := range request.Attachments {
+		switch {
+		case strings.HasPrefix(attachment, "http://"), strings.HasPrefix(attachment, "https://"):
+			entry.Attachments = append(entry.Attachments, attachment)
+		case strings.HasPrefix(attachment, "data:"):
+			url, err := s.upload(attachment)
+			if err != nil {
+				s.deleteAttachments(entry.Attachments, request.Attachments)
+				return domain.JournalEntry{}, err
+			}
+			entry.Attachments = append(entry.Attachments, url)
+		default:
+			return domain.JournalEntry{}, ErrInvalidAttachment
+		}
+	}
+	return entry, nil
+}
+
+func (s *service) upload(dataURL string) (string, error) {
+	image, err := storage.DecodeDataURL(dataURL)
+	if err != nil {
+		return "", err
+	}
+	return storage.SaveImage(context.Background(), s.store, attachmentFolder, image)
+}
+
+// deleteAttachments removes the stored images in urls that are not kept.
+func (s *service) deleteAttachments(urls []string, kept []string) {
+	for _, url := range urls {
+		if slices.Contains(kept, url) {
+			continue
+		}
+		if err := storage.DeleteImage(context.Background(), s.store, url); err != nil {
+			log.Println(err)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package journal
+
+// visibleTo limits journal entries to the ones a user may read: every entry of
+// their own characters, entries shared with the dungeon master of the
+// character's campaign, and party entries for the players of that campaign.
+// It takes the user id three times.
+const visibleTo = `(c.user_id = ?
+	OR (j.visibility IN ('dm', 'party') AND ca.dungeon_master = ?)
+	OR (j.visibility = 'party' AND EXISTS (SELECT 1 FROM user_campaign uc WHERE uc.campaign_id = c.campaign_id AND uc.user_id = ?)))`
+
+const selectEntries = `SELECT j.journal_entry_id, j.character_id, j.session_id, j.title, j.content, j.visibility, j.created_at, j.updated_at
+	FROM character_journal j
+	INNER JOIN character_data c ON c.character_id = j.character_id
+	LEFT JOIN campaign ca ON ca.campaign_id = c.campaign_id`
+
+var (
+	QueryCreate              = `INSERT INTO character_journal (character_id, session_id, title, content, visibility, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?);`
+	QueryUpdate              = `UPDATE character_journal SET session_id = ?, title = ?, content = ?, visibility = ?, updated_at = ? WHERE journal_entry_id = ?;`
+	QueryDelete              = `DELETE FROM character_journal WHERE journal_entry_id = ?;`
+	QueryDeleteByCharacterId = `DELETE FROM character_journal WHERE character_id = ?;`
+	QueryGetById             = selectEntries + ` WHERE j.journal_entry_id = ?;`
+	QueryGetByCharacterId    = selectEntries + ` WHERE j.character_id = ? AND ` + visibleTo + ` ORDER BY j.created_at DESC;`
+	QuerySearch              = selectEntries + ` WHERE ` + visibleTo + `
+	AND (j.title LIKE ? OR j.content LIKE ?)
+	AND (? IS NULL OR j.character_id = ?)
+	AND (? IS NULL OR j.session_id = ?)
+	ORDER BY j.created_at DESC;`
+	QueryIsVisible         = `SELECT COUNT(*) FROM character_journal j INNER JOIN character_data c ON c.character_id = j.character_id LEFT JOIN campaign ca ON ca.campaign_id = c.campaign_id WHERE j.journal_entry_id = ? AND ` + visibleTo + `;`
+	QueryGetCharacterOwner = `SELECT user_id FROM character_data WHERE character_id = ?;`
+
+	QueryGetAttachments             = `SELECT url FROM journal_attachment WHERE journal_entry_id = ? ORDER BY journal_attachment_id;`
+	QueryCreateAttachment           = `INSERT INTO journal_attachment (journal_entry_id, url) VALUES (?, ?);`
+	QueryDeleteAttachments          = `DELETE FROM journal_attachment WHERE journal_entry_id = ?;`
+	QueryDeleteCharacterAttachments = `DELETE journal_attachment FROM journal_attachment INNER JOIN character_journal ON character_journal.journal_entry_id = journal_attachment.journal_entry_id WHERE character_journal.character_id = ?;`
+)
@@ -0,0 +1,27 @@
+package progression
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryProgression interface {
+	Create(row domain.ClassProgression) (domain.ClassProgression, error)
+	GetById(id int) (domain.ClassProgression, error)
+	GetByClassId(classId int) ([]domain.ClassProgression, error)
+	Update(row domain.ClassProgression) error
+	Delete(id int) error
+	DeleteBySubclassId(subclassId int) error
+}
+
+type ServiceProgression interface {
+	Create(classId int, row dto.ClassProgressionDto) (domain.ClassProgression, error)
+	GetById(id int) (domain.ClassProgression, error)
+	GetByClassId(classId int) ([]domain.ClassProgression, error)
+	Update(id int, row dto.ClassProgressionDto) (domain.ClassProgression, error)
+	Delete(id int) error
+	DeleteBySubclassId(subclassId int) error
+	// ForCharacter reads the rows of the character's classes and returns what
+	// they grant at its class levels.
+	ForCharacter(classes []domain.CharacterClass) (Grants, error)
+}
@@ -0,0 +1,249 @@
+package progression
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var ErrNotFound = errors.New("class progression not found")
+
+type progressionMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewProgressionRepository(db *sql.DB) RepositoryProgression {
+	return &progressionMySqlRepository{db: db}
+}
+
+// Create implements RepositoryProgression.
+func (r *progressionMySqlRepository) Create(row domain.ClassProgression) (domain.ClassProgression, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(QueryInsert, row.ClassId, row.SubclassId, row.Level, encodeSlots(row.SpellSlots))
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	row.ClassProgressionId = int(lastId)
+	if err := insertChildren(tx, row); err != nil {
+		return domain.ClassProgression{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.ClassProgression{}, err
+	}
+	return row, nil
+}
+
+// GetById implements RepositoryProgression.
+func (r *progressionMySqlRepository) GetById(id int) (domain.ClassProgression, error) {
+	rows, err := r.query(QueryGetById, QueryGetFeaturesById, QueryGetResourcesById, id)
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	if len(rows) == 0 {
+		return domain.ClassProgression{}, ErrNotFound
+	}
+	return rows[0], nil
+}
+
+// GetByClassId implements RepositoryProgression.
+func (r *progressionMySqlRepository) GetByClassId(classId int) ([]domain.ClassProgression, error) {
+	return r.query(QueryGetByClassId, QueryGetFeaturesByClassId, QueryGetResourcesByClassId, classId)
+}
+
+// Update implements RepositoryProgression. The features and resources of the
+// row are replaced.
+func (r *progressionMySqlRepository) Update(row domain.ClassProgression) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryUpdate, row.SubclassId, row.Level, encodeSlots(row.SpellSlots), row.ClassProgressionId); err != nil {
+		return err
+	}
+	if err := deleteChildren(tx, row.ClassProgressionId); err != nil {
+		return err
+	}
+	if err := insertChildren(tx, row); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Delete implements RepositoryProgression.
+func (r *progressionMySqlRepository) Delete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := deleteChildren(tx, id); err != nil {
+		return err
+	}
+	result, err := tx.Exec(QueryDelete, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// DeleteBySubclassId implements RepositoryProgression.
+func (r *progressionMySqlRepository) DeleteBySubclassId(subclassId int) error {
+	rows, err := r.db.Query(QueryGetIdsBySubclassId, subclassId)
+	if err != nil {
+		return err
+	}
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := r.Delete(id); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// query reads progression rows and attaches their features and resources,
+// all three queries taking the same argument.
+func (r *progressionMySqlRepository) query(rowsQuery string, featuresQuery string, resourcesQuery string, arg int) ([]domain.ClassProgression, error) {
+	rows, err := r.db.Query(rowsQuery, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progression := []domain.ClassProgression{}
+	index := map[int]int{}
+	for rows.Next() {
+		row := domain.ClassProgression{FeatureIds: []int{}, Resources: []domain.ProgressionResource{}}
+		var slots sql.NullString
+		if err := rows.Scan(&row.ClassProgressionId, &row.ClassId, &row.SubclassId, &row.Level, &slots); err != nil {
+			return nil, err
+		}
+		if row.SpellSlots, err = decodeSlots(slots.String); err != nil {
+			return nil, err
+		}
+		index[row.ClassProgressionId] = len(progression)
+		progression = append(progression, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	featureRows, err := r.db.Query(featuresQuery, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer featureRows.Close()
+	for featureRows.Next() {
+		var id, featureId int
+		if err := featureRows.Scan(&id, &featureId); err != nil {
+			return nil, err
+		}
+		if i, ok := index[id]; ok {
+			progression[i].FeatureIds = append(progression[i].FeatureIds, featureId)
+		}
+	}
+	if err := featureRows.Err(); err != nil {
+		return nil, err
+	}
+
+	resourceRows, err := r.db.Query(resourcesQuery, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer resourceRows.Close()
+	for resourceRows.Next() {
+		var id int
+		var resource domain.ProgressionResource
+		if err := resourceRows.Scan(&id, &resource.Name, &resource.Maximum); err != nil {
+			return nil, err
+		}
+		if i, ok := index[id]; ok {
+			progression[i].Resources = append(progression[i].Resources, resource)
+		}
+	}
+	return progression, resourceRows.Err()
+}
+
+func insertChildren(tx *sql.Tx, row domain.ClassProgression) error {
+	for _, featureId := range row.FeatureIds {
+		if _, err := tx.Exec(QueryInsertFeature, row.ClassProgressionId, featureId); err != nil {
+			return err
+		}
+	}
+	for _, resource := range row.Resources {
+		if _, err := tx.Exec(QueryInsertResource, row.ClassProgressionId, resource.Name, resource.Maximum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteChildren(tx *sql.Tx, id int) error {
+	if _, err := tx.Exec(QueryDeleteFeatures, id); err != nil {
+		return err
+	}
+	_, err := tx.Exec(QueryDeleteResources, id)
+	return err
+}
+
+// encodeSlots stores a slot row as a comma separated list, or NULL when the
+// row leaves slots to the class rules.
+func encodeSlots(slots []int) any {
+	if len(slots) == 0 {
+		return nil
+	}
+	values := make([]string, len(slots))
+	for i, count := range slots {
+		values[i] = strconv.Itoa(count)
+	}
+	return strings.Join(values, ",")
+}
+
+func decodeSlots(encoded string) ([]int, error) {
+	slots := []int{}
+	if encoded == "" {
+		return slots, nil
+	}
+	for _, value := range strings.Split(encoded, ",") {
+		count, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, count)
+	}
+	return slots, nil
+}
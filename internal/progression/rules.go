@@ -0,0 +1,70 @@
+package progression
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+// Grants is what the progression rows give a character at its class levels.
+type Grants struct {
+	FeatureIds []int
+	// SpellSlots is the slot row of a single class character whose rows set
+	// one, and nil otherwise, leaving slots to the class rules.
+	SpellSlots []int
+	// ResourceMaxima holds the maximum formula of each resource the rows
+	// name, keyed by lower case name.
+	ResourceMaxima map[string]string
+}
+
+// Grant applies the rows of a character's classes. A row counts when the
+// character has its class at its level or higher and, for subclass rows, chose
+// that subclass. Later levels override the slots and maxima of earlier ones,
+// and subclass rows those of the class at the same level.
+func Grant(classes []domain.CharacterClass, rows []domain.ClassProgression) Grants {
+	grants := Grants{FeatureIds: []int{}, ResourceMaxima: map[string]string{}}
+	applied := []domain.ClassProgression{}
+	for _, row := range rows {
+		for _, characterClass := range classes {
+			if applies(row, characterClass) {
+				applied = append(applied, row)
+				break
+			}
+		}
+	}
+	sort.SliceStable(applied, func(i, j int) bool {
+		if applied[i].Level != applied[j].Level {
+			return applied[i].Level < applied[j].Level
+		}
+		return applied[i].SubclassId == nil && applied[j].SubclassId != nil
+	})
+
+	granted := map[int]bool{}
+	for _, row := range applied {
+		for _, featureId := range row.FeatureIds {
+			if !granted[featureId] {
+				granted[featureId] = true
+				grants.FeatureIds = append(grants.FeatureIds, featureId)
+			}
+		}
+		for _, resource := range row.Resources {
+			grants.ResourceMaxima[strings.ToLower(strings.TrimSpace(resource.Name))] = resource.Maximum
+		}
+		// Multiclass characters use the multiclass spellcaster table instead.
+		if len(classes) == 1 && len(row.SpellSlots) > 0 {
+			grants.SpellSlots = row.SpellSlots
+		}
+	}
+	return grants
+}
+
+func applies(row domain.ClassProgression, characterClass domain.CharacterClass) bool {
+	if row.ClassId != characterClass.Class.ClassId || row.Level > characterClass.Level {
+		return false
+	}
+	if row.SubclassId == nil {
+		return true
+	}
+	return characterClass.Subclass != nil && characterClass.Subclass.SubclassId == *row.SubclassId
+}
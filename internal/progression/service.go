@@ -0,0 +1,145 @@
+package progression
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/resource"
+	"github.com/proyecto-dnd/backend/internal/subclass"
+)
+
+// maxSpellLevel bounds the length of a slot row.
+const maxSpellLevel = 9
+
+var (
+	ErrInvalidLevel      = errors.New("level must be between 1 and 20")
+	ErrUnknownFeature    = errors.New("unknown feature")
+	ErrInvalidSpellSlots = errors.New("spell slots must list at most 9 spell levels and no negative counts")
+	ErrInvalidResource   = errors.New("resources need a name and a valid maximum")
+)
+
+type service struct {
+	repository      RepositoryProgression
+	classService    class.ClassService
+	subclassService subclass.ServiceSubclass
+	featureService  feature.FeatureService
+}
+
+func NewProgressionService(repository RepositoryProgression, classService class.ClassService, subclassService subclass.ServiceSubclass, featureService feature.FeatureService) ServiceProgression {
+	return &service{repository: repository, classService: classService, subclassService: subclassService, featureService: featureService}
+}
+
+// Create implements ServiceProgression.
+func (s *service) Create(classId int, request dto.ClassProgressionDto) (domain.ClassProgression, error) {
+	if _, err := s.classService.GetById(classId); err != nil {
+		return domain.ClassProgression{}, err
+	}
+	row, err := s.validate(classId, request)
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	return s.repository.Create(row)
+}
+
+// GetById implements ServiceProgression.
+func (s *service) GetById(id int) (domain.ClassProgression, error) {
+	return s.repository.GetById(id)
+}
+
+// GetByClassId implements ServiceProgression.
+func (s *service) GetByClassId(classId int) ([]domain.ClassProgression, error) {
+	return s.repository.GetByClassId(classId)
+}
+
+// Update implements ServiceProgression. A row stays under its class.
+func (s *service) Update(id int, request dto.ClassProgressionDto) (domain.ClassProgression, error) {
+	current, err := s.repository.GetById(id)
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	row, err := s.validate(current.ClassId, request)
+	if err != nil {
+		return domain.ClassProgression{}, err
+	}
+	row.ClassProgressionId = id
+	if err := s.repository.Update(row); err != nil {
+		return domain.ClassProgression{}, err
+	}
+	return row, nil
+}
+
+// Delete implements ServiceProgression.
+func (s *service) Delete(id int) error {
+	return s.repository.Delete(id)
+}
+
+// DeleteBySubclassId implements ServiceProgression.
+func (s *service) DeleteBySubclassId(subclassId int) error {
+	return s.repository.DeleteBySubclassId(subclassId)
+}
+
+// ForCharacter implements ServiceProgression.
+func (s *service) ForCharacter(classes []domain.CharacterClass) (Grants, error) {
+	rows := []domain.ClassProgression{}
+	for _, characterClass := range classes {
+		classRows, err := s.repository.GetByClassId(characterClass.Class.ClassId)
+		if err != nil {
+			return Grants{}, err
+		}
+		rows = append(rows, classRows...)
+	}
+	return Grant(classes, rows), nil
+}
+
+func (s *service) validate(classId int, request dto.ClassProgressionDto) (domain.ClassProgression, error) {
+	if request.Level < 1 || request.Level > 20 {
+		return domain.ClassProgression{}, ErrInvalidLevel
+	}
+	if request.SubclassId != nil {
+		chosen, err := s.subclassService.GetById(*request.SubclassId)
+		if err != nil {
+			return domain.ClassProgression{}, err
+		}
+		if chosen.ClassId != classId {
+			return domain.ClassProgression{}, subclass.ErrOtherClass
+		}
+	}
+
+	row := domain.ClassProgression{
+		ClassId:    classId,
+		SubclassId: request.SubclassId,
+		Level:      request.Level,
+		FeatureIds: []int{},
+		SpellSlots: []int{},
+		Resources:  []domain.ProgressionResource{},
+	}
+	for _, featureId := range request.FeatureIds {
+		if _, err := s.featureService.GetFeatureById(featureId); err != nil {
+			return domain.ClassProgression{}, fmt.Errorf("%w: %d", ErrUnknownFeature, featureId)
+		}
+		row.FeatureIds = append(row.FeatureIds, featureId)
+	}
+	if len(request.SpellSlots) > maxSpellLevel {
+		return domain.ClassProgression{}, ErrInvalidSpellSlots
+	}
+	for _, count := range request.SpellSlots {
+		if count < 0 {
+			return domain.ClassProgression{}, ErrInvalidSpellSlots
+		}
+		row.SpellSlots = append(row.SpellSlots, count)
+	}
+	for _, definition := range request.Resources {
+		definition.Name = strings.TrimSpace(definition.Name)
+		// Formulas only read the character, so an empty one tells whether they parse.
+		if _, err := resource.Maximum(definition.Maximum, dto.FullCharacterData{}); definition.Name == "" || err != nil {
+			return domain.ClassProgression{}, ErrInvalidResource
+		}
+		row.Resources = append(row.Resources, definition)
+	}
+	return row, nil
+}
@@ -0,0 +1,25 @@
+package progression
+
+var (
+	QueryInsert = `INSERT INTO class_progression (class_id, subclass_id, level, spell_slots) VALUES (?, ?, ?, ?);`
+	QueryUpdate = `UPDATE class_progression SET subclass_id = ?, level = ?, spell_slots = ? WHERE class_progression_id = ?;`
+	QueryDelete = `DELETE FROM class_progression WHERE class_progression_id = ?;`
+
+	QueryInsertFeature  = `INSERT INTO class_progression_feature (class_progression_id, feature_id) VALUES (?, ?);`
+	QueryDeleteFeatures = `DELETE FROM class_progression_feature WHERE class_progression_id = ?;`
+
+	QueryInsertResource  = `INSERT INTO class_progression_resource (class_progression_id, name, maximum) VALUES (?, ?, ?);`
+	QueryDeleteResources = `DELETE FROM class_progression_resource WHERE class_progression_id = ?;`
+
+	QueryGetById          = `SELECT class_progression_id, class_id, subclass_id, level, spell_slots FROM class_progression WHERE class_progression_id = ?;`
+	QueryGetFeaturesById  = `SELECT class_progression_id, feature_id FROM class_progression_feature WHERE class_progression_id = ? ORDER BY feature_id;`
+	QueryGetResourcesById = `SELECT class_progression_id, name, maximum FROM class_progression_resource WHERE class_progression_id = ? ORDER BY class_progression_resource_id;`
+
+	QueryGetByClassId         = `SELECT class_progression_id, class_id, subclass_id, level, spell_slots FROM class_progression WHERE class_id = ? ORDER BY level, subclass_id, class_progression_id;`
+	QueryGetFeaturesByClassId = `SELECT cpf.class_progression_id, cpf.feature_id FROM class_progression_feature cpf
+	INNER JOIN class_progression cp ON cpf.class_progression_id = cp.class_progression_id WHERE cp.class_id = ? ORDER BY cpf.feature_id;`
+	QueryGetResourcesByClassId = `SELECT cpr.class_progression_id, cpr.name, cpr.maximum FROM class_progression_resource cpr
+	INNER JOIN class_progression cp ON cpr.class_progression_id = cp.class_progression_id WHERE cp.class_id = ? ORDER BY cpr.class_progression_resource_id;`
+
+	QueryGetIdsBySubclassId = `SELECT class_progression_id FROM class_progression WHERE subclass_id = ?;`
+)
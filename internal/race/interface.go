@@ -2,6 +2,7 @@ package race
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -20,6 +21,7 @@ type RaceService interface {
 	GetRaceByID(id int) (domain.Race, error)
 	UpdateRace(race dto.CreateRaceDto, id int) (domain.Race, error)
 	DeleteRace(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Race], error)
 }
 
 type RaceRepository interface {
@@ -28,4 +30,5 @@ type RaceRepository interface {
 	GetRaceById(id int) (domain.Race, error)
 	UpdateRace(race domain.Race, id int) (domain.Race, error)
 	DeleteRace(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Race], error)
 }
@@ -4,7 +4,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -38,6 +41,7 @@ func (r *raceMySqlRepository) Create(race domain.Race) (domain.Race, error) {
 		race.Con,
 		race.Wiz,
 		race.Cha,
+		race.CampaignId,
 	)
 	if err != nil {
 		return domain.Race{}, err
@@ -73,6 +77,7 @@ func (r *raceMySqlRepository) GetAllRaces() ([]domain.Race, error) {
 			&race.Con,
 			&race.Wiz,
 			&race.Cha,
+			&race.CampaignId,
 		); err != nil {
 			return nil, err
 		}
@@ -95,6 +100,7 @@ func (r *raceMySqlRepository) GetRaceById(id int) (domain.Race, error) {
 		&race.Con,
 		&race.Wiz,
 		&race.Cha,
+		&race.CampaignId,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -123,6 +129,7 @@ func (r *raceMySqlRepository) UpdateRace(race domain.Race, id int) (domain.Race,
 		race.Con,
 		race.Wiz,
 		race.Cha,
+		race.CampaignId,
 		id,
 	)
 	if err != nil {
@@ -133,13 +140,43 @@ func (r *raceMySqlRepository) UpdateRace(race domain.Race, id int) (domain.Race,
 	return race, nil
 }
 
+// DeleteRace implements RaceRepository. The translations of the race go with
+// it.
 func (r *raceMySqlRepository) DeleteRace(id int) error {
-	statement, err := r.db.Prepare(QueryDeleteRace)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return ErrPrepareStatementRace
+		return err
 	}
-	defer statement.Close()
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryDeleteRace, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Search implements RaceRepository.
+func (r *raceMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Race], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanRace)
+}
 
-	_, err = statement.Exec(id)
-	return err
+func scanRace(row catalog.Scannable) (domain.Race, error) {
+	var race domain.Race
+	err := row.Scan(
+		&race.RaceID,
+		&race.Name,
+		&race.Description,
+		&race.Speed,
+		&race.Str,
+		&race.Dex,
+		&race.Int,
+		&race.Con,
+		&race.Wiz,
+		&race.Cha,
+		&race.CampaignId,
+	)
+	return race, err
 }
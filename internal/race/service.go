@@ -2,6 +2,7 @@ package race
 
 import (
 	"fmt"
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -25,6 +26,7 @@ func (s *raceService) CreateRace(raceDto dto.CreateRaceDto) (domain.Race, error)
 		Con:         raceDto.Con,
 		Wiz:         raceDto.Wiz,
 		Cha:         raceDto.Cha,
+		CampaignId:  raceDto.CampaignId,
 	}
 
 	createdRace, err := s.raceRepo.Create(raceDomain)
@@ -64,6 +66,7 @@ func (s *raceService) UpdateRace(raceDto dto.CreateRaceDto, id int) (domain.Race
 		Con:         raceDto.Con,
 		Wiz:         raceDto.Wiz,
 		Cha:         raceDto.Cha,
+		CampaignId:  raceDto.CampaignId,
 	}
 
 	updatedRace, err := s.raceRepo.UpdateRace(raceDomain, id)
@@ -78,3 +81,8 @@ func (s *raceService) UpdateRace(raceDto dto.CreateRaceDto, id int) (domain.Race
 func (s *raceService) DeleteRace(id int) error {
 	return s.raceRepo.DeleteRace(id)
 }
+
+// Search implements RaceService.
+func (s *raceService) Search(params catalog.Params) (catalog.Page[domain.Race], error) {
+	return s.raceRepo.Search(params)
+}
@@ -1,7 +1,9 @@
 package race
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
-	QueryCreateRace = "INSERT INTO race (name, description, speed, str, dex, `int`, con, wiz, cha) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);	"
+	QueryCreateRace = "INSERT INTO race (name, description, speed, str, dex, `int`, con, wiz, cha, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);	"
 
 	QueryGetAllRaces = `
 		SELECT * FROM race;
@@ -11,7 +13,17 @@ var (
 		SELECT * FROM race WHERE race_id = ?;
 	`
 
-	QueryUpdateRace = "UPDATE race SET name = ?, description = ?, speed = ?, str = ?, dex = ?, `int` = ?, con = ?, wiz = ?, cha = ? WHERE race_id = ?;"
+	QueryUpdateRace = "UPDATE race SET name = ?, description = ?, speed = ?, str = ?, dex = ?, `int` = ?, con = ?, wiz = ?, cha = ?, campaign_id = ? WHERE race_id = ?;"
 
 	QueryDeleteRace = `DELETE FROM race WHERE race_id = ?;`
 )
+
+// CatalogTable describes how GET /race searches and sorts races.
+var CatalogTable = catalog.Table{
+	Name:           "race",
+	Id:             "race_id",
+	Search:         []string{"name", "description"},
+	Sorts:          map[string]string{"name": "name", "speed": "speed"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
@@ -154,6 +154,8 @@ func generateCharacterDataHeaders(excelFile *excelize.File) {
 	excelFile.SetCellValue("Character Data", "AY1", "armor_class")
 	excelFile.SetCellValue("Character Data", "AZ1", "level")
 	excelFile.SetCellValue("Character Data", "BA1", "exp")
+	excelFile.SetCellValue("Character Data", "BB1", "subrace_id")
+	excelFile.SetCellValue("Character Data", "BC1", "subrace")
 }
 
 func insertCharacterDataRow(excelFile *excelize.File, characterData *dto.FullCharacterData, index int) {
@@ -212,6 +214,10 @@ func insertCharacterDataRow(excelFile *excelize.File, characterData *dto.FullCha
 	excelFile.SetCellValue("Character Data", "AY"+strconv.Itoa(index+2), characterData.Armor_Class)
 	excelFile.SetCellValue("Character Data", "AZ"+strconv.Itoa(index+2), characterData.Level)
 	excelFile.SetCellValue("Character Data", "BA"+strconv.Itoa(index+2), characterData.Exp)
+	if characterData.Subrace != nil {
+		excelFile.SetCellValue("Character Data", "BB"+strconv.Itoa(index+2), characterData.Subrace.SubraceId)
+		excelFile.SetCellValue("Character Data", "BC"+strconv.Itoa(index+2), characterData.Subrace.Name)
+	}
 }
 
 func generateItemXCharacterDataHeaders(excelFile *excelize.File) {
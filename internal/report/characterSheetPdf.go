@@ -0,0 +1,394 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
+	"github.com/proyecto-dnd/backend/pkg/pdf"
+	"github.com/proyecto-dnd/backend/pkg/stats"
+)
+
+const (
+	sheetMargin      = 40.0
+	sheetBodySize    = 9.5
+	sheetLineHeight  = 12.5
+	sheetHeadingSize = 12.0
+)
+
+type sheetWriter struct {
+	doc  *pdf.Document
+	page *pdf.Page
+	y    float64
+}
+
+// GenerateCharacterSheetPdf writes the sheet with its catalog entries
+// translated to locale.
+func (r *ReportGenerator) GenerateCharacterSheetPdf(id int, locale string) (*bytes.Buffer, error) {
+	character, err := r.characterDataService.GetById(id)
+	if err != nil {
+		return &bytes.Buffer{}, err
+	}
+	if err := translation.LocalizeCharacter(r.translationService, locale, &character); err != nil {
+		return &bytes.Buffer{}, err
+	}
+
+	sheet := &sheetWriter{doc: pdf.New(character.Name)}
+	sheet.newPage()
+
+	writeSheetHeader(sheet, &character)
+	writeSheetCombat(sheet, &character)
+	writeSheetAbilities(sheet, &character)
+	writeSheetSavingThrows(sheet, &character)
+	writeSheetSkills(sheet, &character)
+	writeSheetProficiencies(sheet, &character)
+	writeSheetInventory(sheet, &character)
+	writeSheetSpells(sheet, &character)
+	writeSheetFeatures(sheet, &character)
+	writeSheetStory(sheet, &character)
+
+	buffer := &bytes.Buffer{}
+	if err := sheet.doc.Write(buffer); err != nil {
+		return &bytes.Buffer{}, err
+	}
+	return buffer, nil
+}
+
+func writeSheetHeader(sheet *sheetWriter, character *dto.FullCharacterData) {
+	sheet.page.Text(sheetMargin, sheet.y+18, 20, pdf.Bold, character.Name)
+	sheet.y += 30
+	classes := []string{}
+	for _, characterClass := range character.Classes {
+		classes = append(classes, fmt.Sprintf("%s %d", characterClass.Class.Name, characterClass.Level))
+	}
+	if len(classes) == 0 {
+		classes = append(classes, fmt.Sprintf("%s %d", character.Class.Name, character.Level))
+	}
+	race := character.Race.Name
+	if character.Subrace != nil {
+		race = fmt.Sprintf("%s (%s)", race, character.Subrace.Name)
+	}
+	sheet.line(pdf.Regular, fmt.Sprintf("%s  |  %s  |  %s  |  %s", strings.Join(classes, " / "), race, character.Background.Name, character.Alignment))
+	sheet.line(pdf.Regular, fmt.Sprintf("Experience: %d  |  Age: %d  |  Height: %d  |  Weight: %d  |  Hair: %s  |  Eyes: %s  |  Skin: %s",
+		character.Exp, character.Age, character.Height, character.Weight, character.Hair, character.Eyes, character.Skin))
+	sheet.y += 4
+	sheet.page.Line(sheetMargin, sheet.y, pdf.PageWidth-sheetMargin, sheet.y, 1)
+	sheet.y += 8
+}
+
+func writeSheetCombat(sheet *sheetWriter, character *dto.FullCharacterData) {
+	boxes := []struct {
+		label string
+		value string
+	}{
+		{"Armor Class", strconv.Itoa(armorClass(character))},
+		{"Hit Points", strconv.Itoa(character.Hitpoints)},
+		{"Hit Dice", hitDiceLabel(character)},
+		{"Speed", strconv.Itoa(character.Speed)},
+		{"Initiative", formatModifier(stats.Modifier(character.Dex))},
+		{"Proficiency", formatModifier(stats.ProficiencyBonus(character.Level))},
+	}
+	sheet.ensureSpace(50)
+	width := (pdf.PageWidth - 2*sheetMargin) / float64(len(boxes))
+	for i, box := range boxes {
+		x := sheetMargin + float64(i)*width
+		sheet.page.Rect(x+2, sheet.y, width-4, 40, 0.8)
+		drawCentered(sheet.page, x, width, sheet.y+20, 14, pdf.Bold, box.value)
+		drawCentered(sheet.page, x, width, sheet.y+34, 7.5, pdf.Regular, box.label)
+	}
+	sheet.y += 50
+}
+
+// armorClass prefers the value derived from equipped armor; without any the
+// stored armor class is kept, as it may come from class features.
+func armorClass(character *dto.FullCharacterData) int {
+	if character.Equipment.BodyArmor != nil || character.Equipment.Shield != nil {
+		return character.Equipment.ArmorClass
+	}
+	return character.Armor_Class
+}
+
+func writeSheetAbilities(sheet *sheetWriter, character *dto.FullCharacterData) {
+	sheet.heading("Abilities")
+	abilities := []struct {
+		label string
+		score int
+	}{
+		{"STR", character.Str},
+		{"DEX", character.Dex},
+		{"CON", character.Con},
+		{"INT", character.Int},
+		{"WIS", character.Wiz},
+		{"CHA", character.Cha},
+	}
+	sheet.ensureSpace(56)
+	width := (pdf.PageWidth - 2*sheetMargin) / float64(len(abilities))
+	for i, ability := range abilities {
+		x := sheetMargin + float64(i)*width
+		sheet.page.Rect(x+2, sheet.y, width-4, 50, 0.8)
+		drawCentered(sheet.page, x, width, sheet.y+12, 8, pdf.Bold, ability.label)
+		drawCentered(sheet.page, x, width, sheet.y+31, 16, pdf.Bold, formatModifier(stats.Modifier(ability.score)))
+		drawCentered(sheet.page, x, width, sheet.y+44, 9, pdf.Regular, strconv.Itoa(ability.score))
+	}
+	sheet.y += 58
+}
+
+func writeSheetSavingThrows(sheet *sheetWriter, character *dto.FullCharacterData) {
+	if len(character.SavingThrows) == 0 {
+		return
+	}
+	sheet.heading("Saving Throws")
+	for _, savingThrow := range character.SavingThrows {
+		label := strings.ToUpper(savingThrow.Ability)
+		if savingThrow.Ability == "wiz" {
+			label = "WIS"
+		}
+		line := fmt.Sprintf("%s %s", formatModifier(savingThrow.Bonus), label)
+		if savingThrow.Proficient {
+			line += " (proficient)"
+		}
+		sheet.line(pdf.Regular, line)
+	}
+}
+
+func writeSheetSkills(sheet *sheetWriter, character *dto.FullCharacterData) {
+	if len(character.Skills) == 0 {
+		return
+	}
+	sheet.heading("Skill Proficiencies")
+	for _, skill := range character.Skills {
+		bonus := stats.ProficiencyBonus(character.Level)
+		if score, ok := abilityScoreByStat(character, skill.Stat); ok {
+			bonus += stats.Modifier(score)
+		}
+		sheet.line(pdf.Regular, fmt.Sprintf("%s %s (%s)", formatModifier(bonus), skill.Name, skill.Stat))
+	}
+}
+
+func writeSheetProficiencies(sheet *sheetWriter, character *dto.FullCharacterData) {
+	sheet.heading("Proficiencies")
+	labeled := []struct {
+		label string
+		value string
+	}{
+		{"Armor", character.Class.ArmorProficiencies},
+		{"Weapons", character.Class.WeaponProficiencies},
+		{"Tools", strings.Trim(character.Class.ToolProficiencies+", "+character.Background.ToolProficiencies, ", ")},
+		{"Languages", character.Background.Languages},
+	}
+	for _, entry := range labeled {
+		if entry.value != "" {
+			sheet.paragraph(entry.label+": "+entry.value, 0)
+		}
+	}
+	for _, proficiency := range character.Proficiencies {
+		sheet.line(pdf.Regular, fmt.Sprintf("%s (%s)", proficiency.Name, proficiency.Type))
+	}
+}
+
+func writeSheetInventory(sheet *sheetWriter, character *dto.FullCharacterData) {
+	coins := character.Wallet
+	if len(character.Weapons)+len(character.Armor)+len(character.Items) == 0 && coins == (domain.Currency{}) {
+		return
+	}
+	sheet.heading("Inventory")
+	sheet.line(pdf.Regular, fmt.Sprintf("CP %d  SP %d  EP %d  GP %d  PP %d", coins.Cp, coins.Sp, coins.Ep, coins.Gp, coins.Pp))
+	load := fmt.Sprintf("Carried %d / %d lb", character.Encumbrance.CarriedWeight, character.Encumbrance.CarryingCapacity)
+	switch {
+	case character.Encumbrance.HeavilyEncumbered:
+		load += " (heavily encumbered)"
+	case character.Encumbrance.Encumbered:
+		load += " (encumbered)"
+	}
+	if character.Encumbrance.SpeedPenalty > 0 {
+		load += fmt.Sprintf(", speed -%d", character.Encumbrance.SpeedPenalty)
+	}
+	sheet.line(pdf.Bold, load)
+	for _, attack := range character.Equipment.Attacks {
+		hand := strings.ReplaceAll(attack.Hand, "_", " ")
+		sheet.line(pdf.Regular, fmt.Sprintf("Attack: %s (%s) %s to hit, %s %s", attack.Name, hand, formatModifier(attack.AttackBonus), attack.Damage, attack.DamageType))
+	}
+	for _, weapon := range character.Weapons {
+		line := fmt.Sprintf("%s%s - %s %s", equippedMark(weapon.Equipped), weapon.Weapon.Name, weapon.Weapon.Damage, weapon.Weapon.Damage_Type)
+		if weapon.Weapon.Versatile_Damage != "" {
+			line += fmt.Sprintf(" (versatile %s)", weapon.Weapon.Versatile_Damage)
+		}
+		sheet.line(pdf.Regular, line)
+	}
+	for _, armor := range character.Armor {
+		sheet.line(pdf.Regular, fmt.Sprintf("%s%s - AC %d (%s)", equippedMark(armor.Equipped), armor.Armor.Name, armor.Armor.ArmorClass, armor.Armor.Category))
+	}
+	for _, item := range character.Items {
+		sheet.line(pdf.Regular, fmt.Sprintf("%dx %s", item.Quantity, item.Item.Name))
+	}
+}
+
+func writeSheetSpells(sheet *sheetWriter, character *dto.FullCharacterData) {
+	if len(character.Spells) == 0 {
+		return
+	}
+	sheet.heading("Spells")
+	if character.Class.SpellcastingAbility != "" {
+		sheet.line(pdf.Regular, "Spellcasting ability: "+character.Class.SpellcastingAbility)
+	}
+	if len(character.SpellSlots.Slots) > 0 {
+		slots := make([]string, len(character.SpellSlots.Slots))
+		for i, count := range character.SpellSlots.Slots {
+			slots[i] = fmt.Sprintf("%d: %d", i+1, count)
+		}
+		sheet.line(pdf.Regular, "Spell slots: "+strings.Join(slots, "  "))
+	}
+	if character.SpellSlots.PactSlots > 0 {
+		sheet.line(pdf.Regular, fmt.Sprintf("Pact slots: %d of level %d", character.SpellSlots.PactSlots, character.SpellSlots.PactSlotLevel))
+	}
+	byLevel := map[int][]string{}
+	for _, spell := range character.Spells {
+		byLevel[spell.Level] = append(byLevel[spell.Level], spell.Name)
+	}
+	levels := make([]int, 0, len(byLevel))
+	for level := range byLevel {
+		levels = append(levels, level)
+	}
+	sort.Ints(levels)
+	for _, level := range levels {
+		label := "Cantrips"
+		if level > 0 {
+			label = fmt.Sprintf("Level %d", level)
+		}
+		names := byLevel[level]
+		sort.Strings(names)
+		sheet.paragraph(label+": "+strings.Join(names, ", "), 0)
+	}
+}
+
+func writeSheetFeatures(sheet *sheetWriter, character *dto.FullCharacterData) {
+	if len(character.Features) == 0 {
+		return
+	}
+	sheet.heading("Features & Traits")
+	for _, feature := range character.Features {
+		sheet.line(pdf.Bold, feature.Name)
+		if feature.Description != "" {
+			sheet.paragraph(feature.Description, 10)
+		}
+	}
+}
+
+func writeSheetStory(sheet *sheetWriter, character *dto.FullCharacterData) {
+	background := character.Background
+	entries := []struct {
+		label string
+		value string
+	}{
+		{"Personality Traits", background.PersonalityTraits},
+		{"Ideals", background.Ideals},
+		{"Bonds", background.Bond},
+		{"Flaws", background.Flaws},
+		{"Story", character.Story},
+	}
+	written := false
+	for _, entry := range entries {
+		if entry.value == "" {
+			continue
+		}
+		if !written {
+			sheet.heading("Background & Story")
+			written = true
+		}
+		sheet.line(pdf.Bold, entry.label)
+		sheet.paragraph(entry.value, 10)
+	}
+}
+
+func (s *sheetWriter) newPage() {
+	s.page = s.doc.AddPage()
+	s.y = sheetMargin
+	s.page.Text(pdf.PageWidth-sheetMargin-40, pdf.PageHeight-20, 7.5, pdf.Regular, fmt.Sprintf("Page %d", s.doc.PageCount()))
+}
+
+func (s *sheetWriter) ensureSpace(height float64) {
+	if s.y+height > pdf.PageHeight-sheetMargin {
+		s.newPage()
+	}
+}
+
+func (s *sheetWriter) heading(title string) {
+	s.ensureSpace(sheetHeadingSize + 2*sheetLineHeight)
+	s.y += 6
+	s.page.FillRect(sheetMargin, s.y, pdf.PageWidth-2*sheetMargin, sheetHeadingSize+6, 0.88)
+	s.page.Text(sheetMargin+4, s.y+sheetHeadingSize+1, sheetHeadingSize, pdf.Bold, title)
+	s.y += sheetHeadingSize + 12
+}
+
+func (s *sheetWriter) line(font pdf.Font, text string) {
+	s.ensureSpace(sheetLineHeight)
+	s.page.Text(sheetMargin, s.y+sheetBodySize, sheetBodySize, font, text)
+	s.y += sheetLineHeight
+}
+
+func (s *sheetWriter) paragraph(text string, indent float64) {
+	width := pdf.PageWidth - 2*sheetMargin - indent
+	for _, line := range pdf.WrapText(pdf.Regular, sheetBodySize, text, width) {
+		s.ensureSpace(sheetLineHeight)
+		s.page.Text(sheetMargin+indent, s.y+sheetBodySize, sheetBodySize, pdf.Regular, line)
+		s.y += sheetLineHeight
+	}
+}
+
+func drawCentered(page *pdf.Page, x, width, y, size float64, font pdf.Font, text string) {
+	page.Text(x+(width-pdf.TextWidth(font, size, text))/2, y, size, font, text)
+}
+
+func equippedMark(equipped bool) string {
+	if equipped {
+		return "[E] "
+	}
+	return ""
+}
+
+func formatModifier(modifier int) string {
+	if modifier >= 0 {
+		return "+" + strconv.Itoa(modifier)
+	}
+	return strconv.Itoa(modifier)
+}
+
+// abilityScoreByStat resolves the stat name stored on skills, which may be
+// abbreviated or spelled out in English or Spanish.
+func abilityScoreByStat(character *dto.FullCharacterData, stat string) (int, bool) {
+	key := strings.ToLower(strings.TrimSpace(stat))
+	if len(key) > 3 {
+		key = key[:3]
+	}
+	switch key {
+	case "str", "fue":
+		return character.Str, true
+	case "dex", "des":
+		return character.Dex, true
+	case "con":
+		return character.Con, true
+	case "int":
+		return character.Int, true
+	case "wis", "wiz", "sab":
+		return character.Wiz, true
+	case "cha", "car":
+		return character.Cha, true
+	}
+	return 0, false
+}
+
+func hitDiceLabel(character *dto.FullCharacterData) string {
+	if len(character.HitDicePool) == 0 {
+		return character.HitDice
+	}
+	dice := make([]string, len(character.HitDicePool))
+	for i, pool := range character.HitDicePool {
+		dice[i] = fmt.Sprintf("%d%s", pool.Total, pool.Die)
+	}
+	return strings.Join(dice, " + ")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 	tradeevent "github.com/proyecto-dnd/backend/internal/tradeEvent"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 type ReportGenerator struct {
@@ -18,14 +19,16 @@ type ReportGenerator struct {
 	attackEventService attackEvent.AttackEventService
 	diceEventService   dice_event.DiceEventService
 	characterDataService characterdata.ServiceCharacterData
+	translationService translation.ServiceTranslation
 }
 
-func NewReportGenerator(tradeEventService tradeevent.ServiceTradeEvent, attackEventService attackEvent.AttackEventService, diceEventService dice_event.DiceEventService, characterDataService characterdata.ServiceCharacterData) *ReportGenerator {
+func NewReportGenerator(tradeEventService tradeevent.ServiceTradeEvent, attackEventService attackEvent.AttackEventService, diceEventService dice_event.DiceEventService, characterDataService characterdata.ServiceCharacterData, translationService translation.ServiceTranslation) *ReportGenerator {
 	return &ReportGenerator{
 		tradeEventService:  tradeEventService,
 		attackEventService: attackEventService,
 		diceEventService:   diceEventService,
 		characterDataService: characterDataService,
+		translationService: translationService,
 	}
 }
 
@@ -133,6 +136,11 @@ func generateTradeEventHeaders(excelFile *excelize.File) {
 	excelFile.SetCellValue("Trade Events", "D1", "receiver")
 	excelFile.SetCellValue("Trade Events", "E1", "description")
 	excelFile.SetCellValue("Trade Events", "F1", "timestamp")
+	excelFile.SetCellValue("Trade Events", "G1", "cp")
+	excelFile.SetCellValue("Trade Events", "H1", "sp")
+	excelFile.SetCellValue("Trade Events", "I1", "ep")
+	excelFile.SetCellValue("Trade Events", "J1", "gp")
+	excelFile.SetCellValue("Trade Events", "K1", "pp")
 }
 
 func insertTradeEventRow(excelFile *excelize.File, tradeEvent domain.TradeEvent, i int) {
@@ -144,6 +152,13 @@ func insertTradeEventRow(excelFile *excelize.File, tradeEvent domain.TradeEvent,
 	if tradeEvent.Timestamp != nil {
 		excelFile.SetCellValue("Trade Events", "F"+strconv.Itoa(i+2), *tradeEvent.Timestamp)
 	}
+	if tradeEvent.Currency != nil {
+		excelFile.SetCellValue("Trade Events", "G"+strconv.Itoa(i+2), tradeEvent.Currency.Cp)
+		excelFile.SetCellValue("Trade Events", "H"+strconv.Itoa(i+2), tradeEvent.Currency.Sp)
+		excelFile.SetCellValue("Trade Events", "I"+strconv.Itoa(i+2), tradeEvent.Currency.Ep)
+		excelFile.SetCellValue("Trade Events", "J"+strconv.Itoa(i+2), tradeEvent.Currency.Gp)
+		excelFile.SetCellValue("Trade Events", "K"+strconv.Itoa(i+2), tradeEvent.Currency.Pp)
+	}
 }
 
 func generateAttackEventHeaders(excelFile *excelize.File) {
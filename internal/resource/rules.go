@@ -0,0 +1,155 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/pkg/stats"
+)
+
+const (
+	RechargeShortRest = "short"
+	RechargeLongRest  = "long"
+	RechargeDawn      = "dawn"
+	RechargeManual    = "manual"
+)
+
+var (
+	ErrInvalidRecharge = errors.New("recharge must be short, long, dawn or manual")
+	ErrInvalidMaximum  = errors.New("invalid resource maximum")
+	ErrMissingName     = errors.New("resource name is required")
+)
+
+// Validate checks a resource declaration before it is stored.
+func Validate(definition domain.FeatureResource) error {
+	if strings.TrimSpace(definition.Name) == "" {
+		return ErrMissingName
+	}
+	if !ValidRecharge(definition.Recharge) {
+		return ErrInvalidRecharge
+	}
+	_, err := Maximum(definition.Maximum, dto.FullCharacterData{})
+	return err
+}
+
+func ValidRecharge(recharge string) bool {
+	switch recharge {
+	case RechargeShortRest, RechargeLongRest, RechargeDawn, RechargeManual:
+		return true
+	}
+	return false
+}
+
+// RechargedBy lists the recharge rules a rest refreshes. A long rest also
+// covers resources that come back at dawn, since parties rest overnight.
+func RechargedBy(rest string) []string {
+	switch rest {
+	case RechargeShortRest:
+		return []string{RechargeShortRest}
+	case RechargeLongRest:
+		return []string{RechargeShortRest, RechargeLongRest, RechargeDawn}
+	case RechargeDawn:
+		return []string{RechargeDawn}
+	}
+	return []string{}
+}
+
+// Maximum evaluates a resource maximum for a character. The formula adds or
+// subtracts terms, each optionally multiplied or divided by a number:
+//
+//	3            a fixed maximum
+//	level        the character level; level:monk is the level in one class
+//	prof         the proficiency bonus
+//	cha, wis...  an ability modifier
+//	level/2, 1+wis, level:paladin*5
+//
+// Divisions round down, and a resource always has at least one use.
+func Maximum(formula string, character dto.FullCharacterData) (int, error) {
+	expression := strings.ToLower(strings.ReplaceAll(formula, " ", ""))
+	if expression == "" {
+		return 0, ErrInvalidMaximum
+	}
+	total := 0
+	sign := 1
+	start := 0
+	for i := 0; i <= len(expression); i++ {
+		if i < len(expression) && expression[i] != '+' && expression[i] != '-' {
+			continue
+		}
+		value, err := evaluateTerm(expression[start:i], character)
+		if err != nil {
+			return 0, err
+		}
+		total += sign * value
+		if i < len(expression) && expression[i] == '-' {
+			sign = -1
+		} else {
+			sign = 1
+		}
+		start = i + 1
+	}
+	return max(total, 1), nil
+}
+
+func evaluateTerm(term string, character dto.FullCharacterData) (int, error) {
+	factor, operator, operand := term, byte(0), 1
+	if index := strings.IndexAny(term, "*/"); index >= 0 {
+		var err error
+		factor, operator = term[:index], term[index]
+		operand, err = strconv.Atoi(term[index+1:])
+		if err != nil || operand <= 0 {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidMaximum, term)
+		}
+	}
+	value, err := evaluateFactor(factor, character)
+	if err != nil {
+		return 0, err
+	}
+	switch operator {
+	case '*':
+		value *= operand
+	case '/':
+		value /= operand
+	}
+	return value, nil
+}
+
+func evaluateFactor(factor string, character dto.FullCharacterData) (int, error) {
+	if value, err := strconv.Atoi(factor); err == nil {
+		return value, nil
+	}
+	switch factor {
+	case "level":
+		return max(character.Level, 1), nil
+	case "prof":
+		return stats.ProficiencyBonus(character.Level), nil
+	case "str":
+		return stats.Modifier(character.Str), nil
+	case "dex":
+		return stats.Modifier(character.Dex), nil
+	case "con":
+		return stats.Modifier(character.Con), nil
+	case "int":
+		return stats.Modifier(character.Int), nil
+	case "wis":
+		return stats.Modifier(character.Wiz), nil
+	case "cha":
+		return stats.Modifier(character.Cha), nil
+	}
+	if className, found := strings.CutPrefix(factor, "level:"); found && className != "" {
+		for _, class := range character.Classes {
+			if strings.EqualFold(class.Class.Name, className) {
+				return class.Level, nil
+			}
+		}
+		if len(character.Classes) == 0 && strings.EqualFold(character.Class.Name, className) {
+			return max(character.Level, 1), nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrInvalidMaximum, factor)
+}
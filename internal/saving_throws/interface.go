@@ -9,6 +9,7 @@ type SavingThrowsRepository interface {
 	Create(savingThrowDto dto.SavingThrowDto) (domain.SavingThrow, error)
 	GetAll() ([]domain.SavingThrow, error)
 	GetById(id int) (domain.SavingThrow, error)
+	GetByClassId(classId int) (domain.SavingThrow, error)
 	Update(savingThrowDto dto.SavingThrowDto, id int) (domain.SavingThrow, error)
 	Delete(id int) error
 }
@@ -17,6 +18,7 @@ type SavingThrowsService interface {
 	Create(savingThrowDto dto.SavingThrowDto) (domain.SavingThrow, error)
 	GetAll() ([]domain.SavingThrow, error)
 	GetById(id int) (domain.SavingThrow, error)
+	GetByClassId(classId int) (domain.SavingThrow, error)
 	Update(savingThrowDto dto.SavingThrowDto, id int) (domain.SavingThrow, error)
 	Delete(id int) error
 }
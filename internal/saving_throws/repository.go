@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -11,6 +12,7 @@ import (
 var (
 	ErrPrepareStatement    = errors.New("error preparing statement")
 	ErrGettingLastInsertId = errors.New("error getting last insert id")
+	ErrNotFound            = errors.New("saving throws not found")
 )
 
 type repositorySqlSavingThrows struct {
@@ -36,6 +38,9 @@ func (r *repositorySqlSavingThrows) Create(savingThrowDto dto.SavingThrowDto) (d
 		savingThrowDto.Wiz,
 		savingThrowDto.Cha,
 	)
+	if err != nil {
+		return domain.SavingThrow{}, err
+	}
 
 	lastId, err := result.LastInsertId()
 	if err != nil {
@@ -60,24 +65,39 @@ func (r *repositorySqlSavingThrows) GetAll() ([]domain.SavingThrow, error) {
 	if err != nil {
 		return []domain.SavingThrow{}, err
 	}
-	var savingThrowList []domain.SavingThrow
+	defer rows.Close()
+
+	savingThrowList := []domain.SavingThrow{}
 	for rows.Next() {
-		var savingThrow domain.SavingThrow
-		if err := rows.Scan(&savingThrow.SavingThrowId, &savingThrow.ClassId, &savingThrow.Str, &savingThrow.Dex, &savingThrow.Int, &savingThrow.Con, &savingThrow.Wiz, &savingThrow.Cha); err != nil {
+		savingThrow, err := scanSavingThrow(rows)
+		if err != nil {
 			return []domain.SavingThrow{}, err
 		}
 		savingThrowList = append(savingThrowList, savingThrow)
 	}
-	return savingThrowList, nil
+	return savingThrowList, rows.Err()
 }
 
 func (r *repositorySqlSavingThrows) GetById(id int) (domain.SavingThrow, error) {
-	var savingThrow domain.SavingThrow
-	if err := r.db.QueryRow(QueryGetById).Scan(&savingThrow.SavingThrowId, &savingThrow.ClassId, &savingThrow.Str, &savingThrow.Dex, &savingThrow.Int, &savingThrow.Con, &savingThrow.Wiz, &savingThrow.Cha); err != nil {
-		return domain.SavingThrow{}, err
+	return r.getOne(QueryGetById, id)
+}
+
+func (r *repositorySqlSavingThrows) GetByClassId(classId int) (domain.SavingThrow, error) {
+	return r.getOne(QueryGetByClassId, classId)
+}
+
+func (r *repositorySqlSavingThrows) getOne(query string, arg int) (domain.SavingThrow, error) {
+	savingThrow, err := scanSavingThrow(r.db.QueryRow(query, arg))
+	if err == sql.ErrNoRows {
+		return domain.SavingThrow{}, ErrNotFound
 	}
+	return savingThrow, err
+}
 
-	return savingThrow, nil
+func scanSavingThrow(row catalog.Scannable) (domain.SavingThrow, error) {
+	var savingThrow domain.SavingThrow
+	err := row.Scan(&savingThrow.SavingThrowId, &savingThrow.ClassId, &savingThrow.Str, &savingThrow.Dex, &savingThrow.Int, &savingThrow.Con, &savingThrow.Wiz, &savingThrow.Cha)
+	return savingThrow, err
 }
 
 func (r *repositorySqlSavingThrows) Update(savingThrowDto dto.SavingThrowDto, id int) (domain.SavingThrow, error) {
@@ -97,6 +117,9 @@ func (r *repositorySqlSavingThrows) Update(savingThrowDto dto.SavingThrowDto, id
 		savingThrowDto.Cha,
 		id,
 	)
+	if err != nil {
+		return domain.SavingThrow{}, err
+	}
 
 	updatedSavingThrow := domain.SavingThrow{
 		SavingThrowId: id,
@@ -117,9 +140,18 @@ func (r *repositorySqlSavingThrows) Delete(id int) error {
 	if err != nil {
 		return ErrPrepareStatement
 	}
-	_, err = statement.Exec(id)
+	defer statement.Close()
+
+	result, err := statement.Exec(id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
 	return nil
 }
@@ -0,0 +1,123 @@
+package saving_throws
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/pkg/stats"
+)
+
+// Abilities are the saving throws in sheet order, by the keys the character
+// uses for its ability scores.
+var Abilities = []string{"str", "dex", "con", "int", "wiz", "cha"}
+
+// Stats are not stored separately, so saving throw names are read from the
+// dice event stat, in English and Spanish.
+var (
+	saveKeywords   = []string{"save", "saving", "salvacion", "salvación"}
+	abilityAliases = map[string][]string{
+		"str": {"str", "strength", "fue", "fuerza"},
+		"dex": {"dex", "dexterity", "des", "destreza"},
+		"con": {"con", "constitution", "constitución", "constitucion"},
+		"int": {"int", "intelligence", "inteligencia"},
+		"wiz": {"wis", "wiz", "wisdom", "sab", "sabiduría", "sabiduria"},
+		"cha": {"cha", "charisma", "car", "carisma"},
+	}
+)
+
+// Ability reads the ability of a saving throw stat such as "dex save" or
+// "Wisdom saving throw". Other stats, death saves among them, report false.
+func Ability(stat string) (string, bool) {
+	words := strings.FieldsFunc(strings.ToLower(stat), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	isSave := false
+	for _, word := range words {
+		for _, keyword := range saveKeywords {
+			if strings.HasPrefix(word, keyword) {
+				isSave = true
+			}
+		}
+	}
+	if !isSave {
+		return "", false
+	}
+	for _, word := range words {
+		for _, ability := range Abilities {
+			for _, alias := range abilityAliases[ability] {
+				if word == alias {
+					return ability, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// Bonuses works out every saving throw of a character. Proficiency comes from
+// the saving throws of its starting class, and active magic items add their
+// saving throw bonus to all of them.
+func Bonuses(character dto.FullCharacterData, proficient domain.SavingThrow) []dto.SavingThrowBonusDto {
+	bonuses := make([]dto.SavingThrowBonusDto, 0, len(Abilities))
+	for _, ability := range Abilities {
+		bonus := dto.SavingThrowBonusDto{
+			Ability:    ability,
+			Modifier:   stats.Modifier(score(character, ability)),
+			Proficient: isProficient(proficient, ability),
+		}
+		bonus.Bonus = bonus.Modifier + character.Equipment.Bonuses.SavingThrow
+		if bonus.Proficient {
+			bonus.Bonus += stats.ProficiencyBonus(character.Level)
+		}
+		bonuses = append(bonuses, bonus)
+	}
+	return bonuses
+}
+
+// Bonus is the saving throw bonus of an ability from a character sheet.
+func Bonus(character dto.FullCharacterData, ability string) (int, bool) {
+	for _, bonus := range character.SavingThrows {
+		if bonus.Ability == ability {
+			return bonus.Bonus, true
+		}
+	}
+	return 0, false
+}
+
+func score(character dto.FullCharacterData, ability string) int {
+	switch ability {
+	case "str":
+		return character.Str
+	case "dex":
+		return character.Dex
+	case "con":
+		return character.Con
+	case "int":
+		return character.Int
+	case "wiz":
+		return character.Wiz
+	case "cha":
+		return character.Cha
+	}
+	return 0
+}
+
+func isProficient(savingThrow domain.SavingThrow, ability string) bool {
+	switch ability {
+	case "str":
+		return savingThrow.Str
+	case "dex":
+		return savingThrow.Dex
+	case "con":
+		return savingThrow.Con
+	case "int":
+		return savingThrow.Int
+	case "wiz":
+		return savingThrow.Wiz
+	case "cha":
+		return savingThrow.Cha
+	}
+	return false
+}
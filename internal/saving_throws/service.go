@@ -1,10 +1,14 @@
 package saving_throws
 
 import (
+	"errors"
+
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
 
+var ErrAlreadyDefined = errors.New("the class already has its saving throws")
+
 type service struct {
 	repository SavingThrowsRepository
 }
@@ -13,8 +17,43 @@ func NewSavingThrowsService(repository SavingThrowsRepository) SavingThrowsServi
 	return &service{repository: repository}
 }
 
-func (s *service) Create(savingThrowDto dto.SavingThrowDto) (domain.SavingThrow, error)
-func (s *service) GetAll() ([]domain.SavingThrow, error)
-func (s *service) GetById(id int) (domain.SavingThrow, error)
-func (s *service) Update(savingThrowDto dto.SavingThrowDto, id int) (domain.SavingThrow, error)
-func (s *service) Delete(id int) error
+// Create implements SavingThrowsService. A class has a single set of saving
+// throws.
+func (s *service) Create(savingThrowDto dto.SavingThrowDto) (domain.SavingThrow, error) {
+	if _, err := s.repository.GetByClassId(savingThrowDto.ClassId); err == nil {
+		return domain.SavingThrow{}, ErrAlreadyDefined
+	} else if !errors.Is(err, ErrNotFound) {
+		return domain.SavingThrow{}, err
+	}
+	return s.repository.Create(savingThrowDto)
+}
+
+func (s *service) GetAll() ([]domain.SavingThrow, error) {
+	return s.repository.GetAll()
+}
+
+func (s *service) GetById(id int) (domain.SavingThrow, error) {
+	return s.repository.GetById(id)
+}
+
+func (s *service) GetByClassId(classId int) (domain.SavingThrow, error) {
+	return s.repository.GetByClassId(classId)
+}
+
+// Update implements SavingThrowsService. Moving the saving throws to another
+// class fails when that class already has its own.
+func (s *service) Update(savingThrowDto dto.SavingThrowDto, id int) (domain.SavingThrow, error) {
+	if _, err := s.repository.GetById(id); err != nil {
+		return domain.SavingThrow{}, err
+	}
+	if other, err := s.repository.GetByClassId(savingThrowDto.ClassId); err == nil && other.SavingThrowId != id {
+		return domain.SavingThrow{}, ErrAlreadyDefined
+	} else if err != nil && !errors.Is(err, ErrNotFound) {
+		return domain.SavingThrow{}, err
+	}
+	return s.repository.Update(savingThrowDto, id)
+}
+
+func (s *service) Delete(id int) error {
+	return s.repository.Delete(id)
+}
@@ -1,9 +1,10 @@
 package saving_throws
 
 var (
-	QueryInsert  = "INSERT INTO saving_thows (class_id, str, dex, `int`, con, wiz, cha) VALUES (?,?,?,?,?,?,?);"
-	QueryGetAll  = `SELECT * FROM saving_throws;`
-	QueryGetById = `SELECT * FROM saving_throws WHERE saving_throw_id = ?;`
-	QueryUpdate  = "UPDATE saving_throws SET str=?,dex=?,`int`=?,con=?,wiz=?,cha=? WHERE saving_throw_id = ?;"
-	QueryDelete  = `DELETE FROM saving_throws WHERE saving_throw_id = ?;`
+	QueryInsert       = "INSERT INTO saving_throws (class_id, str, dex, `int`, con, wiz, cha) VALUES (?,?,?,?,?,?,?);"
+	QueryGetAll       = "SELECT saving_throw_id, class_id, str, dex, `int`, con, wiz, cha FROM saving_throws;"
+	QueryGetById      = "SELECT saving_throw_id, class_id, str, dex, `int`, con, wiz, cha FROM saving_throws WHERE saving_throw_id = ?;"
+	QueryGetByClassId = "SELECT saving_throw_id, class_id, str, dex, `int`, con, wiz, cha FROM saving_throws WHERE class_id = ?;"
+	QueryUpdate       = "UPDATE saving_throws SET class_id=?,str=?,dex=?,`int`=?,con=?,wiz=?,cha=? WHERE saving_throw_id = ?;"
+	QueryDelete       = `DELETE FROM saving_throws WHERE saving_throw_id = ?;`
 )
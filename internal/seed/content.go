@@ -0,0 +1,79 @@
+package seed
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+//go:embed srd/*.json
+var srdFiles embed.FS
+
+// Race is a seeded race with the names of the proficiencies it grants.
+type Race struct {
+	domain.Race
+	Proficiencies []string `json:"proficiencies"`
+}
+
+// Class is a seeded class with the names of the proficiencies it grants.
+type Class struct {
+	domain.Class
+	Proficiencies []string `json:"proficiencies"`
+}
+
+// Background is a seeded background with the names of the skills and
+// proficiencies it grants.
+type Background struct {
+	domain.Background
+	Skills        []string `json:"skills"`
+	Proficiencies []string `json:"proficiencies"`
+}
+
+// Spell is a seeded spell with the names of the classes whose list it is on.
+type Spell struct {
+	domain.Spell
+	Classes []string `json:"classes"`
+}
+
+// Content is a set of generic catalog entries to load. Links between them are
+// made by name, so the same file works whatever ids the database hands out.
+type Content struct {
+	Skills        []domain.Skill
+	Proficiencies []domain.Proficiency
+	Races         []Race
+	Classes       []Class
+	Backgrounds   []Background
+	Spells        []Spell
+	Weapons       []domain.Weapon
+	Armor         []domain.Armor
+}
+
+// SRD reads the 5e System Reference Document content embedded in the binary.
+func SRD() (Content, error) {
+	var content Content
+	files := []struct {
+		name   string
+		target any
+	}{
+		{"skills.json", &content.Skills},
+		{"proficiencies.json", &content.Proficiencies},
+		{"races.json", &content.Races},
+		{"classes.json", &content.Classes},
+		{"backgrounds.json", &content.Backgrounds},
+		{"spells.json", &content.Spells},
+		{"weapons.json", &content.Weapons},
+		{"armor.json", &content.Armor},
+	}
+	for _, file := range files {
+		data, err := srdFiles.ReadFile("srd/" + file.name)
+		if err != nil {
+			return Content{}, err
+		}
+		if err := json.Unmarshal(data, file.target); err != nil {
+			return Content{}, fmt.Errorf("%w: %s: %s", ErrInvalidContent, file.name, err)
+		}
+	}
+	return content, nil
+}
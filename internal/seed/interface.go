@@ -0,0 +1,15 @@
+package seed
+
+import "github.com/proyecto-dnd/backend/internal/dto"
+
+type RepositorySeed interface {
+	// Load stores content inside one transaction, or nothing at all. Entries
+	// are matched by name among the generic ones, and links are only added
+	// when missing, so loading the same content twice changes nothing.
+	Load(content Content) (dto.SeedReportDto, error)
+}
+
+type ServiceSeed interface {
+	LoadSRD() (dto.SeedReportDto, error)
+	Load(content Content) (dto.SeedReportDto, error)
+}
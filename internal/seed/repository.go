@@ -0,0 +1,221 @@
+package seed
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type seedMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewSeedRepository(db *sql.DB) RepositorySeed {
+	return &seedMySqlRepository{db: db}
+}
+
+// target is a table entries are upserted into.
+type target struct {
+	table  string
+	getId  string
+	insert string
+	update string
+}
+
+func catalogTarget(table string, id string, insert string, update string) target {
+	return target{table: table, getId: fmt.Sprintf(QueryGetGenericId, id, table), insert: insert, update: update}
+}
+
+var (
+	skillTarget       = target{table: "skill", getId: QueryGetSkillId, insert: QueryInsertSkill, update: QueryUpdateSkill}
+	proficiencyTarget = target{table: "proficiency", getId: QueryGetProficiencyId, insert: QueryInsertProficiency, update: QueryUpdateProficiency}
+	raceTarget        = catalogTarget("race", "race_id", QueryInsertRace, QueryUpdateRace)
+	classTarget       = catalogTarget("class", "class_id", QueryInsertClass, QueryUpdateClass)
+	backgroundTarget  = catalogTarget("background", "background_id", QueryInsertBackground, QueryUpdateBackground)
+	spellTarget       = catalogTarget("spell", "spell_id", QueryInsertSpell, QueryUpdateSpell)
+	weaponTarget      = catalogTarget("weapon", "weapon_id", QueryInsertWeapon, QueryUpdateWeapon)
+	armorTarget       = catalogTarget("armor", "armor_id", QueryInsertArmor, QueryUpdateArmor)
+)
+
+// link is a table joining two catalogs.
+type link struct {
+	table string
+	owner string
+	other string
+}
+
+var (
+	raceProficiencyLink       = link{table: "race_proficiency", owner: "race_id", other: "proficiency_id"}
+	classProficiencyLink      = link{table: "class_proficiency", owner: "class_id", other: "proficiency_id"}
+	backgroundProficiencyLink = link{table: "background_proficiency", owner: "background_id", other: "proficiency_id"}
+	backgroundSkillLink       = link{table: "background_skills", owner: "background_id", other: "skill_id"}
+	classSpellLink            = link{table: "class_spell", owner: "class_id", other: "spell_id"}
+)
+
+// loader runs the statements of one Load and keeps the count of each table.
+type loader struct {
+	tx     *sql.Tx
+	report dto.SeedReportDto
+	tables map[string]int
+}
+
+// Load implements RepositorySeed.
+func (r *seedMySqlRepository) Load(content Content) (dto.SeedReportDto, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return dto.SeedReportDto{}, err
+	}
+	defer tx.Rollback()
+	l := &loader{tx: tx, report: dto.SeedReportDto{Tables: []dto.SeedTableDto{}}, tables: map[string]int{}}
+
+	skillIds := map[string]int{}
+	for _, skill := range content.Skills {
+		if skillIds[key(skill.Name)], err = l.upsert(skillTarget, skill.Name, skill.Stat); err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+	proficiencyIds := map[string]int{}
+	for _, proficiency := range content.Proficiencies {
+		if proficiencyIds[key(proficiency.Name)], err = l.upsert(proficiencyTarget, proficiency.Name, proficiency.Type); err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+
+	for _, race := range content.Races {
+		id, err := l.upsert(raceTarget, race.Name, race.Description, race.Speed, race.Str, race.Dex, race.Int, race.Con, race.Wiz, race.Cha)
+		if err != nil {
+			return dto.SeedReportDto{}, err
+		}
+		if err := l.linkAll(raceProficiencyLink, id, race.Proficiencies, proficiencyIds); err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+
+	classIds := map[string]int{}
+	for _, class := range content.Classes {
+		id, err := l.upsert(classTarget, class.Name, class.Description, class.ProficiencyBonus, class.HitDice, class.ArmorProficiencies, class.WeaponProficiencies, class.ToolProficiencies, class.SpellcastingAbility)
+		if err != nil {
+			return dto.SeedReportDto{}, err
+		}
+		classIds[key(class.Name)] = id
+		if err := l.linkAll(classProficiencyLink, id, class.Proficiencies, proficiencyIds); err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+
+	for _, background := range content.Backgrounds {
+		id, err := l.upsert(backgroundTarget, background.Name, background.Languages, background.PersonalityTraits, background.Ideals, background.Bond, background.Flaws, background.Trait, background.ToolProficiencies)
+		if err != nil {
+			return dto.SeedReportDto{}, err
+		}
+		if err := l.linkAll(backgroundProficiencyLink, id, background.Proficiencies, proficiencyIds); err != nil {
+			return dto.SeedReportDto{}, err
+		}
+		if err := l.linkAll(backgroundSkillLink, id, background.Skills, skillIds); err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+
+	for _, spell := range content.Spells {
+		id, err := l.upsert(spellTarget, spell.Name, spell.Description, spell.Range, spell.Ritual, spell.Duration, spell.Concentration, spell.CastingTime, spell.Level, spell.DamageType, spell.DifficultyClass, spell.Aoe, spell.School)
+		if err != nil {
+			return dto.SeedReportDto{}, err
+		}
+		// class_spell lists the class first, so the spell is the other side.
+		for _, className := range spell.Classes {
+			if err := l.link(classSpellLink, classIds[key(className)], id); err != nil {
+				return dto.SeedReportDto{}, err
+			}
+		}
+	}
+
+	for _, weapon := range content.Weapons {
+		_, err := l.upsert(weaponTarget, weapon.Name, weapon.Weapon_Type, weapon.Weight, weapon.Price, weapon.Category, weapon.Reach, weapon.Description, weapon.Damage, weapon.Versatile_Damage, weapon.Ammunition, weapon.Damage_Type, weapon.TwoHanded, weapon.Finesse, weapon.Ranged, weapon.RequiresAttunement)
+		if err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+	for _, armor := range content.Armor {
+		_, err := l.upsert(armorTarget, armor.Name, armor.Material, armor.Weight, armor.Price, armor.Category, armor.ProtectionType, armor.Description, armor.Penalty, armor.Strength, armor.ArmorClass, armor.DexBonus, armor.MaxDexBonus, armor.RequiresAttunement)
+		if err != nil {
+			return dto.SeedReportDto{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return dto.SeedReportDto{}, err
+	}
+	return l.report, nil
+}
+
+// upsert stores an entry by name and returns its id. values are the columns
+// that follow the name in the insert and update statements.
+func (l *loader) upsert(t target, name string, values ...any) (int, error) {
+	args := append([]any{name}, values...)
+	var id int
+	err := l.tx.QueryRow(t.getId, name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		result, err := l.tx.Exec(t.insert, args...)
+		if err != nil {
+			return 0, fmt.Errorf("%s %q: %w", t.table, name, err)
+		}
+		lastId, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		l.count(t.table).Created++
+		return int(lastId), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if _, err := l.tx.Exec(t.update, append(args, id)...); err != nil {
+		return 0, fmt.Errorf("%s %q: %w", t.table, name, err)
+	}
+	l.count(t.table).Existing++
+	return id, nil
+}
+
+func (l *loader) linkAll(lk link, ownerId int, names []string, ids map[string]int) error {
+	for _, name := range names {
+		if err := l.link(lk, ownerId, ids[key(name)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *loader) link(lk link, ownerId int, otherId int) error {
+	result, err := l.tx.Exec(fmt.Sprintf(QueryInsertLink, lk.table, lk.owner, lk.other), ownerId, otherId, ownerId, otherId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", lk.table, err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if inserted > 0 {
+		l.count(lk.table).Created++
+	} else {
+		l.count(lk.table).Existing++
+	}
+	return nil
+}
+
+func (l *loader) count(table string) *dto.SeedTableDto {
+	i, ok := l.tables[table]
+	if !ok {
+		i = len(l.report.Tables)
+		l.tables[table] = i
+		l.report.Tables = append(l.report.Tables, dto.SeedTableDto{Table: table})
+	}
+	return &l.report.Tables[i]
+}
+
+// key matches names the way MySQL compares them.
+func key(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,132 @@
+package seed
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+var ErrInvalidContent = errors.New("invalid seed content")
+
+// stats are the ability codes skills are tied to.
+var stats = []string{"str", "dex", "con", "int", "wis", "cha"}
+
+type service struct {
+	repository RepositorySeed
+}
+
+func NewSeedService(repository RepositorySeed) ServiceSeed {
+	return &service{repository: repository}
+}
+
+// LoadSRD implements ServiceSeed.
+func (s *service) LoadSRD() (dto.SeedReportDto, error) {
+	content, err := SRD()
+	if err != nil {
+		return dto.SeedReportDto{}, err
+	}
+	return s.Load(content)
+}
+
+// Load implements ServiceSeed. Content is checked as a whole before anything
+// is stored, so a broken file can not leave links half made.
+func (s *service) Load(content Content) (dto.SeedReportDto, error) {
+	if err := validate(content); err != nil {
+		return dto.SeedReportDto{}, err
+	}
+	return s.repository.Load(content)
+}
+
+// validate checks that every entry has a name that is unique within its
+// catalog and that links only name entries of the same content.
+func validate(content Content) error {
+	problems := []string{}
+	names := func(catalog string, list []string) map[string]bool {
+		seen := map[string]bool{}
+		for _, name := range list {
+			switch k := key(name); {
+			case k == "":
+				problems = append(problems, catalog+": an entry has no name")
+			case seen[k]:
+				problems = append(problems, fmt.Sprintf("%s: %q is repeated", catalog, name))
+			default:
+				seen[k] = true
+			}
+		}
+		return seen
+	}
+	links := func(owner string, catalog string, list []string, known map[string]bool) {
+		for _, name := range list {
+			if !known[key(name)] {
+				problems = append(problems, fmt.Sprintf("%s: unknown %s %q", owner, catalog, name))
+			}
+		}
+	}
+
+	skillNames := make([]string, len(content.Skills))
+	for i, skill := range content.Skills {
+		skillNames[i] = skill.Name
+		if !slices.Contains(stats, skill.Stat) {
+			problems = append(problems, fmt.Sprintf("skill %q: unknown stat %q", skill.Name, skill.Stat))
+		}
+	}
+	skills := names("skill", skillNames)
+
+	proficiencyNames := make([]string, len(content.Proficiencies))
+	for i, proficiency := range content.Proficiencies {
+		proficiencyNames[i] = proficiency.Name
+	}
+	proficiencies := names("proficiency", proficiencyNames)
+
+	raceNames := make([]string, len(content.Races))
+	for i, race := range content.Races {
+		raceNames[i] = race.Name
+		links("race "+race.Name, "proficiency", race.Proficiencies, proficiencies)
+	}
+	names("race", raceNames)
+
+	classNames := make([]string, len(content.Classes))
+	for i, class := range content.Classes {
+		classNames[i] = class.Name
+		links("class "+class.Name, "proficiency", class.Proficiencies, proficiencies)
+	}
+	classes := names("class", classNames)
+
+	backgroundNames := make([]string, len(content.Backgrounds))
+	for i, background := range content.Backgrounds {
+		backgroundNames[i] = background.Name
+		links("background "+background.Name, "proficiency", background.Proficiencies, proficiencies)
+		links("background "+background.Name, "skill", background.Skills, skills)
+	}
+	names("background", backgroundNames)
+
+	spellNames := make([]string, len(content.Spells))
+	for i, spell := range content.Spells {
+		spellNames[i] = spell.Name
+		if spell.Level < 0 || spell.Level > 9 {
+			problems = append(problems, fmt.Sprintf("spell %q: level must be between 0 and 9", spell.Name))
+		}
+		links("spell "+spell.Name, "class", spell.Classes, classes)
+	}
+	names("spell", spellNames)
+
+	weaponNames := make([]string, len(content.Weapons))
+	for i, weapon := range content.Weapons {
+		weaponNames[i] = weapon.Name
+	}
+	names("weapon", weaponNames)
+
+	armorNames := make([]string, len(content.Armor))
+	for i, armor := range content.Armor {
+		armorNames[i] = armor.Name
+	}
+	names("armor", armorNames)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidContent, strings.Join(problems, "; "))
+	}
+	return nil
+}
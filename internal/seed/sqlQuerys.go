@@ -0,0 +1,37 @@
+package seed
+
+var (
+	// QueryGetGenericId is completed with the id column and table of a catalog.
+	// Seeded rows are the generic ones; campaign homebrew with the same name is
+	// left alone.
+	QueryGetGenericId = `SELECT %s FROM %s WHERE name = ? AND campaign_id IS NULL ORDER BY %[1]s LIMIT 1;`
+	// QueryInsertLink is completed with a link table and its two id columns. It
+	// only inserts the pair when the table does not have it yet.
+	QueryInsertLink = `INSERT INTO %[1]s (%[2]s, %[3]s) SELECT ?, ? FROM DUAL WHERE NOT EXISTS (SELECT 1 FROM %[1]s WHERE %[2]s = ? AND %[3]s = ?);`
+
+	QueryGetSkillId  = `SELECT skill_id FROM skill WHERE name = ? ORDER BY skill_id LIMIT 1;`
+	QueryInsertSkill = `INSERT INTO skill (name, stat) VALUES (?, ?);`
+	QueryUpdateSkill = `UPDATE skill SET name = ?, stat = ? WHERE skill_id = ?;`
+
+	QueryGetProficiencyId  = `SELECT proficiency_id FROM proficiency WHERE name = ? ORDER BY proficiency_id LIMIT 1;`
+	QueryInsertProficiency = `INSERT INTO proficiency (name, type) VALUES (?, ?);`
+	QueryUpdateProficiency = `UPDATE proficiency SET name = ?, type = ? WHERE proficiency_id = ?;`
+
+	QueryInsertRace = "INSERT INTO race (name, description, speed, str, dex, `int`, con, wiz, cha, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NULL);"
+	QueryUpdateRace = "UPDATE race SET name = ?, description = ?, speed = ?, str = ?, dex = ?, `int` = ?, con = ?, wiz = ?, cha = ? WHERE race_id = ?;"
+
+	QueryInsertClass = `INSERT INTO class (name, description, proficiency_bonus, hit_dice, armor_proficiencies, weapon_proficiencies, tool_proficiencies, spellcasting_ability, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL);`
+	QueryUpdateClass = `UPDATE class SET name = ?, description = ?, proficiency_bonus = ?, hit_dice = ?, armor_proficiencies = ?, weapon_proficiencies = ?, tool_proficiencies = ?, spellcasting_ability = ? WHERE class_id = ?;`
+
+	QueryInsertBackground = `INSERT INTO background (name, languages, personality_traits, ideals, bond, flaws, trait, tool_proficiencies, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL);`
+	QueryUpdateBackground = `UPDATE background SET name = ?, languages = ?, personality_traits = ?, ideals = ?, bond = ?, flaws = ?, trait = ?, tool_proficiencies = ? WHERE background_id = ?;`
+
+	QueryInsertSpell = "INSERT INTO spell (name, description, `range`, ritual, duration, concentration, casting_time, level, damage_type, difficulty_class, aoe, school, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULL);"
+	QueryUpdateSpell = "UPDATE spell SET name = ?, description = ?, `range` = ?, ritual = ?, duration = ?, concentration = ?, casting_time = ?, level = ?, damage_type = ?, difficulty_class = ?, aoe = ?, school = ? WHERE spell_id = ?;"
+
+	QueryInsertWeapon = `INSERT INTO weapon (name, weapon_type, weight, price, category, reach, description, damage, versatile_damage, ammunition, damage_type, two_handed, finesse, ranged, requires_attunement, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULL);`
+	QueryUpdateWeapon = `UPDATE weapon SET name = ?, weapon_type = ?, weight = ?, price = ?, category = ?, reach = ?, description = ?, damage = ?, versatile_damage = ?, ammunition = ?, damage_type = ?, two_handed = ?, finesse = ?, ranged = ?, requires_attunement = ? WHERE weapon_id = ?;`
+
+	QueryInsertArmor = `INSERT INTO armor (name, material, weight, price, category, protection_type, description, penalty, strength, armor_class, dex_bonus, max_dex_bonus, requires_attunement, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULL);`
+	QueryUpdateArmor = `UPDATE armor SET name = ?, material = ?, weight = ?, price = ?, category = ?, protection_type = ?, description = ?, penalty = ?, strength = ?, armor_class = ?, dex_bonus = ?, max_dex_bonus = ?, requires_attunement = ? WHERE armor_id = ?;`
+)
@@ -60,7 +60,7 @@ func (r *skillxCharacterDataSqlRepository) Create(skillXCharacterData domain.Ski
 
 // Delete implements RepositorySkillXCharacterData.
 func (r *skillxCharacterDataSqlRepository) Delete(skillXCharacterData domain.SkillXCharacterData) error {
-	result, err := r.db.Exec(QueryDeleteSkillXCharacter, skillXCharacterData.SkillID, skillXCharacterData.CharacterID, nil)
+	result, err := r.db.Exec(QueryDeleteSkillXCharacter, skillXCharacterData.CharacterID, skillXCharacterData.SkillID)
 	if err != nil {
 		return err
 	}
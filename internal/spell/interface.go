@@ -1,6 +1,7 @@
 package spell
 
 import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -13,6 +14,7 @@ type RepositorySpell interface {
 	GetByClassId(classId int) ([]domain.Spell, error)
 	Update(spell dto.SpellDto, id int) (domain.Spell, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Spell], error)
 }
 
 type ServiceSpell interface {
@@ -23,4 +25,5 @@ type ServiceSpell interface {
 	GetByClassId(classId int) ([]domain.Spell, error)
 	Update(spell dto.SpellDto, id int) (domain.Spell, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Spell], error)
 }
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -43,6 +45,7 @@ func (r *spellMySqlRepository) Create(spell dto.SpellDto) (domain.Spell, error)
 		spell.DifficultyClass,
 		spell.Aoe,
 		spell.School,
+		spell.CampaignId,
 	)
 	if err != nil {
 		return domain.Spell{}, err
@@ -67,6 +70,7 @@ func (r *spellMySqlRepository) Create(spell dto.SpellDto) (domain.Spell, error)
 	createdSpell.DifficultyClass = spell.DifficultyClass
 	createdSpell.Aoe = spell.Aoe
 	createdSpell.School = spell.School
+	createdSpell.CampaignId = spell.CampaignId
 
 	return createdSpell, nil
 }
@@ -92,7 +96,8 @@ func (r *spellMySqlRepository) GetAll() ([]domain.Spell, error) {
 			&spell.DamageType,
 			&spell.DifficultyClass,
 			&spell.Aoe,
-			&spell.School); err != nil {
+			&spell.School,
+			&spell.CampaignId); err != nil {
 			return nil, err
 		}
 		spells = append(spells, spell)
@@ -116,7 +121,8 @@ func (r *spellMySqlRepository) GetById(id int) (domain.Spell, error) {
 		&spell.DamageType,
 		&spell.DifficultyClass,
 		&spell.Aoe,
-		&spell.School)
+		&spell.School,
+		&spell.CampaignId)
 	if err != nil {
 		fmt.Println(err)
 		return domain.Spell{}, err
@@ -131,7 +137,7 @@ func (r *spellMySqlRepository) Update(spell dto.SpellDto, id int) (domain.Spell,
 		return domain.Spell{}, ErrPrepareStatement
 	}
 	defer statement.Close()
-	_, err = statement.Exec(&spell.Name, &spell.Description, &spell.Range, &spell.Ritual, &spell.Duration, &spell.Concentration, &spell.CastingTime, &spell.Level, &spell.DamageType, &spell.DifficultyClass, &spell.Aoe, &spell.School, id)
+	_, err = statement.Exec(&spell.Name, &spell.Description, &spell.Range, &spell.Ritual, &spell.Duration, &spell.Concentration, &spell.CastingTime, &spell.Level, &spell.DamageType, &spell.DifficultyClass, &spell.Aoe, &spell.School, spell.CampaignId, id)
 	if err != nil {
 		return domain.Spell{}, err
 	}
@@ -149,18 +155,20 @@ func (r *spellMySqlRepository) Update(spell dto.SpellDto, id int) (domain.Spell,
 	updatedSpell.DifficultyClass = spell.DifficultyClass
 	updatedSpell.Aoe = spell.Aoe
 	updatedSpell.School = spell.School
+	updatedSpell.CampaignId = spell.CampaignId
 
 	return updatedSpell, nil
 }
 
+// Delete implements RepositorySpell. The translations of the spell go with it.
 func (r *spellMySqlRepository) Delete(id int) error {
-	statement, err := r.db.Prepare(QueryDelete)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return ErrPrepareStatement
+		return err
 	}
-	defer statement.Close()
+	defer tx.Rollback()
 
-	result, err := statement.Exec(id)
+	result, err := tx.Exec(QueryDelete, id)
 	if err != nil {
 		return err
 	}
@@ -174,7 +182,10 @@ func (r *spellMySqlRepository) Delete(id int) error {
 		return ErrNotFound
 	}
 
-	return nil
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (r *spellMySqlRepository) GetByCharacterDataId(characterId int) ([]domain.Spell, error) {
@@ -199,7 +210,8 @@ func (r *spellMySqlRepository) GetByCharacterDataId(characterId int) ([]domain.S
 			&spell.DamageType,
 			&spell.DifficultyClass,
 			&spell.Aoe,
-			&spell.School); err != nil {
+			&spell.School,
+			&spell.CampaignId); err != nil {
 			return nil, err
 		}
 		spells = append(spells, spell)
@@ -230,7 +242,8 @@ func (r *spellMySqlRepository) GetByClassId(classId int) ([]domain.Spell, error)
 			&spell.DamageType,
 			&spell.DifficultyClass,
 			&spell.Aoe,
-			&spell.School)
+			&spell.School,
+			&spell.CampaignId)
 
 		if err != nil {
 			return []domain.Spell{}, err
@@ -240,3 +253,29 @@ func (r *spellMySqlRepository) GetByClassId(classId int) ([]domain.Spell, error)
 
 	return spells, nil
 }
+
+// Search implements RepositorySpell.
+func (r *spellMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Spell], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanSpell)
+}
+
+func scanSpell(row catalog.Scannable) (domain.Spell, error) {
+	var spell domain.Spell
+	err := row.Scan(
+		&spell.SpellId,
+		&spell.Name,
+		&spell.Description,
+		&spell.Range,
+		&spell.Ritual,
+		&spell.Duration,
+		&spell.Concentration,
+		&spell.CastingTime,
+		&spell.Level,
+		&spell.DamageType,
+		&spell.DifficultyClass,
+		&spell.Aoe,
+		&spell.School,
+		&spell.CampaignId,
+	)
+	return spell, err
+}
@@ -1,6 +1,7 @@
 package spell
 
 import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	"github.com/proyecto-dnd/backend/internal/dto"
 )
@@ -60,3 +61,8 @@ func (s *service) GetByCharacterDataId(characterId int) ([]domain.Spell, error)
 func (s *service) GetByClassId(classId int) ([]domain.Spell, error) {
 	return s.repository.GetByClassId(classId)
 }
+
+// Search implements ServiceSpell.
+func (s *service) Search(params catalog.Params) (catalog.Page[domain.Spell], error) {
+	return s.repository.Search(params)
+}
@@ -1,11 +1,31 @@
 package spell
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
-	QueryCreateSpell          = "INSERT INTO spell (name, description, `range`, ritual, duration, concentration, casting_time, level, damage_type, difficulty_class, aoe, school) VALUES (?,?,?,?,?,?,?,?,?,?,?,?)"
+	QueryCreateSpell          = "INSERT INTO spell (name, description, `range`, ritual, duration, concentration, casting_time, level, damage_type, difficulty_class, aoe, school, campaign_id) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)"
 	QueryGetAll               = `SELECT * FROM spell`
 	QueryGetById              = `SELECT * FROM spell WHERE spell_id = ?`
 	QueryGetByCharacterDataId = `SELECT spell.* FROM spell left join character_spell on character_spell.spell_id = spell.spell_id WHERE character_spell.character_id = ?;`
 	QueryGetByClassId         = `SELECT spell.* FROM spell left join class_spell on class_spell.spell_id = spell.spell_id WHERE class_spell.class_id = ?;`
-	QueryUpdate               = "UPDATE spell SET name=?, description=?, `range`=?, ritual=?, duration=?, concentration=?, casting_time=?, level=?,damage_type=?, difficulty_class=?, aoe=?, school=? WHERE spell_id = ?"
-	QueryDelete               = `DELETE FROM spell WHERE id = ?`
+	QueryUpdate               = "UPDATE spell SET name=?, description=?, `range`=?, ritual=?, duration=?, concentration=?, casting_time=?, level=?,damage_type=?, difficulty_class=?, aoe=?, school=?, campaign_id=? WHERE spell_id = ?"
+	QueryDelete               = `DELETE FROM spell WHERE spell_id = ?`
 )
+
+// CatalogTable describes how GET /spell searches, filters and sorts spells.
+var CatalogTable = catalog.Table{
+	Name:   "spell",
+	Id:     "spell_id",
+	Search: []string{"name", "description"},
+	Filters: map[string]catalog.Filter{
+		"level":         {Condition: "level = ?", Kind: catalog.Integer},
+		"school":        {Condition: "school = ?", Kind: catalog.Text},
+		"ritual":        {Condition: "ritual = ?", Kind: catalog.Boolean},
+		"concentration": {Condition: "concentration = ?", Kind: catalog.Boolean},
+		"damage_type":   {Condition: "damage_type = ?", Kind: catalog.Text},
+		"class":         {Condition: "spell_id IN (SELECT spell_id FROM class_spell WHERE class_id = ?)", Kind: catalog.Integer},
+	},
+	Sorts:          map[string]string{"name": "name", "level": "level", "school": "school"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
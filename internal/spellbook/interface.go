@@ -0,0 +1,8 @@
+package spellbook
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+type ServiceSpellbook interface {
+	AddSpell(characterXSpell domain.CharacterXSpell) (domain.CharacterXSpell, error)
+	SetPreparation(id int, preparation string) (domain.CharacterXSpell, error)
+}
@@ -0,0 +1,316 @@
+package spellbook
+
+import (
+	"errors"
+	"fmt"
+
+	campaignrules "github.com/proyecto-dnd/backend/internal/campaignRules"
+	characterdata "github.com/proyecto-dnd/backend/internal/characterData"
+	characterXclass "github.com/proyecto-dnd/backend/internal/characterXClass"
+	characterXspell "github.com/proyecto-dnd/backend/internal/characterXSpell"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/spell"
+)
+
+var (
+	ErrSpellAlreadyAdded   = errors.New("the character already has this spell")
+	ErrNotOnClassList      = errors.New("the spell is not on the spell list of any of the character's classes")
+	ErrSpellLevelTooHigh   = errors.New("the character cannot cast spells of this level yet")
+	ErrSpellLimitReached   = errors.New("the character already has as many spells as the class allows")
+	ErrCantripLimitReached = errors.New("the character already knows as many cantrips as the class allows")
+	ErrOtherClass          = errors.New("the spell cannot be learned through that class")
+)
+
+type service struct {
+	characterXSpellService characterXspell.ServiceCharacterXSpell
+	characterDataService   characterdata.ServiceCharacterData
+	spellService           spell.ServiceSpell
+	campaignRulesService   campaignrules.ServiceCampaignRules
+}
+
+func NewSpellbookService(characterXSpellService characterXspell.ServiceCharacterXSpell, characterDataService characterdata.ServiceCharacterData, spellService spell.ServiceSpell, campaignRulesService campaignrules.ServiceCampaignRules) ServiceSpellbook {
+	return &service{characterXSpellService: characterXSpellService, characterDataService: characterDataService, spellService: spellService, campaignRulesService: campaignRulesService}
+}
+
+// AddSpell implements ServiceSpellbook. Unless the campaign allows free spell
+// selection, the spell must be on the list of a class of the character (always
+// prepared spells excepted), castable at that class level and within that
+// class's cantrip, known or prepared limit. The spell is learned through the
+// requested class, or else the first one that has room for it.
+func (s *service) AddSpell(characterXSpell domain.CharacterXSpell) (domain.CharacterXSpell, error) {
+	if characterXSpell.Preparation == "" {
+		characterXSpell.Preparation = characterXspell.PreparationPrepared
+	}
+	if !characterXspell.ValidPreparation(characterXSpell.Preparation) {
+		return domain.CharacterXSpell{}, characterXspell.ErrInvalidPreparation
+	}
+	character, err := s.characterDataService.GetById(characterXSpell.CharacterId)
+	if err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	links, err := s.characterXSpellService.GetByCharacterId(character.Character_Id)
+	if err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	for _, link := range links {
+		if link.SpellId == characterXSpell.SpellId {
+			return domain.CharacterXSpell{}, ErrSpellAlreadyAdded
+		}
+	}
+
+	free, err := s.freeSelection(character)
+	if err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	if !free {
+		newSpell, err := s.spellService.GetById(characterXSpell.SpellId)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		book, err := s.spellbookOf(character, links)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		candidates, err := s.learnableThrough(book, newSpell, characterXSpell.Preparation)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		if characterXSpell.ClassId != nil {
+			candidates = classesWithId(candidates, *characterXSpell.ClassId)
+			if len(candidates) == 0 {
+				return domain.CharacterXSpell{}, ErrOtherClass
+			}
+		}
+		characterXSpell.ClassId, err = s.classWithRoom(book, newSpell, characterXSpell.Preparation, candidates)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+	}
+	return s.characterXSpellService.Create(characterXSpell)
+}
+
+// SetPreparation implements ServiceSpellbook. The spell keeps counting
+// against the class it was learned through.
+func (s *service) SetPreparation(id int, preparation string) (domain.CharacterXSpell, error) {
+	if !characterXspell.ValidPreparation(preparation) {
+		return domain.CharacterXSpell{}, characterXspell.ErrInvalidPreparation
+	}
+	link, err := s.characterXSpellService.GetById(id)
+	if err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	if link.Preparation == preparation {
+		return link, nil
+	}
+	character, err := s.characterDataService.GetById(link.CharacterId)
+	if err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	free, err := s.freeSelection(character)
+	if err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	if !free {
+		changedSpell, err := s.spellService.GetById(link.SpellId)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		links, err := s.characterXSpellService.GetByCharacterId(link.CharacterId)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		others := []domain.CharacterXSpell{}
+		for _, other := range links {
+			if other.CharacterSpellId != id {
+				others = append(others, other)
+			}
+		}
+		book, err := s.spellbookOf(character, others)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		owner, err := s.owner(book, link)
+		if err != nil {
+			return domain.CharacterXSpell{}, err
+		}
+		if owners := classesWithId(character.Classes, owner); len(owners) > 0 {
+			if _, err := s.classWithRoom(book, changedSpell, preparation, owners); err != nil {
+				return domain.CharacterXSpell{}, err
+			}
+		}
+	}
+	if err := s.characterXSpellService.UpdatePreparation(id, preparation); err != nil {
+		return domain.CharacterXSpell{}, err
+	}
+	link.Preparation = preparation
+	return link, nil
+}
+
+// spellbook is what the limit checks need to know about a character's
+// spells: its links, the level of each linked spell and the class spell
+// lists, fetched once per class.
+type spellbook struct {
+	character dto.FullCharacterData
+	links     []domain.CharacterXSpell
+	levels    map[int]int
+	lists     map[int][]domain.Spell
+}
+
+func (s *service) spellbookOf(character dto.FullCharacterData, links []domain.CharacterXSpell) (*spellbook, error) {
+	spells, err := s.spellService.GetByCharacterDataId(character.Character_Id)
+	if err != nil {
+		return nil, err
+	}
+	levels := map[int]int{}
+	for _, characterSpell := range spells {
+		levels[characterSpell.SpellId] = characterSpell.Level
+	}
+	return &spellbook{character: character, links: links, levels: levels, lists: map[int][]domain.Spell{}}, nil
+}
+
+func (s *service) classList(book *spellbook, classId int) ([]domain.Spell, error) {
+	if list, ok := book.lists[classId]; ok {
+		return list, nil
+	}
+	list, err := s.spellService.GetByClassId(classId)
+	if err != nil {
+		return nil, err
+	}
+	book.lists[classId] = list
+	return list, nil
+}
+
+func (s *service) freeSelection(character dto.FullCharacterData) (bool, error) {
+	if character.Campaign_Id == 0 {
+		return false, nil
+	}
+	rules, err := s.campaignRulesService.GetByCampaignId(character.Campaign_Id)
+	if err != nil {
+		return false, err
+	}
+	return rules.FreeSpellSelection, nil
+}
+
+// learnableThrough returns the classes of the character that have the spell on
+// their list, or any class for always prepared spells, and can cast it.
+func (s *service) learnableThrough(book *spellbook, newSpell domain.Spell, preparation string) ([]domain.CharacterClass, error) {
+	onList := false
+	castable := []domain.CharacterClass{}
+	for _, characterClass := range book.character.Classes {
+		if preparation != characterXspell.PreparationAlways {
+			classSpells, err := s.classList(book, characterClass.Class.ClassId)
+			if err != nil {
+				return nil, err
+			}
+			if !containsSpell(classSpells, newSpell.SpellId) {
+				continue
+			}
+		}
+		onList = true
+		if newSpell.Level <= characterXclass.MaxSpellLevel(characterClass.Class, characterClass.Level) {
+			castable = append(castable, characterClass)
+		}
+	}
+	if !onList {
+		return nil, ErrNotOnClassList
+	}
+	if len(castable) == 0 {
+		return nil, fmt.Errorf("%w: %s is level %d", ErrSpellLevelTooHigh, newSpell.Name, newSpell.Level)
+	}
+	return castable, nil
+}
+
+// classWithRoom returns the first candidate class whose limit leaves room for
+// the spell. Cantrips count against the cantrip limit whatever their
+// preparation; for leveled spells a class that prepares spells only counts
+// prepared ones, so a wizard's spellbook can grow freely, while known casters
+// count everything. Always prepared spells count against nothing.
+func (s *service) classWithRoom(book *spellbook, newSpell domain.Spell, preparation string, candidates []domain.CharacterClass) (*int, error) {
+	cantrip := newSpell.Level == 0
+	var limitErr error
+	for _, candidate := range candidates {
+		classId := candidate.Class.ClassId
+		var limit int
+		var prepares, ok bool
+		if cantrip {
+			limit, ok = characterXclass.CantripLimit(candidate.Class, candidate.Level)
+		} else {
+			limit, prepares, ok = characterXclass.SpellLimit(characterDataOf(book.character), candidate.Class, candidate.Level)
+		}
+		counts := func(preparation string) bool {
+			if preparation == characterXspell.PreparationAlways {
+				return false
+			}
+			return cantrip || preparation == characterXspell.PreparationPrepared || !prepares
+		}
+		// Classes without a known rule (homebrew) put no cap on spells.
+		if !ok || !counts(preparation) {
+			return &classId, nil
+		}
+
+		count := 1
+		for _, link := range book.links {
+			if (book.levels[link.SpellId] == 0) != cantrip || !counts(link.Preparation) {
+				continue
+			}
+			owner, err := s.owner(book, link)
+			if err != nil {
+				return nil, err
+			}
+			if owner == classId {
+				count++
+			}
+		}
+		if count <= limit {
+			return &classId, nil
+		}
+		if cantrip {
+			limitErr = fmt.Errorf("%w: %s (%d)", ErrCantripLimitReached, candidate.Class.Name, limit)
+		} else {
+			limitErr = fmt.Errorf("%w: %s (%d)", ErrSpellLimitReached, candidate.Class.Name, limit)
+		}
+	}
+	return nil, limitErr
+}
+
+// owner returns the class a spell counts against. Spells added before links
+// recorded their class count against the first class with the spell on its
+// list, or none.
+func (s *service) owner(book *spellbook, link domain.CharacterXSpell) (int, error) {
+	if link.ClassId != nil {
+		return *link.ClassId, nil
+	}
+	for _, characterClass := range book.character.Classes {
+		classSpells, err := s.classList(book, characterClass.Class.ClassId)
+		if err != nil {
+			return 0, err
+		}
+		if containsSpell(classSpells, link.SpellId) {
+			return characterClass.Class.ClassId, nil
+		}
+	}
+	return 0, nil
+}
+
+func classesWithId(classes []domain.CharacterClass, classId int) []domain.CharacterClass {
+	for _, characterClass := range classes {
+		if characterClass.Class.ClassId == classId {
+			return []domain.CharacterClass{characterClass}
+		}
+	}
+	return nil
+}
+
+func containsSpell(spells []domain.Spell, spellId int) bool {
+	for _, classSpell := range spells {
+		if classSpell.SpellId == spellId {
+			return true
+		}
+	}
+	return false
+}
+
+func characterDataOf(character dto.FullCharacterData) domain.CharacterData {
+	return domain.CharacterData{Str: character.Str, Dex: character.Dex, Int: character.Int, Con: character.Con, Wiz: character.Wiz, Cha: character.Cha}
+}
@@ -0,0 +1,31 @@
+package subclass
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositorySubclass interface {
+	Create(subclass domain.Subclass) (domain.Subclass, error)
+	GetById(id int) (domain.Subclass, error)
+	GetByClassId(classId int) ([]domain.Subclass, error)
+	Update(subclass domain.Subclass) error
+	Delete(id int) error
+	// GetByCharacterId returns the subclasses a character chose, by class id.
+	GetByCharacterId(characterId int) (map[int]domain.Subclass, error)
+	SetForCharacter(characterId int, classId int, subclassId int) error
+	DeleteForCharacter(characterId int, classId int) error
+	DeleteByCharacterId(characterId int) error
+}
+
+type ServiceSubclass interface {
+	Create(classId int, subclass dto.SubclassDto) (domain.Subclass, error)
+	GetById(id int) (domain.Subclass, error)
+	GetByClassId(classId int) ([]domain.Subclass, error)
+	Update(id int, subclass dto.SubclassDto) (domain.Subclass, error)
+	Delete(id int) error
+	GetByCharacterId(characterId int) (map[int]domain.Subclass, error)
+	SetForCharacter(characterId int, classId int, subclassId int) error
+	DeleteForCharacter(characterId int, classId int) error
+	DeleteByCharacterId(characterId int) error
+}
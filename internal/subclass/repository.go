@@ -0,0 +1,123 @@
+package subclass
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var ErrNotFound = errors.New("subclass not found")
+
+type subclassMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewSubclassRepository(db *sql.DB) RepositorySubclass {
+	return &subclassMySqlRepository{db: db}
+}
+
+// Create implements RepositorySubclass.
+func (r *subclassMySqlRepository) Create(subclass domain.Subclass) (domain.Subclass, error) {
+	result, err := r.db.Exec(QueryInsert, subclass.ClassId, subclass.Name, subclass.Description, subclass.Level)
+	if err != nil {
+		return domain.Subclass{}, err
+	}
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return domain.Subclass{}, err
+	}
+	subclass.SubclassId = int(lastId)
+	return subclass, nil
+}
+
+// GetById implements RepositorySubclass.
+func (r *subclassMySqlRepository) GetById(id int) (domain.Subclass, error) {
+	var subclass domain.Subclass
+	err := r.db.QueryRow(QueryGetById, id).Scan(&subclass.SubclassId, &subclass.ClassId, &subclass.Name, &subclass.Description, &subclass.Level)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Subclass{}, ErrNotFound
+	}
+	return subclass, err
+}
+
+// GetByClassId implements RepositorySubclass.
+func (r *subclassMySqlRepository) GetByClassId(classId int) ([]domain.Subclass, error) {
+	rows, err := r.db.Query(QueryGetByClassId, classId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subclasses := []domain.Subclass{}
+	for rows.Next() {
+		var subclass domain.Subclass
+		if err := rows.Scan(&subclass.SubclassId, &subclass.ClassId, &subclass.Name, &subclass.Description, &subclass.Level); err != nil {
+			return nil, err
+		}
+		subclasses = append(subclasses, subclass)
+	}
+	return subclasses, rows.Err()
+}
+
+// Update implements RepositorySubclass.
+func (r *subclassMySqlRepository) Update(subclass domain.Subclass) error {
+	_, err := r.db.Exec(QueryUpdate, subclass.Name, subclass.Description, subclass.Level, subclass.SubclassId)
+	return err
+}
+
+// Delete implements RepositorySubclass.
+func (r *subclassMySqlRepository) Delete(id int) error {
+	if _, err := r.db.Exec(QueryDeleteChoices, id); err != nil {
+		return err
+	}
+	result, err := r.db.Exec(QueryDelete, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetByCharacterId implements RepositorySubclass.
+func (r *subclassMySqlRepository) GetByCharacterId(characterId int) (map[int]domain.Subclass, error) {
+	rows, err := r.db.Query(QueryGetByCharacterId, characterId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subclasses := map[int]domain.Subclass{}
+	for rows.Next() {
+		var subclass domain.Subclass
+		if err := rows.Scan(&subclass.SubclassId, &subclass.ClassId, &subclass.Name, &subclass.Description, &subclass.Level); err != nil {
+			return nil, err
+		}
+		subclasses[subclass.ClassId] = subclass
+	}
+	return subclasses, rows.Err()
+}
+
+// SetForCharacter implements RepositorySubclass.
+func (r *subclassMySqlRepository) SetForCharacter(characterId int, classId int, subclassId int) error {
+	_, err := r.db.Exec(QuerySetForCharacter, characterId, classId, subclassId)
+	return err
+}
+
+// DeleteForCharacter implements RepositorySubclass.
+func (r *subclassMySqlRepository) DeleteForCharacter(characterId int, classId int) error {
+	_, err := r.db.Exec(QueryDeleteForCharacter, characterId, classId)
+	return err
+}
+
+// DeleteByCharacterId implements RepositorySubclass.
+func (r *subclassMySqlRepository) DeleteByCharacterId(characterId int) error {
+	_, err := r.db.Exec(QueryDeleteByCharacterId, characterId)
+	return err
+}
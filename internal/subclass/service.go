@@ -0,0 +1,102 @@
+package subclass
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/class"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+var (
+	ErrMissingName  = errors.New("name is required")
+	ErrInvalidLevel = errors.New("level must be between 1 and 20")
+	ErrOtherClass   = errors.New("the subclass belongs to another class")
+)
+
+type service struct {
+	repository   RepositorySubclass
+	classService class.ClassService
+}
+
+func NewSubclassService(repository RepositorySubclass, classService class.ClassService) ServiceSubclass {
+	return &service{repository: repository, classService: classService}
+}
+
+// Create implements ServiceSubclass.
+func (s *service) Create(classId int, request dto.SubclassDto) (domain.Subclass, error) {
+	if err := validate(request); err != nil {
+		return domain.Subclass{}, err
+	}
+	if _, err := s.classService.GetById(classId); err != nil {
+		return domain.Subclass{}, err
+	}
+	return s.repository.Create(domain.Subclass{
+		ClassId:     classId,
+		Name:        strings.TrimSpace(request.Name),
+		Description: request.Description,
+		Level:       request.Level,
+	})
+}
+
+// GetById implements ServiceSubclass.
+func (s *service) GetById(id int) (domain.Subclass, error) {
+	return s.repository.GetById(id)
+}
+
+// GetByClassId implements ServiceSubclass.
+func (s *service) GetByClassId(classId int) ([]domain.Subclass, error) {
+	return s.repository.GetByClassId(classId)
+}
+
+// Update implements ServiceSubclass. A subclass stays under its class.
+func (s *service) Update(id int, request dto.SubclassDto) (domain.Subclass, error) {
+	if err := validate(request); err != nil {
+		return domain.Subclass{}, err
+	}
+	subclass, err := s.repository.GetById(id)
+	if err != nil {
+		return domain.Subclass{}, err
+	}
+	subclass.Name, subclass.Description, subclass.Level = strings.TrimSpace(request.Name), request.Description, request.Level
+	if err := s.repository.Update(subclass); err != nil {
+		return domain.Subclass{}, err
+	}
+	return subclass, nil
+}
+
+// Delete implements ServiceSubclass.
+func (s *service) Delete(id int) error {
+	return s.repository.Delete(id)
+}
+
+// GetByCharacterId implements ServiceSubclass.
+func (s *service) GetByCharacterId(characterId int) (map[int]domain.Subclass, error) {
+	return s.repository.GetByCharacterId(characterId)
+}
+
+// SetForCharacter implements ServiceSubclass.
+func (s *service) SetForCharacter(characterId int, classId int, subclassId int) error {
+	return s.repository.SetForCharacter(characterId, classId, subclassId)
+}
+
+// DeleteForCharacter implements ServiceSubclass.
+func (s *service) DeleteForCharacter(characterId int, classId int) error {
+	return s.repository.DeleteForCharacter(characterId, classId)
+}
+
+// DeleteByCharacterId implements ServiceSubclass.
+func (s *service) DeleteByCharacterId(characterId int) error {
+	return s.repository.DeleteByCharacterId(characterId)
+}
+
+func validate(request dto.SubclassDto) error {
+	if strings.TrimSpace(request.Name) == "" {
+		return ErrMissingName
+	}
+	if request.Level < 1 || request.Level > 20 {
+		return ErrInvalidLevel
+	}
+	return nil
+}
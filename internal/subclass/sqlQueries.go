@@ -0,0 +1,18 @@
+package subclass
+
+var (
+	QueryInsert       = `INSERT INTO subclass (class_id, name, description, level) VALUES (?, ?, ?, ?);`
+	QueryGetById      = `SELECT subclass_id, class_id, name, description, level FROM subclass WHERE subclass_id = ?;`
+	QueryGetByClassId = `SELECT subclass_id, class_id, name, description, level FROM subclass WHERE class_id = ? ORDER BY name;`
+	QueryUpdate       = `UPDATE subclass SET name = ?, description = ?, level = ? WHERE subclass_id = ?;`
+	QueryDelete       = `DELETE FROM subclass WHERE subclass_id = ?;`
+	// Choices go first so no character is left pointing at a deleted subclass.
+	QueryDeleteChoices = `DELETE FROM character_subclass WHERE subclass_id = ?;`
+
+	QueryGetByCharacterId = `SELECT subclass.subclass_id, subclass.class_id, subclass.name, subclass.description, subclass.level
+	FROM character_subclass INNER JOIN subclass ON character_subclass.subclass_id = subclass.subclass_id WHERE character_subclass.character_id = ?;`
+	QuerySetForCharacter = `INSERT INTO character_subclass (character_id, class_id, subclass_id) VALUES (?, ?, ?)
+	ON DUPLICATE KEY UPDATE subclass_id = VALUES(subclass_id);`
+	QueryDeleteForCharacter  = `DELETE FROM character_subclass WHERE character_id = ? AND class_id = ?;`
+	QueryDeleteByCharacterId = `DELETE FROM character_subclass WHERE character_id = ?;`
+)
@@ -0,0 +1,22 @@
+package subrace
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositorySubrace interface {
+	Create(subrace domain.Subrace) (domain.Subrace, error)
+	GetById(id int) (domain.Subrace, error)
+	GetByRaceId(raceId int) ([]domain.Subrace, error)
+	Update(subrace domain.Subrace) error
+	Delete(id int) error
+}
+
+type ServiceSubrace interface {
+	Create(raceId int, subrace dto.SubraceDto) (domain.Subrace, error)
+	GetById(id int) (domain.Subrace, error)
+	GetByRaceId(raceId int) ([]domain.Subrace, error)
+	Update(id int, subrace dto.SubraceDto) (domain.Subrace, error)
+	Delete(id int) error
+}
@@ -0,0 +1,188 @@
+package subrace
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var ErrNotFound = errors.New("subrace not found")
+
+type subraceMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewSubraceRepository(db *sql.DB) RepositorySubrace {
+	return &subraceMySqlRepository{db: db}
+}
+
+// Create implements RepositorySubrace.
+func (r *subraceMySqlRepository) Create(subrace domain.Subrace) (domain.Subrace, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(QueryInsert, subrace.RaceId, subrace.Name, subrace.Description, subrace.Speed, subrace.Str, subrace.Dex, subrace.Int, subrace.Con, subrace.Wiz, subrace.Cha)
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	subrace.SubraceId = int(lastId)
+	if err := insertLinks(tx, subrace); err != nil {
+		return domain.Subrace{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.Subrace{}, err
+	}
+	return subrace, nil
+}
+
+// GetById implements RepositorySubrace.
+func (r *subraceMySqlRepository) GetById(id int) (domain.Subrace, error) {
+	subraces, err := r.query(QueryGetById, QueryGetProficienciesById, QueryGetFeaturesById, id)
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	if len(subraces) == 0 {
+		return domain.Subrace{}, ErrNotFound
+	}
+	return subraces[0], nil
+}
+
+// GetByRaceId implements RepositorySubrace.
+func (r *subraceMySqlRepository) GetByRaceId(raceId int) ([]domain.Subrace, error) {
+	return r.query(QueryGetByRaceId, QueryGetProficienciesByRaceId, QueryGetFeaturesByRaceId, raceId)
+}
+
+// Update implements RepositorySubrace. The proficiencies and features of the
+// subrace are replaced.
+func (r *subraceMySqlRepository) Update(subrace domain.Subrace) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryUpdate, subrace.Name, subrace.Description, subrace.Speed, subrace.Str, subrace.Dex, subrace.Int, subrace.Con, subrace.Wiz, subrace.Cha, subrace.SubraceId); err != nil {
+		return err
+	}
+	if err := deleteLinks(tx, subrace.SubraceId); err != nil {
+		return err
+	}
+	if err := insertLinks(tx, subrace); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Delete implements RepositorySubrace.
+func (r *subraceMySqlRepository) Delete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryClearCharacters, id); err != nil {
+		return err
+	}
+	if err := deleteLinks(tx, id); err != nil {
+		return err
+	}
+	result, err := tx.Exec(QueryDelete, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected < 1 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// query reads subraces and attaches their proficiencies and features, all
+// three queries taking the same argument.
+func (r *subraceMySqlRepository) query(subracesQuery string, proficienciesQuery string, featuresQuery string, arg int) ([]domain.Subrace, error) {
+	rows, err := r.db.Query(subracesQuery, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subraces := []domain.Subrace{}
+	index := map[int]int{}
+	for rows.Next() {
+		subrace := domain.Subrace{ProficiencyIds: []int{}, FeatureIds: []int{}}
+		if err := rows.Scan(&subrace.SubraceId, &subrace.RaceId, &subrace.Name, &subrace.Description, &subrace.Speed, &subrace.Str, &subrace.Dex, &subrace.Int, &subrace.Con, &subrace.Wiz, &subrace.Cha); err != nil {
+			return nil, err
+		}
+		index[subrace.SubraceId] = len(subraces)
+		subraces = append(subraces, subrace)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.queryLinks(proficienciesQuery, arg, func(i int, id int) {
+		subraces[i].ProficiencyIds = append(subraces[i].ProficiencyIds, id)
+	}, index); err != nil {
+		return nil, err
+	}
+	if err := r.queryLinks(featuresQuery, arg, func(i int, id int) {
+		subraces[i].FeatureIds = append(subraces[i].FeatureIds, id)
+	}, index); err != nil {
+		return nil, err
+	}
+	return subraces, nil
+}
+
+// queryLinks reads subrace_id, linked id pairs and adds each to the subrace
+// at its index.
+func (r *subraceMySqlRepository) queryLinks(query string, arg int, add func(i int, id int), index map[int]int) error {
+	rows, err := r.db.Query(query, arg)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var subraceId, id int
+		if err := rows.Scan(&subraceId, &id); err != nil {
+			return err
+		}
+		if i, ok := index[subraceId]; ok {
+			add(i, id)
+		}
+	}
+	return rows.Err()
+}
+
+func insertLinks(tx *sql.Tx, subrace domain.Subrace) error {
+	for _, proficiencyId := range subrace.ProficiencyIds {
+		if _, err := tx.Exec(QueryInsertProficiency, subrace.SubraceId, proficiencyId); err != nil {
+			return err
+		}
+	}
+	for _, featureId := range subrace.FeatureIds {
+		if _, err := tx.Exec(QueryInsertFeature, subrace.SubraceId, featureId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteLinks(tx *sql.Tx, id int) error {
+	if _, err := tx.Exec(QueryDeleteProficiencies, id); err != nil {
+		return err
+	}
+	_, err := tx.Exec(QueryDeleteFeatures, id)
+	return err
+}
@@ -0,0 +1,121 @@
+package subrace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+	"github.com/proyecto-dnd/backend/internal/feature"
+	"github.com/proyecto-dnd/backend/internal/proficiency"
+	"github.com/proyecto-dnd/backend/internal/race"
+)
+
+var (
+	ErrMissingName        = errors.New("name is required")
+	ErrUnknownProficiency = errors.New("unknown proficiency")
+	ErrUnknownFeature     = errors.New("unknown feature")
+	ErrOtherRace          = errors.New("the subrace belongs to another race")
+)
+
+type service struct {
+	repository         RepositorySubrace
+	raceService        race.RaceService
+	proficiencyService proficiency.ProficiencyService
+	featureService     feature.FeatureService
+}
+
+func NewSubraceService(repository RepositorySubrace, raceService race.RaceService, proficiencyService proficiency.ProficiencyService, featureService feature.FeatureService) ServiceSubrace {
+	return &service{repository: repository, raceService: raceService, proficiencyService: proficiencyService, featureService: featureService}
+}
+
+// Create implements ServiceSubrace.
+func (s *service) Create(raceId int, request dto.SubraceDto) (domain.Subrace, error) {
+	if _, err := s.raceService.GetRaceByID(raceId); err != nil {
+		return domain.Subrace{}, err
+	}
+	subrace, err := s.build(request)
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	subrace.RaceId = raceId
+	return s.repository.Create(subrace)
+}
+
+// GetById implements ServiceSubrace.
+func (s *service) GetById(id int) (domain.Subrace, error) {
+	return s.repository.GetById(id)
+}
+
+// GetByRaceId implements ServiceSubrace.
+func (s *service) GetByRaceId(raceId int) ([]domain.Subrace, error) {
+	return s.repository.GetByRaceId(raceId)
+}
+
+// Update implements ServiceSubrace. A subrace stays under its race, and
+// characters keep what it granted before.
+func (s *service) Update(id int, request dto.SubraceDto) (domain.Subrace, error) {
+	current, err := s.repository.GetById(id)
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	subrace, err := s.build(request)
+	if err != nil {
+		return domain.Subrace{}, err
+	}
+	subrace.SubraceId, subrace.RaceId = current.SubraceId, current.RaceId
+	if err := s.repository.Update(subrace); err != nil {
+		return domain.Subrace{}, err
+	}
+	return subrace, nil
+}
+
+// Delete implements ServiceSubrace.
+func (s *service) Delete(id int) error {
+	return s.repository.Delete(id)
+}
+
+// build validates a request, checking that what the subrace grants exists.
+// Repeated ids are granted once.
+func (s *service) build(request dto.SubraceDto) (domain.Subrace, error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return domain.Subrace{}, ErrMissingName
+	}
+	subrace := domain.Subrace{
+		Name:           strings.TrimSpace(request.Name),
+		Description:    request.Description,
+		Speed:          request.Speed,
+		Str:            request.Str,
+		Dex:            request.Dex,
+		Int:            request.Int,
+		Con:            request.Con,
+		Wiz:            request.Wiz,
+		Cha:            request.Cha,
+		ProficiencyIds: []int{},
+		FeatureIds:     []int{},
+	}
+	seen := map[int]bool{}
+	for _, proficiencyId := range request.ProficiencyIds {
+		if seen[proficiencyId] {
+			continue
+		}
+		if _, err := s.proficiencyService.GetById(proficiencyId); err != nil {
+			return domain.Subrace{}, fmt.Errorf("%w: %d", ErrUnknownProficiency, proficiencyId)
+		}
+		seen[proficiencyId] = true
+		subrace.ProficiencyIds = append(subrace.ProficiencyIds, proficiencyId)
+	}
+	seen = map[int]bool{}
+	for _, featureId := range request.FeatureIds {
+		if seen[featureId] {
+			continue
+		}
+		if _, err := s.featureService.GetFeatureById(featureId); err != nil {
+			return domain.Subrace{}, fmt.Errorf("%w: %d", ErrUnknownFeature, featureId)
+		}
+		seen[featureId] = true
+		subrace.FeatureIds = append(subrace.FeatureIds, featureId)
+	}
+	return subrace, nil
+}
@@ -0,0 +1,24 @@
+package subrace
+
+var (
+	QueryInsert = "INSERT INTO subrace (race_id, name, description, speed, str, dex, `int`, con, wiz, cha) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);"
+	QueryUpdate = "UPDATE subrace SET name = ?, description = ?, speed = ?, str = ?, dex = ?, `int` = ?, con = ?, wiz = ?, cha = ? WHERE subrace_id = ?;"
+	QueryDelete = `DELETE FROM subrace WHERE subrace_id = ?;`
+	// Characters of a deleted subrace keep their race.
+	QueryClearCharacters = `UPDATE character_data SET subrace_id = NULL WHERE subrace_id = ?;`
+
+	QueryInsertProficiency   = `INSERT INTO subrace_proficiency (subrace_id, proficiency_id) VALUES (?, ?);`
+	QueryDeleteProficiencies = `DELETE FROM subrace_proficiency WHERE subrace_id = ?;`
+	QueryInsertFeature       = `INSERT INTO subrace_feature (subrace_id, feature_id) VALUES (?, ?);`
+	QueryDeleteFeatures      = `DELETE FROM subrace_feature WHERE subrace_id = ?;`
+
+	QueryGetById              = "SELECT subrace_id, race_id, name, description, speed, str, dex, `int`, con, wiz, cha FROM subrace WHERE subrace_id = ?;"
+	QueryGetProficienciesById = `SELECT subrace_id, proficiency_id FROM subrace_proficiency WHERE subrace_id = ? ORDER BY proficiency_id;`
+	QueryGetFeaturesById      = `SELECT subrace_id, feature_id FROM subrace_feature WHERE subrace_id = ? ORDER BY feature_id;`
+
+	QueryGetByRaceId              = "SELECT subrace_id, race_id, name, description, speed, str, dex, `int`, con, wiz, cha FROM subrace WHERE race_id = ? ORDER BY name;"
+	QueryGetProficienciesByRaceId = `SELECT sp.subrace_id, sp.proficiency_id FROM subrace_proficiency sp
+	INNER JOIN subrace ON sp.subrace_id = subrace.subrace_id WHERE subrace.race_id = ? ORDER BY sp.proficiency_id;`
+	QueryGetFeaturesByRaceId = `SELECT sf.subrace_id, sf.feature_id FROM subrace_feature sf
+	INNER JOIN subrace ON sf.subrace_id = subrace.subrace_id WHERE subrace.race_id = ? ORDER BY sf.feature_id;`
+)
@@ -4,6 +4,9 @@ import "github.com/proyecto-dnd/backend/internal/domain"
 
 type RepositoryTradeEvent interface {
 	Create(tradeEvent domain.TradeEvent) (domain.TradeEvent, error)
+	// Trade stores the trade event, moves its coins, weapons, armor and items
+	// and records them inside one transaction, or does nothing at all.
+	Trade(tradeEvent domain.TradeEvent) (domain.TradeEvent, error)
 	GetBySessionId(sessionId int) ([]domain.TradeEvent, error)
 	GetBySender(sender int) ([]domain.TradeEvent, error)
 	GetByReceiver(receiver int) ([]domain.TradeEvent, error)
@@ -2,13 +2,11 @@ package tradeevent
 
 import (
 	"errors"
-	"fmt"
 
-	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
 	charactertrade "github.com/proyecto-dnd/backend/internal/characterTrade"
 	"github.com/proyecto-dnd/backend/internal/domain"
 	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
-	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/wallet"
 )
 
 var (
@@ -16,15 +14,14 @@ var (
 )
 
 type serviceTradeEvent struct {
-	tradeEventRepo          RepositoryTradeEvent
-	characterTradeService   charactertrade.ServiceCharacterTrade
-	weaponXCharacterService weaponxcharacterdata.ServiceWeaponXCharacterData
-	armorXCharacterService  armorXCharacterData.ServiceArmorXCharacterData
-	itemXCharacterService   itemxcharacterdata.ServiceItemXCharacterData
+	tradeEventRepo        RepositoryTradeEvent
+	characterTradeService charactertrade.ServiceCharacterTrade
+	itemXCharacterService itemxcharacterdata.ServiceItemXCharacterData
+	walletService         wallet.ServiceWallet
 }
 
-func NewTradeEventService(tradeEventRepo RepositoryTradeEvent, characterTradeService charactertrade.ServiceCharacterTrade, weaponService weaponxcharacterdata.ServiceWeaponXCharacterData, armorService armorXCharacterData.ServiceArmorXCharacterData, itemService itemxcharacterdata.ServiceItemXCharacterData) ServiceTradeEvent {
-	return &serviceTradeEvent{tradeEventRepo, characterTradeService, weaponService, armorService, itemService}
+func NewTradeEventService(tradeEventRepo RepositoryTradeEvent, characterTradeService charactertrade.ServiceCharacterTrade, itemService itemxcharacterdata.ServiceItemXCharacterData, walletService wallet.ServiceWallet) ServiceTradeEvent {
+	return &serviceTradeEvent{tradeEventRepo, characterTradeService, itemService, walletService}
 }
 
 // DeleteBySenderOrReciever implements ServiceTradeEvent.
@@ -43,60 +40,27 @@ func (s *serviceTradeEvent) DeleteBySenderOrReciever(id int) error {
 }
 
 // Create implements ServiceTradeEvent.
+// The trade is checked up front and then applied by the repository in one
+// transaction, so coins and items move together or not at all.
 // TO DO: Implement method in itemXCharacterData to search by characterData_Id and Item_Id, must also be implemented in itemXcharacterData's create to prevent duping of entries
 func (s *serviceTradeEvent) Create(tradeEvent domain.TradeEvent) (domain.TradeEvent, error) {
-	newTradeEvent, err := s.tradeEventRepo.Create(tradeEvent)
-	if err != nil {
-		return domain.TradeEvent{}, err
+	if tradeEvent.Currency != nil && wallet.IsZero(*tradeEvent.Currency) {
+		tradeEvent.Currency = nil
 	}
-
-	for i, tradingItems := range tradeEvent.TradingItems {
-		tradeEvent.TradingItems[i].TradeEvent_Id = newTradeEvent.TradeEvent_Id
-		if tradingItems.WeaponXCharacter != nil {
-			err = s.weaponXCharacterService.UpdateOwnership(domain.WeaponXCharacterData{Character_Weapon_Id: *tradingItems.WeaponXCharacter, CharacterData_Id: tradingItems.ItemReciever, Weapon: domain.Weapon{}, Equipped: false})
-			fmt.Println("updating weapon")
-			if err != nil {
-				return domain.TradeEvent{}, err
-			}
-		}
-		if tradingItems.ArmorXCharacter != nil {
-			fmt.Println("updating armor")
-			err = s.armorXCharacterService.UpdateOwnership(domain.ArmorXCharacterData{ArmorXCharacterData_Id: *tradingItems.ArmorXCharacter, Armor: domain.Armor{}, CharacterData_Id: tradingItems.ItemReciever, Equipped: false})
-			if err != nil {
-				return domain.TradeEvent{}, err
-			}
-		}
-
-		if tradingItems.ItemXCharacter != nil {
-			itemXCharacterToUpdate, err := s.itemXCharacterService.GetById(*tradingItems.ItemXCharacter)
-			if err != nil {
-				return domain.TradeEvent{}, err
-			}
-			if itemXCharacterToUpdate.Quantity < *tradingItems.Quantity {
-				return domain.TradeEvent{}, ErrCannotBeNegative
-			}
-			if itemXCharacterToUpdate.Quantity == *tradingItems.Quantity {
-				err = s.itemXCharacterService.UpdateOwnership(domain.ItemXCharacterData{Character_Item_Id: *tradingItems.ItemXCharacter, CharacterData_Id: tradeEvent.Receiver, Item: domain.Item{Item_Id: itemXCharacterToUpdate.Item.Item_Id}, Quantity: itemXCharacterToUpdate.Quantity})
-				if err != nil {
-					return domain.TradeEvent{}, err
-				}
-			} else {
-				_, err = s.itemXCharacterService.Update(domain.ItemXCharacterData{Character_Item_Id: *tradingItems.ItemXCharacter, CharacterData_Id: tradeEvent.Sender, Item: domain.Item{Item_Id: itemXCharacterToUpdate.Item.Item_Id}, Quantity: itemXCharacterToUpdate.Quantity - *tradingItems.Quantity})
-				if err != nil {
-					return domain.TradeEvent{}, err
-				}
-				_, err = s.itemXCharacterService.Create(domain.ItemXCharacterData{Character_Item_Id: *tradingItems.ItemXCharacter, CharacterData_Id: tradeEvent.Receiver, Item: domain.Item{Item_Id: itemXCharacterToUpdate.Item.Item_Id}, Quantity: *tradingItems.Quantity})
-				if err != nil {
-					return domain.TradeEvent{}, err
-				}
-			}
-
+	if tradeEvent.Currency != nil {
+		if err := s.walletService.CanPay(tradeEvent.Sender, *tradeEvent.Currency); err != nil {
+			return domain.TradeEvent{}, err
 		}
 	}
-	err = s.characterTradeService.BulkCreateCharacterTrade(tradeEvent.TradingItems)
+	if err := s.checkItemQuantities(tradeEvent.TradingItems); err != nil {
+		return domain.TradeEvent{}, err
+	}
+
+	newTradeEvent, err := s.tradeEventRepo.Trade(tradeEvent)
 	if err != nil {
 		return domain.TradeEvent{}, err
 	}
+
 	newTradeEvent.TradingItems, err = s.characterTradeService.GetByTradeEventId(newTradeEvent.TradeEvent_Id)
 	if err != nil {
 		return domain.TradeEvent{}, err
@@ -104,6 +68,22 @@ func (s *serviceTradeEvent) Create(tradeEvent domain.TradeEvent) (domain.TradeEv
 	return newTradeEvent, nil
 }
 
+func (s *serviceTradeEvent) checkItemQuantities(tradingItems []domain.CharacterTrade) error {
+	for _, tradingItem := range tradingItems {
+		if tradingItem.ItemXCharacter == nil {
+			continue
+		}
+		itemXCharacter, err := s.itemXCharacterService.GetById(*tradingItem.ItemXCharacter)
+		if err != nil {
+			return err
+		}
+		if tradingItem.Quantity == nil || *tradingItem.Quantity < 0 || itemXCharacter.Quantity < *tradingItem.Quantity {
+			return ErrCannotBeNegative
+		}
+	}
+	return nil
+}
+
 // Delete implements ServiceTradeEvent.
 func (s *serviceTradeEvent) Delete(id int) error {
 	s.characterTradeService.DeleteByTradeEventId(id)
@@ -116,11 +96,8 @@ func (s *serviceTradeEvent) GetByReceiver(receiver int) ([]domain.TradeEvent, er
 	if err != nil {
 		return []domain.TradeEvent{}, err
 	}
-	for i := range tradeEvents {
-		tradeEvents[i].TradingItems, err = s.characterTradeService.GetByTradeEventId(tradeEvents[i].TradeEvent_Id)
-		if err != nil {
-			return []domain.TradeEvent{}, err
-		}
+	if err := s.loadTradeDetails(tradeEvents); err != nil {
+		return []domain.TradeEvent{}, err
 	}
 	return tradeEvents, nil
 }
@@ -131,11 +108,8 @@ func (s *serviceTradeEvent) GetBySender(sender int) ([]domain.TradeEvent, error)
 	if err != nil {
 		return []domain.TradeEvent{}, err
 	}
-	for i := range tradeEvents {
-		tradeEvents[i].TradingItems, err = s.characterTradeService.GetByTradeEventId(tradeEvents[i].TradeEvent_Id)
-		if err != nil {
-			return []domain.TradeEvent{}, err
-		}
+	if err := s.loadTradeDetails(tradeEvents); err != nil {
+		return []domain.TradeEvent{}, err
 	}
 	return tradeEvents, nil
 }
@@ -146,11 +120,8 @@ func (s *serviceTradeEvent) GetBySenderOrReciever(id int) ([]domain.TradeEvent,
 	if err != nil {
 		return []domain.TradeEvent{}, err
 	}
-	for i := range tradeEvents {
-		tradeEvents[i].TradingItems, err = s.characterTradeService.GetByTradeEventId(tradeEvents[i].TradeEvent_Id)
-		if err != nil {
-			return []domain.TradeEvent{}, err
-		}
+	if err := s.loadTradeDetails(tradeEvents); err != nil {
+		return []domain.TradeEvent{}, err
 	}
 	return tradeEvents, nil
 }
@@ -161,12 +132,24 @@ func (s *serviceTradeEvent) GetBySessionId(sessionId int) ([]domain.TradeEvent,
 	if err != nil {
 		return []domain.TradeEvent{}, err
 	}
+	if err := s.loadTradeDetails(tradeEvents); err != nil {
+		return []domain.TradeEvent{}, err
+	}
+	return tradeEvents, nil
+}
+
+
+func (s *serviceTradeEvent) loadTradeDetails(tradeEvents []domain.TradeEvent) error {
+	var err error
 	for i := range tradeEvents {
 		tradeEvents[i].TradingItems, err = s.characterTradeService.GetByTradeEventId(tradeEvents[i].TradeEvent_Id)
 		if err != nil {
-			return []domain.TradeEvent{}, err
+			return err
+		}
+		tradeEvents[i].Currency, err = s.walletService.GetTradeCurrency(tradeEvents[i].TradeEvent_Id)
+		if err != nil {
+			return err
 		}
 	}
-	return tradeEvents, nil
+	return nil
 }
-
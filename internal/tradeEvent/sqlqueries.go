@@ -7,4 +7,6 @@ var (
 	QueryGetByReceiver = "SELECT trade_event_id, session_id, sender, receiver, description, timestamp FROM trade_event WHERE receiver = ?"
 	QueryGetBySenderOrReciever = "SELECT trade_event_id, session_id, sender, receiver, description, timestamp FROM trade_event WHERE sender = ? OR receiver = ?"
 	QueryDelete = "DELETE FROM trade_event WHERE trade_event_id = ?"
+	QueryLockCharacterItem = "SELECT item_id, quantity FROM character_item WHERE character_item_id = ? FOR UPDATE"
+	QuerySplitCharacterItem = "UPDATE character_item SET quantity = quantity - ? WHERE character_item_id = ?"
 )
\ No newline at end of file
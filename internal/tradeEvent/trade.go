@@ -0,0 +1,117 @@
+package tradeevent
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/proyecto-dnd/backend/internal/armorXCharacterData"
+	charactertrade "github.com/proyecto-dnd/backend/internal/characterTrade"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	itemxcharacterdata "github.com/proyecto-dnd/backend/internal/itemXCharacterData"
+	"github.com/proyecto-dnd/backend/internal/wallet"
+	weaponxcharacterdata "github.com/proyecto-dnd/backend/internal/weaponXCharacterData"
+)
+
+// Trade implements RepositoryTradeEvent. Traded weapons and armor are
+// unequipped; items given in part are split into a new entry for the
+// receiver.
+func (t *tradeEventMysqlRepository) Trade(tradeEvent domain.TradeEvent) (domain.TradeEvent, error) {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return domain.TradeEvent{}, err
+	}
+	defer tx.Rollback()
+
+	timestamp := time.Now()
+	result, err := tx.Exec(QueryInsert, tradeEvent.Session_Id, tradeEvent.Sender, tradeEvent.Receiver, tradeEvent.Description, timestamp)
+	if err != nil {
+		return domain.TradeEvent{}, err
+	}
+	lastInsert, err := result.LastInsertId()
+	if err != nil {
+		return domain.TradeEvent{}, err
+	}
+	tradeEvent.TradeEvent_Id = int(lastInsert)
+	tradeEvent.Timestamp = &timestamp
+
+	if tradeEvent.Currency != nil {
+		reason := fmt.Sprintf("trade #%d", tradeEvent.TradeEvent_Id)
+		_, err := wallet.ApplyTx(tx, []int{tradeEvent.Sender, tradeEvent.Receiver}, reason, &tradeEvent.TradeEvent_Id, wallet.TransferChange(*tradeEvent.Currency))
+		if err != nil {
+			return domain.TradeEvent{}, err
+		}
+	}
+
+	for i := range tradeEvent.TradingItems {
+		tradeEvent.TradingItems[i].TradeEvent_Id = tradeEvent.TradeEvent_Id
+		if err := moveTradedItem(tx, tradeEvent, tradeEvent.TradingItems[i]); err != nil {
+			return domain.TradeEvent{}, err
+		}
+	}
+	if err := insertCharacterTrades(tx, tradeEvent.TradingItems); err != nil {
+		return domain.TradeEvent{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.TradeEvent{}, err
+	}
+	return tradeEvent, nil
+}
+
+func moveTradedItem(tx *sql.Tx, tradeEvent domain.TradeEvent, tradingItem domain.CharacterTrade) error {
+	if tradingItem.WeaponXCharacter != nil {
+		if _, err := tx.Exec(weaponxcharacterdata.QueryUpdateOwnership, tradingItem.ItemReciever, false, *tradingItem.WeaponXCharacter); err != nil {
+			return err
+		}
+	}
+	if tradingItem.ArmorXCharacter != nil {
+		if _, err := tx.Exec(armorXCharacterData.QueryUpdateOwnership, tradingItem.ItemReciever, false, *tradingItem.ArmorXCharacter); err != nil {
+			return err
+		}
+	}
+	if tradingItem.ItemXCharacter == nil {
+		return nil
+	}
+
+	var itemId, quantity int
+	if err := tx.QueryRow(QueryLockCharacterItem, *tradingItem.ItemXCharacter).Scan(&itemId, &quantity); err != nil {
+		return err
+	}
+	if tradingItem.Quantity == nil || *tradingItem.Quantity < 0 || quantity < *tradingItem.Quantity {
+		return ErrCannotBeNegative
+	}
+	if quantity == *tradingItem.Quantity {
+		_, err := tx.Exec(itemxcharacterdata.QueryUpdateOwnership, tradeEvent.Receiver, quantity, *tradingItem.ItemXCharacter)
+		return err
+	}
+	if _, err := tx.Exec(QuerySplitCharacterItem, *tradingItem.Quantity, *tradingItem.ItemXCharacter); err != nil {
+		return err
+	}
+	_, err := tx.Exec(itemxcharacterdata.QueryCreateItemXCharacterData, tradeEvent.Receiver, itemId, *tradingItem.Quantity)
+	return err
+}
+
+func insertCharacterTrades(tx *sql.Tx, tradingItems []domain.CharacterTrade) error {
+	if len(tradingItems) == 0 {
+		return nil
+	}
+	rows := make([]string, len(tradingItems))
+	values := []interface{}{}
+	for i, tradingItem := range tradingItems {
+		rows[i] = "(?, ?, ?, ?, ?, ?, ?, ?, ?)"
+		values = append(values,
+			tradingItem.TradeEvent_Id,
+			tradingItem.WeaponXCharacter,
+			tradingItem.ItemXCharacter,
+			tradingItem.ArmorXCharacter,
+			tradingItem.ItemOwner,
+			tradingItem.ItemReciever,
+			tradingItem.Quantity,
+			tradingItem.ItemName,
+			tradingItem.ItemType)
+	}
+	_, err := tx.Exec(charactertrade.QueryBulkInsert+strings.Join(rows, ", "), values...)
+	return err
+}
@@ -0,0 +1,87 @@
+package translation
+
+import (
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+// LocalizeCharacter translates the catalog entries a character sheet embeds.
+func LocalizeCharacter(service ServiceTranslation, locale string, character *dto.FullCharacterData) error {
+	races := []domain.Race{character.Race}
+	if err := Localize(service, "race", locale, races, func(r *domain.Race) Text {
+		return Text{Id: r.RaceID, Name: &r.Name, Description: &r.Description}
+	}); err != nil {
+		return err
+	}
+	character.Race = races[0]
+
+	classes := []domain.Class{character.Class}
+	for _, characterClass := range character.Classes {
+		classes = append(classes, characterClass.Class)
+	}
+	if err := Localize(service, "class", locale, classes, func(c *domain.Class) Text {
+		return Text{Id: c.ClassId, Name: &c.Name, Description: &c.Description}
+	}); err != nil {
+		return err
+	}
+	character.Class = classes[0]
+	for i := range character.Classes {
+		character.Classes[i].Class = classes[i+1]
+	}
+
+	backgrounds := []domain.Background{character.Background}
+	if err := Localize(service, "background", locale, backgrounds, func(b *domain.Background) Text {
+		return Text{Id: b.BackgroundID, Name: &b.Name}
+	}); err != nil {
+		return err
+	}
+	character.Background = backgrounds[0]
+
+	items := make([]domain.Item, len(character.Items))
+	for i, item := range character.Items {
+		items[i] = item.Item
+	}
+	if err := Localize(service, "item", locale, items, func(i *domain.Item) Text {
+		return Text{Id: i.Item_Id, Name: &i.Name, Description: &i.Description}
+	}); err != nil {
+		return err
+	}
+	for i := range character.Items {
+		character.Items[i].Item = items[i]
+	}
+
+	weapons := make([]domain.Weapon, len(character.Weapons))
+	for i, weapon := range character.Weapons {
+		weapons[i] = weapon.Weapon
+	}
+	if err := Localize(service, "weapon", locale, weapons, func(w *domain.Weapon) Text {
+		return Text{Id: w.Weapon_Id, Name: &w.Name, Description: &w.Description}
+	}); err != nil {
+		return err
+	}
+	for i := range character.Weapons {
+		character.Weapons[i].Weapon = weapons[i]
+	}
+
+	armor := make([]domain.Armor, len(character.Armor))
+	for i, piece := range character.Armor {
+		armor[i] = piece.Armor
+	}
+	if err := Localize(service, "armor", locale, armor, func(a *domain.Armor) Text {
+		return Text{Id: a.ArmorId, Name: &a.Name, Description: &a.Description}
+	}); err != nil {
+		return err
+	}
+	for i := range character.Armor {
+		character.Armor[i].Armor = armor[i]
+	}
+
+	if err := Localize(service, "feature", locale, character.Features, func(f *domain.Feature) Text {
+		return Text{Id: f.FeatureId, Name: &f.Name, Description: &f.Description}
+	}); err != nil {
+		return err
+	}
+	return Localize(service, "spell", locale, character.Spells, func(s *domain.Spell) Text {
+		return Text{Id: s.SpellId, Name: &s.Name, Description: &s.Description}
+	})
+}
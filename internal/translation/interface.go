@@ -0,0 +1,27 @@
+package translation
+
+import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+type RepositoryTranslation interface {
+	GetByEntry(catalogName string, entryId int) ([]domain.Translation, error)
+	GetByCatalog(catalogName string) ([]domain.Translation, error)
+	GetByEntries(catalogName string, locale string, entryIds []int) ([]domain.Translation, error)
+	GetCampaignId(table catalog.Table, entryId int) (*int, error)
+	Save(translation domain.Translation) error
+	Replace(catalogName string, entryId int, translations []domain.Translation) error
+	Delete(catalogName string, entryId int, locale string) error
+}
+
+type ServiceTranslation interface {
+	GetByEntry(catalogName string, entryId int) (dto.TranslationsDto, error)
+	GetByCatalog(catalogName string) (map[int]dto.TranslationsDto, error)
+	GetByEntries(catalogName string, locale string, entryIds []int) (map[int]dto.TranslationDto, error)
+	GetCampaignId(catalogName string, entryId int) (*int, error)
+	Set(catalogName string, entryId int, locale string, translation dto.TranslationDto) (dto.TranslationsDto, error)
+	Replace(catalogName string, entryId int, translations dto.TranslationsDto) error
+	Delete(catalogName string, entryId int, locale string) error
+}
@@ -0,0 +1,65 @@
+package translation
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is used when neither the user nor the request ask for a
+// supported locale.
+const DefaultLocale = "es"
+
+// Locales are the languages catalog entries can be translated to.
+var Locales = []string{"es", "en"}
+
+// Supported reports whether catalog entries can be translated to locale.
+func Supported(locale string) bool {
+	return slices.Contains(Locales, locale)
+}
+
+// Negotiate picks the locale of a request. The preference of the user comes
+// first, then the best supported language of the Accept-Language header, such
+// as "en-US,en;q=0.9,es;q=0.8", and then DefaultLocale.
+func Negotiate(preference string, acceptLanguage string) string {
+	if Supported(preference) {
+		return preference
+	}
+	type weighted struct {
+		locale  string
+		quality float64
+	}
+	candidates := []weighted{}
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		language, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if language == "*" {
+			language = DefaultLocale
+		}
+		if quality > 0 && Supported(language) {
+			candidates = append(candidates, weighted{locale: language, quality: quality})
+		}
+	}
+	if len(candidates) == 0 {
+		return DefaultLocale
+	}
+	// Languages of the same quality keep the order of the header.
+	slices.SortStableFunc(candidates, func(a, b weighted) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		}
+		return 0
+	})
+	return candidates[0].locale
+}
@@ -0,0 +1,37 @@
+package translation
+
+// Text points at the translatable fields of a catalog entry. Description is
+// nil for catalogs without one.
+type Text struct {
+	Id          int
+	Name        *string
+	Description *string
+}
+
+// Localize swaps the name and description of entries for their translation
+// to locale. Entries without one keep the text they were written in.
+func Localize[T any](service ServiceTranslation, catalogName string, locale string, entries []T, text func(*T) Text) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	ids := make([]int, len(entries))
+	for i := range entries {
+		ids[i] = text(&entries[i]).Id
+	}
+	translations, err := service.GetByEntries(catalogName, locale, ids)
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		fields := text(&entries[i])
+		translation, ok := translations[fields.Id]
+		if !ok {
+			continue
+		}
+		*fields.Name = translation.Name
+		if fields.Description != nil && translation.Description != "" {
+			*fields.Description = translation.Description
+		}
+	}
+	return nil
+}
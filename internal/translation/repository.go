@@ -0,0 +1,125 @@
+package translation
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrPrepareStatement = errors.New("error preparing statement")
+	ErrNotFound         = errors.New("translation not found")
+	ErrEntryNotFound    = errors.New("catalog entry not found")
+)
+
+type translationMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewTranslationRepository(db *sql.DB) RepositoryTranslation {
+	return &translationMySqlRepository{db: db}
+}
+
+// GetByEntry implements RepositoryTranslation.
+func (r *translationMySqlRepository) GetByEntry(catalogName string, entryId int) ([]domain.Translation, error) {
+	return r.query(QueryGetByEntry, catalogName, entryId)
+}
+
+// GetByCatalog implements RepositoryTranslation.
+func (r *translationMySqlRepository) GetByCatalog(catalogName string) ([]domain.Translation, error) {
+	return r.query(QueryGetByCatalog, catalogName)
+}
+
+// GetByEntries implements RepositoryTranslation.
+func (r *translationMySqlRepository) GetByEntries(catalogName string, locale string, entryIds []int) ([]domain.Translation, error) {
+	if len(entryIds) == 0 {
+		return []domain.Translation{}, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(entryIds)), ", ")
+	args := []any{catalogName, locale}
+	for _, id := range entryIds {
+		args = append(args, id)
+	}
+	return r.query(fmt.Sprintf(QueryGetByEntries, placeholders), args...)
+}
+
+// GetCampaignId implements RepositoryTranslation.
+func (r *translationMySqlRepository) GetCampaignId(table catalog.Table, entryId int) (*int, error) {
+	var campaignId *int
+	err := r.db.QueryRow(fmt.Sprintf(QueryGetCampaignId, table.Name, table.Id), entryId).Scan(&campaignId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrEntryNotFound
+	}
+	return campaignId, err
+}
+
+// Save implements RepositoryTranslation. It replaces the translation of the
+// entry in the same locale.
+func (r *translationMySqlRepository) Save(translation domain.Translation) error {
+	statement, err := r.db.Prepare(QuerySave)
+	if err != nil {
+		return ErrPrepareStatement
+	}
+	defer statement.Close()
+
+	_, err = statement.Exec(translation.Catalog, translation.EntryId, translation.Locale, translation.Name, translation.Description)
+	return err
+}
+
+// Replace implements RepositoryTranslation. The entry ends up with exactly
+// the translations given.
+func (r *translationMySqlRepository) Replace(catalogName string, entryId int, translations []domain.Translation) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryDeleteByEntry, catalogName, entryId); err != nil {
+		return err
+	}
+	for _, translation := range translations {
+		if _, err := tx.Exec(QuerySave, catalogName, entryId, translation.Locale, translation.Name, translation.Description); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete implements RepositoryTranslation.
+func (r *translationMySqlRepository) Delete(catalogName string, entryId int, locale string) error {
+	result, err := r.db.Exec(QueryDelete, catalogName, entryId, locale)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *translationMySqlRepository) query(query string, args ...any) ([]domain.Translation, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := []domain.Translation{}
+	for rows.Next() {
+		var translation domain.Translation
+		if err := rows.Scan(&translation.TranslationId, &translation.Catalog, &translation.EntryId, &translation.Locale, &translation.Name, &translation.Description); err != nil {
+			return nil, err
+		}
+		translations = append(translations, translation)
+	}
+	return translations, rows.Err()
+}
@@ -0,0 +1,160 @@
+package translation
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/dto"
+)
+
+var (
+	ErrUnknownCatalog    = errors.New("unknown catalog")
+	ErrUnsupportedLocale = errors.New("locale must be one of " + strings.Join(Locales, ", "))
+	ErrMissingName       = errors.New("the translation needs a name")
+)
+
+type service struct {
+	repository RepositoryTranslation
+	catalogs   map[string]catalog.Table
+}
+
+// NewTranslationService serves the translations of the catalogs given, which
+// are named after their tables.
+func NewTranslationService(repository RepositoryTranslation, tables ...catalog.Table) ServiceTranslation {
+	catalogs := map[string]catalog.Table{}
+	for _, table := range tables {
+		catalogs[table.Name] = table
+	}
+	return &service{repository: repository, catalogs: catalogs}
+}
+
+// GetByEntry implements ServiceTranslation.
+func (s *service) GetByEntry(catalogName string, entryId int) (dto.TranslationsDto, error) {
+	if _, err := s.GetCampaignId(catalogName, entryId); err != nil {
+		return nil, err
+	}
+	translations, err := s.repository.GetByEntry(catalogName, entryId)
+	if err != nil {
+		return nil, err
+	}
+	byLocale := dto.TranslationsDto{}
+	for _, translation := range translations {
+		byLocale[translation.Locale] = dto.TranslationDto{Name: translation.Name, Description: translation.Description}
+	}
+	return byLocale, nil
+}
+
+// GetByCatalog implements ServiceTranslation. Entries without translations
+// are left out.
+func (s *service) GetByCatalog(catalogName string) (map[int]dto.TranslationsDto, error) {
+	if _, ok := s.catalogs[catalogName]; !ok {
+		return nil, ErrUnknownCatalog
+	}
+	translations, err := s.repository.GetByCatalog(catalogName)
+	if err != nil {
+		return nil, err
+	}
+	byEntry := map[int]dto.TranslationsDto{}
+	for _, translation := range translations {
+		if byEntry[translation.EntryId] == nil {
+			byEntry[translation.EntryId] = dto.TranslationsDto{}
+		}
+		byEntry[translation.EntryId][translation.Locale] = dto.TranslationDto{Name: translation.Name, Description: translation.Description}
+	}
+	return byEntry, nil
+}
+
+// GetByEntries implements ServiceTranslation. Entries without a translation
+// to locale are left out.
+func (s *service) GetByEntries(catalogName string, locale string, entryIds []int) (map[int]dto.TranslationDto, error) {
+	if _, ok := s.catalogs[catalogName]; !ok {
+		return nil, ErrUnknownCatalog
+	}
+	translations, err := s.repository.GetByEntries(catalogName, locale, entryIds)
+	if err != nil {
+		return nil, err
+	}
+	byEntry := map[int]dto.TranslationDto{}
+	for _, translation := range translations {
+		byEntry[translation.EntryId] = dto.TranslationDto{Name: translation.Name, Description: translation.Description}
+	}
+	return byEntry, nil
+}
+
+// GetCampaignId implements ServiceTranslation. It is nil for generic entries.
+func (s *service) GetCampaignId(catalogName string, entryId int) (*int, error) {
+	table, ok := s.catalogs[catalogName]
+	if !ok {
+		return nil, ErrUnknownCatalog
+	}
+	return s.repository.GetCampaignId(table, entryId)
+}
+
+// Set implements ServiceTranslation. It returns every translation of the
+// entry.
+func (s *service) Set(catalogName string, entryId int, locale string, translation dto.TranslationDto) (dto.TranslationsDto, error) {
+	if err := Validate(dto.TranslationsDto{locale: translation}); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetCampaignId(catalogName, entryId); err != nil {
+		return nil, err
+	}
+	err := s.repository.Save(domain.Translation{
+		Catalog:     catalogName,
+		EntryId:     entryId,
+		Locale:      locale,
+		Name:        strings.TrimSpace(translation.Name),
+		Description: translation.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByEntry(catalogName, entryId)
+}
+
+// Replace implements ServiceTranslation. Locales missing from translations
+// are removed from the entry.
+func (s *service) Replace(catalogName string, entryId int, translations dto.TranslationsDto) error {
+	if err := Validate(translations); err != nil {
+		return err
+	}
+	if _, err := s.GetCampaignId(catalogName, entryId); err != nil {
+		return err
+	}
+	return s.repository.Replace(catalogName, entryId, List(translations))
+}
+
+// Delete implements ServiceTranslation.
+func (s *service) Delete(catalogName string, entryId int, locale string) error {
+	if _, ok := s.catalogs[catalogName]; !ok {
+		return ErrUnknownCatalog
+	}
+	return s.repository.Delete(catalogName, entryId, locale)
+}
+
+// List returns the translations of an entry in locale order, as they are
+// stored.
+func List(translations dto.TranslationsDto) []domain.Translation {
+	list := make([]domain.Translation, 0, len(translations))
+	for _, locale := range Locales {
+		if translation, ok := translations[locale]; ok {
+			list = append(list, domain.Translation{Locale: locale, Name: strings.TrimSpace(translation.Name), Description: translation.Description})
+		}
+	}
+	return list
+}
+
+// Validate checks every locale is supported and has a name.
+func Validate(translations dto.TranslationsDto) error {
+	for locale, translation := range translations {
+		if !Supported(locale) {
+			return ErrUnsupportedLocale
+		}
+		if strings.TrimSpace(translation.Name) == "" {
+			return ErrMissingName
+		}
+	}
+	return nil
+}
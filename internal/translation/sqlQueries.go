@@ -0,0 +1,13 @@
+package translation
+
+var (
+	QueryGetByEntry   = `SELECT translation_id, catalog, entry_id, locale, name, description FROM catalog_translation WHERE catalog = ? AND entry_id = ? ORDER BY locale;`
+	QueryGetByCatalog = `SELECT translation_id, catalog, entry_id, locale, name, description FROM catalog_translation WHERE catalog = ? ORDER BY entry_id, locale;`
+	// QueryGetByEntries is completed with a placeholder for each entry id.
+	QueryGetByEntries  = `SELECT translation_id, catalog, entry_id, locale, name, description FROM catalog_translation WHERE catalog = ? AND locale = ? AND entry_id IN (%s);`
+	QuerySave          = `INSERT INTO catalog_translation (catalog, entry_id, locale, name, description) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name), description = VALUES(description);`
+	QueryDelete        = `DELETE FROM catalog_translation WHERE catalog = ? AND entry_id = ? AND locale = ?;`
+	QueryDeleteByEntry = `DELETE FROM catalog_translation WHERE catalog = ? AND entry_id = ?;`
+	// QueryGetCampaignId is completed with the table and id column of a catalog.
+	QueryGetCampaignId = `SELECT campaign_id FROM %s WHERE %s = ?;`
+)
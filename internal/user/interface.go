@@ -17,6 +17,8 @@ type RepositoryUsers interface {
 	SubscribeToPremium(id string, date string) (string, error)
 	CheckSubExpiration(userId string) error
 	SendVerificationEmail(emailAddress string) error
+	GetLocale(id string) (string, error)
+	SetLocale(id string, locale string) error
 }
 
 type ServiceUsers interface {
@@ -32,4 +34,6 @@ type ServiceUsers interface {
 	SubscribeToPremium(id string, date string) (string, error)
 	CheckSubExpiration(userId string) error
 	SendVerificationEmail(emailAddress string) error
+	GetLocale(id string) (string, error)
+	SetLocale(id string, locale string) error
 }
@@ -522,3 +522,21 @@ func (r *repositoryFirebase) SendVerificationEmail(emailAddress string) error {
 	}
 	return nil
 }
+
+// GetLocale implements RepositoryUsers. It is empty when the user has no
+// preference.
+func (r *repositoryFirebase) GetLocale(id string) (string, error) {
+	var locale sql.NullString
+	err := r.db.QueryRow(QueryGetLocale, id).Scan(&locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return locale.String, err
+}
+
+// SetLocale implements RepositoryUsers. An empty locale clears the preference.
+func (r *repositoryFirebase) SetLocale(id string, locale string) error {
+	preference := sql.NullString{String: locale, Valid: locale != ""}
+	_, err := r.db.Exec(QueryUpdateLocale, preference, id)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 type service struct {
@@ -98,3 +99,16 @@ func (s *service) CheckSubExpiration(userId string) error {
 func (s *service) SendVerificationEmail(emailAddress string) error {
 	return s.repositoryFirebase.SendVerificationEmail(emailAddress)
 }
+
+func (s *service) GetLocale(id string) (string, error) {
+	return s.repositoryFirebase.GetLocale(id)
+}
+
+// SetLocale implements ServiceUsers. The locale must be one catalog entries
+// can be translated to, or empty to clear the preference.
+func (s *service) SetLocale(id string, locale string) error {
+	if locale != "" && !translation.Supported(locale) {
+		return translation.ErrUnsupportedLocale
+	}
+	return s.repositoryFirebase.SetLocale(id, locale)
+}
@@ -8,5 +8,7 @@ var (
 	QueryDeleteUser              = `DELETE FROM user WHERE uid = ?`
 	QueryGetSubExpirationDate    = `SELECT sub_expiration FROM user WHERE uid = ?`
 	QueryUpdateSubExpirationDate = `UPDATE user SET sub_expiration = ? WHERE uid = ?`
+	QueryGetLocale               = `SELECT locale FROM user WHERE uid = ?`
+	QueryUpdateLocale            = `UPDATE user SET locale = ? WHERE uid = ?`
 	QueryGetFullData             = `SELECT uid, name, email, display_name, image, sub_expiration FROM user WHERE uid = ?`
 )
@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrInsufficientFunds = errors.New("not enough coins")
+	ErrNegativeAmount    = errors.New("amounts cannot be negative")
+)
+
+// Coin values in copper pieces, lowest denomination first.
+var coinValues = [5]int{1, 10, 50, 100, 1000}
+
+const electrum = 2
+
+func coins(currency domain.Currency) [5]int {
+	return [5]int{currency.Cp, currency.Sp, currency.Ep, currency.Gp, currency.Pp}
+}
+
+func fromCoins(coins [5]int) domain.Currency {
+	return domain.Currency{Cp: coins[0], Sp: coins[1], Ep: coins[2], Gp: coins[3], Pp: coins[4]}
+}
+
+// Value is the worth of an amount in copper pieces.
+func Value(currency domain.Currency) int {
+	total := 0
+	for i, count := range coins(currency) {
+		total += count * coinValues[i]
+	}
+	return total
+}
+
+// Coins is the number of coins in an amount, regardless of denomination.
+func Coins(currency domain.Currency) int {
+	total := 0
+	for _, count := range coins(currency) {
+		total += count
+	}
+	return total
+}
+
+func Negative(currency domain.Currency) bool {
+	for _, count := range coins(currency) {
+		if count < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func IsZero(currency domain.Currency) bool {
+	return currency == domain.Currency{}
+}
+
+func Add(a domain.Currency, b domain.Currency) domain.Currency {
+	result := coins(a)
+	for i, count := range coins(b) {
+		result[i] += count
+	}
+	return fromCoins(result)
+}
+
+func Negate(currency domain.Currency) domain.Currency {
+	result := coins(currency)
+	for i := range result {
+		result[i] = -result[i]
+	}
+	return fromCoins(result)
+}
+
+// Pay takes amount out of balance, making change when the exact coins are not
+// there. Missing coins are first broken out of the next larger coin held; if
+// that is not enough, smaller coins are gathered up and the change is returned
+// in gold, silver and copper.
+func Pay(balance domain.Currency, amount domain.Currency) (domain.Currency, error) {
+	if Negative(amount) {
+		return domain.Currency{}, ErrNegativeAmount
+	}
+	if Value(balance) < Value(amount) {
+		return domain.Currency{}, ErrInsufficientFunds
+	}
+
+	result := coins(balance)
+	for i, count := range coins(amount) {
+		result[i] -= count
+	}
+	if broken, ok := breakLargerCoins(result); ok {
+		return fromCoins(broken), nil
+	}
+	return gatherChange(balance, Value(balance)-Value(amount)), nil
+}
+
+func breakLargerCoins(result [5]int) ([5]int, bool) {
+	for i := range result {
+		for result[i] < 0 {
+			j := i + 1
+			for j < len(result) && result[j] <= 0 {
+				j++
+			}
+			if j == len(result) {
+				return result, false
+			}
+			// Break one coin down a denomination at a time so change comes in
+			// the largest coins that fit. Electrum is only handed out when it
+			// is the coin being paid.
+			for j > i {
+				next := j - 1
+				if next == electrum && i < electrum {
+					next--
+				}
+				result[j]--
+				result[next] += coinValues[j] / coinValues[next]
+				j = next
+			}
+		}
+	}
+	return result, true
+}
+
+// gatherChange rebuilds a balance worth remaining, keeping as many of the
+// original coins as possible from the largest down.
+func gatherChange(balance domain.Currency, remaining int) domain.Currency {
+	original := coins(balance)
+	result := [5]int{}
+	for i := len(original) - 1; i >= 0; i-- {
+		count := min(original[i], remaining/coinValues[i])
+		result[i] = count
+		remaining -= count * coinValues[i]
+	}
+	for _, i := range []int{3, 1, 0} {
+		result[i] += remaining / coinValues[i]
+		remaining %= coinValues[i]
+	}
+	return fromCoins(result)
+}
@@ -0,0 +1,25 @@
+package wallet
+
+import "github.com/proyecto-dnd/backend/internal/domain"
+
+type RepositoryWallet interface {
+	GetByCharacterId(characterId int) (domain.Wallet, error)
+	// Apply runs change on each listed wallet inside one transaction, storing the
+	// new balances and a ledger entry per wallet, or nothing at all.
+	Apply(characterIds []int, reason string, tradeEventId *int, change func(wallets []domain.Wallet) ([]domain.Wallet, error)) ([]domain.Wallet, error)
+	GetTransactions(characterId int) ([]domain.WalletTransaction, error)
+	GetTradeTransactions(tradeEventId int) ([]domain.WalletTransaction, error)
+	DeleteByCharacterId(characterId int) error
+}
+
+type ServiceWallet interface {
+	GetByCharacterId(characterId int) (domain.Wallet, error)
+	Credit(characterId int, amount domain.Currency, reason string) (domain.Wallet, error)
+	Debit(characterId int, amount domain.Currency, reason string) (domain.Wallet, error)
+	SetBalance(characterId int, balance domain.Currency, reason string) (domain.Wallet, error)
+	Transfer(senderId int, receiverId int, amount domain.Currency, reason string, tradeEventId *int) error
+	CanPay(characterId int, amount domain.Currency) error
+	GetTransactions(characterId int) ([]domain.WalletTransaction, error)
+	GetTradeCurrency(tradeEventId int) (*domain.Currency, error)
+	DeleteByCharacterId(characterId int) error
+}
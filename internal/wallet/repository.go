@@ -0,0 +1,145 @@
+package wallet
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var ErrNotFound = errors.New("wallet not found")
+
+type walletMySqlRepository struct {
+	db *sql.DB
+}
+
+func NewWalletRepository(db *sql.DB) RepositoryWallet {
+	return &walletMySqlRepository{db: db}
+}
+
+// GetByCharacterId implements RepositoryWallet. Characters that never held
+// coins get an empty wallet.
+func (r *walletMySqlRepository) GetByCharacterId(characterId int) (domain.Wallet, error) {
+	wallet, err := scanWallet(r.db.QueryRow(QueryGetByCharacterId, characterId))
+	if err == sql.ErrNoRows {
+		return domain.Wallet{CharacterId: characterId}, nil
+	}
+	return wallet, err
+}
+
+// Apply implements RepositoryWallet.
+func (r *walletMySqlRepository) Apply(characterIds []int, reason string, tradeEventId *int, change func(wallets []domain.Wallet) ([]domain.Wallet, error)) ([]domain.Wallet, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	after, err := ApplyTx(tx, characterIds, reason, tradeEventId, change)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// ApplyTx is Apply inside a transaction owned by the caller, so coins can
+// change hands together with other rows. Wallets are locked in id order so
+// two opposite transfers cannot deadlock each other.
+func ApplyTx(tx *sql.Tx, characterIds []int, reason string, tradeEventId *int, change func(wallets []domain.Wallet) ([]domain.Wallet, error)) ([]domain.Wallet, error) {
+	locking := append([]int{}, characterIds...)
+	sort.Ints(locking)
+	locked := map[int]domain.Wallet{}
+	for _, characterId := range locking {
+		if _, err := tx.Exec(QueryEnsureWallet, characterId); err != nil {
+			return nil, err
+		}
+		wallet, err := scanWallet(tx.QueryRow(QueryGetForUpdate, characterId))
+		if err != nil {
+			return nil, err
+		}
+		locked[characterId] = wallet
+	}
+
+	before := make([]domain.Wallet, len(characterIds))
+	for i, characterId := range characterIds {
+		before[i] = locked[characterId]
+	}
+	after, err := change(append([]domain.Wallet{}, before...))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i, wallet := range after {
+		if Negative(wallet.Currency) {
+			return nil, ErrInsufficientFunds
+		}
+		_, err := tx.Exec(QueryUpsert, wallet.CharacterId, wallet.Cp, wallet.Sp, wallet.Ep, wallet.Gp, wallet.Pp)
+		if err != nil {
+			return nil, err
+		}
+		delta := Add(wallet.Currency, Negate(before[i].Currency))
+		_, err = tx.Exec(QueryInsertTransaction, wallet.CharacterId, delta.Cp, delta.Sp, delta.Ep, delta.Gp, delta.Pp, reason, tradeEventId, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return after, nil
+}
+
+// GetTransactions implements RepositoryWallet.
+func (r *walletMySqlRepository) GetTransactions(characterId int) ([]domain.WalletTransaction, error) {
+	return r.queryTransactions(QueryGetTransactions, characterId)
+}
+
+// GetTradeTransactions implements RepositoryWallet.
+func (r *walletMySqlRepository) GetTradeTransactions(tradeEventId int) ([]domain.WalletTransaction, error) {
+	return r.queryTransactions(QueryGetTradeTransactions, tradeEventId)
+}
+
+// DeleteByCharacterId implements RepositoryWallet.
+func (r *walletMySqlRepository) DeleteByCharacterId(characterId int) error {
+	if _, err := r.db.Exec(QueryDeleteTransactionsByCharacterId, characterId); err != nil {
+		return err
+	}
+	_, err := r.db.Exec(QueryDeleteByCharacterId, characterId)
+	return err
+}
+
+func (r *walletMySqlRepository) queryTransactions(query string, id int) ([]domain.WalletTransaction, error) {
+	rows, err := r.db.Query(query, id)
+	if err != nil {
+		return []domain.WalletTransaction{}, err
+	}
+	defer rows.Close()
+
+	transactions := []domain.WalletTransaction{}
+	for rows.Next() {
+		var transaction domain.WalletTransaction
+		var tradeEventId sql.NullInt64
+		err := rows.Scan(&transaction.WalletTransactionId, &transaction.CharacterId, &transaction.Amount.Cp, &transaction.Amount.Sp, &transaction.Amount.Ep, &transaction.Amount.Gp, &transaction.Amount.Pp, &transaction.Reason, &tradeEventId, &transaction.CreatedAt)
+		if err != nil {
+			return []domain.WalletTransaction{}, err
+		}
+		if tradeEventId.Valid {
+			id := int(tradeEventId.Int64)
+			transaction.TradeEventId = &id
+		}
+		transactions = append(transactions, transaction)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.WalletTransaction{}, err
+	}
+	return transactions, nil
+}
+
+func scanWallet(row *sql.Row) (domain.Wallet, error) {
+	var wallet domain.Wallet
+	err := row.Scan(&wallet.CharacterId, &wallet.Cp, &wallet.Sp, &wallet.Ep, &wallet.Gp, &wallet.Pp)
+	return wallet, err
+}
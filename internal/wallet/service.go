@@ -0,0 +1,159 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
+
+var (
+	ErrEmptyAmount   = errors.New("amount cannot be empty")
+	ErrMissingReason = errors.New("a reason is required")
+	ErrSameCharacter = errors.New("cannot transfer coins to the same character")
+)
+
+type service struct {
+	repository RepositoryWallet
+}
+
+func NewWalletService(repository RepositoryWallet) ServiceWallet {
+	return &service{repository: repository}
+}
+
+// GetByCharacterId implements ServiceWallet.
+func (s *service) GetByCharacterId(characterId int) (domain.Wallet, error) {
+	return s.repository.GetByCharacterId(characterId)
+}
+
+// Credit implements ServiceWallet. Coins are added as given, without
+// converting them.
+func (s *service) Credit(characterId int, amount domain.Currency, reason string) (domain.Wallet, error) {
+	if err := validateAmount(amount, reason); err != nil {
+		return domain.Wallet{}, err
+	}
+	wallets, err := s.repository.Apply([]int{characterId}, reason, nil, func(wallets []domain.Wallet) ([]domain.Wallet, error) {
+		wallets[0].Currency = Add(wallets[0].Currency, amount)
+		return wallets, nil
+	})
+	if err != nil {
+		return domain.Wallet{}, err
+	}
+	return wallets[0], nil
+}
+
+// Debit implements ServiceWallet. Change is made from larger coins when the
+// exact coins are missing.
+func (s *service) Debit(characterId int, amount domain.Currency, reason string) (domain.Wallet, error) {
+	if err := validateAmount(amount, reason); err != nil {
+		return domain.Wallet{}, err
+	}
+	wallets, err := s.repository.Apply([]int{characterId}, reason, nil, func(wallets []domain.Wallet) ([]domain.Wallet, error) {
+		balance, err := Pay(wallets[0].Currency, amount)
+		if err != nil {
+			return nil, err
+		}
+		wallets[0].Currency = balance
+		return wallets, nil
+	})
+	if err != nil {
+		return domain.Wallet{}, err
+	}
+	return wallets[0], nil
+}
+
+// SetBalance implements ServiceWallet. The ledger records the difference to
+// the old balance, so restoring a wallet stays traceable.
+func (s *service) SetBalance(characterId int, balance domain.Currency, reason string) (domain.Wallet, error) {
+	if Negative(balance) {
+		return domain.Wallet{}, ErrNegativeAmount
+	}
+	if reason == "" {
+		return domain.Wallet{}, ErrMissingReason
+	}
+	wallets, err := s.repository.Apply([]int{characterId}, reason, nil, func(wallets []domain.Wallet) ([]domain.Wallet, error) {
+		wallets[0].Currency = balance
+		return wallets, nil
+	})
+	if err != nil {
+		return domain.Wallet{}, err
+	}
+	return wallets[0], nil
+}
+
+// Transfer implements ServiceWallet. The sender pays with change-making and
+// the receiver gets the exact coins, both in one transaction.
+func (s *service) Transfer(senderId int, receiverId int, amount domain.Currency, reason string, tradeEventId *int) error {
+	if err := validateAmount(amount, reason); err != nil {
+		return err
+	}
+	if senderId == receiverId {
+		return ErrSameCharacter
+	}
+	_, err := s.repository.Apply([]int{senderId, receiverId}, reason, tradeEventId, TransferChange(amount))
+	return err
+}
+
+// TransferChange pays amount from the first of two wallets into the second.
+func TransferChange(amount domain.Currency) func(wallets []domain.Wallet) ([]domain.Wallet, error) {
+	return func(wallets []domain.Wallet) ([]domain.Wallet, error) {
+		balance, err := Pay(wallets[0].Currency, amount)
+		if err != nil {
+			return nil, err
+		}
+		wallets[0].Currency = balance
+		wallets[1].Currency = Add(wallets[1].Currency, amount)
+		return wallets, nil
+	}
+}
+
+// CanPay implements ServiceWallet.
+func (s *service) CanPay(characterId int, amount domain.Currency) error {
+	wallet, err := s.repository.GetByCharacterId(characterId)
+	if err != nil {
+		return err
+	}
+	_, err = Pay(wallet.Currency, amount)
+	return err
+}
+
+// GetTransactions implements ServiceWallet.
+func (s *service) GetTransactions(characterId int) ([]domain.WalletTransaction, error) {
+	return s.repository.GetTransactions(characterId)
+}
+
+// GetTradeCurrency implements ServiceWallet. It returns the coins the
+// receiver got in a trade, or nil when no currency moved.
+func (s *service) GetTradeCurrency(tradeEventId int) (*domain.Currency, error) {
+	transactions, err := s.repository.GetTradeTransactions(tradeEventId)
+	if err != nil {
+		return nil, err
+	}
+	total := domain.Currency{}
+	for _, transaction := range transactions {
+		if !Negative(transaction.Amount) {
+			total = Add(total, transaction.Amount)
+		}
+	}
+	if IsZero(total) {
+		return nil, nil
+	}
+	return &total, nil
+}
+
+// DeleteByCharacterId implements ServiceWallet.
+func (s *service) DeleteByCharacterId(characterId int) error {
+	return s.repository.DeleteByCharacterId(characterId)
+}
+
+func validateAmount(amount domain.Currency, reason string) error {
+	if Negative(amount) {
+		return ErrNegativeAmount
+	}
+	if IsZero(amount) {
+		return ErrEmptyAmount
+	}
+	if reason == "" {
+		return ErrMissingReason
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package wallet
+
+var (
+	QueryGetByCharacterId                = `SELECT character_id, cp, sp, ep, gp, pp FROM character_wallet WHERE character_id = ?;`
+	QueryGetForUpdate                    = `SELECT character_id, cp, sp, ep, gp, pp FROM character_wallet WHERE character_id = ? FOR UPDATE;`
+	QueryUpsert                          = `INSERT INTO character_wallet (character_id, cp, sp, ep, gp, pp) VALUES (?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE cp = VALUES(cp), sp = VALUES(sp), ep = VALUES(ep), gp = VALUES(gp), pp = VALUES(pp);`
+	QueryEnsureWallet                    = `INSERT IGNORE INTO character_wallet (character_id, cp, sp, ep, gp, pp) VALUES (?, 0, 0, 0, 0, 0);`
+	QueryInsertTransaction               = `INSERT INTO wallet_transaction (character_id, cp, sp, ep, gp, pp, reason, trade_event_id, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	QueryGetTransactions                 = `SELECT wallet_transaction_id, character_id, cp, sp, ep, gp, pp, reason, trade_event_id, created_at FROM wallet_transaction WHERE character_id = ? ORDER BY created_at DESC, wallet_transaction_id DESC;`
+	QueryGetTradeTransactions            = `SELECT wallet_transaction_id, character_id, cp, sp, ep, gp, pp, reason, trade_event_id, created_at FROM wallet_transaction WHERE trade_event_id = ? ORDER BY wallet_transaction_id;`
+	QueryDeleteByCharacterId             = `DELETE FROM character_wallet WHERE character_id = ?;`
+	QueryDeleteTransactionsByCharacterId = `DELETE FROM wallet_transaction WHERE character_id = ?;`
+)
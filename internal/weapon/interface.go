@@ -1,6 +1,9 @@
 package weapon
 
-import "github.com/proyecto-dnd/backend/internal/domain"
+import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
 
 type RepositoryWeapon interface {
 	Create(weapon domain.Weapon) (domain.Weapon, error)
@@ -10,6 +13,7 @@ type RepositoryWeapon interface {
 	GetById(id int) (domain.Weapon, error)
 	Update(weapon domain.Weapon) (domain.Weapon, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Weapon], error)
 }
 
 type ServiceWeapon interface {
@@ -20,4 +24,5 @@ type ServiceWeapon interface {
 	GetById(id int) (domain.Weapon, error)
 	Update(item domain.Weapon) (domain.Weapon, error)
 	Delete(id int) error
+	Search(params catalog.Params) (catalog.Page[domain.Weapon], error)
 }
\ No newline at end of file
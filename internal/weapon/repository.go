@@ -5,7 +5,9 @@ import (
 	"errors"
 	"log"
 
+	"github.com/proyecto-dnd/backend/internal/catalog"
 	"github.com/proyecto-dnd/backend/internal/domain"
+	"github.com/proyecto-dnd/backend/internal/translation"
 )
 
 var (
@@ -46,6 +48,10 @@ func (r *weaponMySqlRepository) GetAllGeneric() ([]domain.Weapon, error) {
 			&weapon.Ammunition,
 			&weapon.Damage_Type,
 			&weapon.Campaign_Id,
+			&weapon.TwoHanded,
+			&weapon.Finesse,
+			&weapon.Ranged,
+			&weapon.RequiresAttunement,
 		)
 		if err != nil {
 
@@ -80,6 +86,10 @@ func (r *weaponMySqlRepository) Create(weapon domain.Weapon) (domain.Weapon, err
 		weapon.Ammunition,
 		weapon.Damage_Type,
 		weapon.Campaign_Id,
+		weapon.TwoHanded,
+		weapon.Finesse,
+		weapon.Ranged,
+		weapon.RequiresAttunement,
 	)
 	if err != nil {
 		return domain.Weapon{}, err
@@ -97,7 +107,13 @@ func (r *weaponMySqlRepository) Create(weapon domain.Weapon) (domain.Weapon, err
 
 // Delete implements RepositoryWeapon.
 func (r *weaponMySqlRepository) Delete(id int) error {
-	result, err := r.db.Exec(QueryDelete, id)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(QueryDelete, id)
 	if err != nil {
 		return err
 	}
@@ -108,7 +124,10 @@ func (r *weaponMySqlRepository) Delete(id int) error {
 	if rowsAffected < 1 {
 		return ErrNotFound
 	}
-	return nil
+	if _, err := tx.Exec(translation.QueryDeleteByEntry, CatalogTable.Name, id); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // GetAll implements RepositoryWeapon.
@@ -136,6 +155,10 @@ func (r *weaponMySqlRepository) GetAll() ([]domain.Weapon, error) {
 			&weapon.Ammunition,
 			&weapon.Damage_Type,
 			&weapon.Campaign_Id,
+			&weapon.TwoHanded,
+			&weapon.Finesse,
+			&weapon.Ranged,
+			&weapon.RequiresAttunement,
 		)
 		if err != nil {
 			return []domain.Weapon{}, err
@@ -173,6 +196,10 @@ func (r *weaponMySqlRepository) GetByCampaignId(campaignId int) ([]domain.Weapon
 			&weapon.Ammunition,
 			&weapon.Damage_Type,
 			&weapon.Campaign_Id,
+			&weapon.TwoHanded,
+			&weapon.Finesse,
+			&weapon.Ranged,
+			&weapon.RequiresAttunement,
 		)
 		if err != nil {
 			return []domain.Weapon{}, err
@@ -210,6 +237,10 @@ func (r *weaponMySqlRepository) GetById(id int) (domain.Weapon, error) {
 		&weapon.Ammunition,
 		&weapon.Damage_Type,
 		&weapon.Campaign_Id,
+		&weapon.TwoHanded,
+		&weapon.Finesse,
+		&weapon.Ranged,
+		&weapon.RequiresAttunement,
 	)
 	if err != nil {
 		log.Println(2, err)
@@ -241,6 +272,10 @@ func (r *weaponMySqlRepository) Update(weapon domain.Weapon) (domain.Weapon, err
 		weapon.Ammunition,
 		weapon.Damage_Type,
 		weapon.Campaign_Id,
+		weapon.TwoHanded,
+		weapon.Finesse,
+		weapon.Ranged,
+		weapon.RequiresAttunement,
 		weapon.Weapon_Id,
 	)
 
@@ -251,3 +286,31 @@ func (r *weaponMySqlRepository) Update(weapon domain.Weapon) (domain.Weapon, err
 	return weapon, nil
 }
 
+// Search implements RepositoryWeapon.
+func (r *weaponMySqlRepository) Search(params catalog.Params) (catalog.Page[domain.Weapon], error) {
+	return catalog.Search(r.db, CatalogTable, params, scanWeapon)
+}
+
+func scanWeapon(row catalog.Scannable) (domain.Weapon, error) {
+	var weapon domain.Weapon
+	err := row.Scan(
+		&weapon.Weapon_Id,
+		&weapon.Weapon_Type,
+		&weapon.Name,
+		&weapon.Weight,
+		&weapon.Price,
+		&weapon.Category,
+		&weapon.Reach,
+		&weapon.Description,
+		&weapon.Damage,
+		&weapon.Versatile_Damage,
+		&weapon.Ammunition,
+		&weapon.Damage_Type,
+		&weapon.Campaign_Id,
+		&weapon.TwoHanded,
+		&weapon.Finesse,
+		&weapon.Ranged,
+		&weapon.RequiresAttunement,
+	)
+	return weapon, err
+}
@@ -1,6 +1,9 @@
 package weapon
 
-import "github.com/proyecto-dnd/backend/internal/domain"
+import (
+	"github.com/proyecto-dnd/backend/internal/catalog"
+	"github.com/proyecto-dnd/backend/internal/domain"
+)
 
 type service struct {
 	repo RepositoryWeapon
@@ -73,3 +76,8 @@ func (s *service) Update(weapon domain.Weapon) (domain.Weapon, error) {
 	}
 	return weapon, nil
 }
+
+// Search implements ServiceWeapon.
+func (s *service) Search(params catalog.Params) (catalog.Page[domain.Weapon], error) {
+	return s.repo.Search(params)
+}
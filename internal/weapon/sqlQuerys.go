@@ -1,11 +1,28 @@
 package weapon
 
+import "github.com/proyecto-dnd/backend/internal/catalog"
+
 var (
-	QueryCreateWeapon = `INSERT INTO weapon (weapon_type, name , weight ,  price ,  category ,  reach, description , damage , versatile_damage , ammunition , damage_type, campaign_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	QueryCreateWeapon = `INSERT INTO weapon (weapon_type, name , weight ,  price ,  category ,  reach, description , damage , versatile_damage , ammunition , damage_type, campaign_id, two_handed, finesse, ranged, requires_attunement) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
     QueryGetAll = `SELECT * FROM weapon;`
 	QueryGetByCampaignId = `SELECT * FROM weapon WHERE campaign_id = ? ;`
     QueryGetById = `SELECT * FROM weapon WHERE weapon_id = ? ;`
     QueryGetGeneric = `SELECT * FROM weapon WHERE campaign_id IS NULL`
-    QueryUpdate = `UPDATE weapon set weapon_type = ? , name = ? , weight = ? ,  price = ? ,  category = ? ,  reach = ?, description = ? , damage = ? , versatile_damage = ? , ammunition = ? , damage_type = ? , campaign_id = ? WHERE weapon_id = ?`
+    QueryUpdate = `UPDATE weapon set weapon_type = ? , name = ? , weight = ? ,  price = ? ,  category = ? ,  reach = ?, description = ? , damage = ? , versatile_damage = ? , ammunition = ? , damage_type = ? , campaign_id = ? , two_handed = ? , finesse = ? , ranged = ? , requires_attunement = ? WHERE weapon_id = ?`
     QueryDelete = `DELETE FROM weapon WHERE weapon_id = ?`
-)
\ No newline at end of file
+)
+
+// CatalogTable describes how GET /weapon searches, filters and sorts weapons.
+var CatalogTable = catalog.Table{
+	Name:   "weapon",
+	Id:     "weapon_id",
+	Search: []string{"name", "description"},
+	Filters: map[string]catalog.Filter{
+		"category":    {Condition: "category = ?", Kind: catalog.Text},
+		"damage_type": {Condition: "damage_type = ?", Kind: catalog.Text},
+		"weapon_type": {Condition: "weapon_type = ?", Kind: catalog.Text},
+	},
+	Sorts:          map[string]string{"name": "name", "category": "category", "price": "price", "weight": "weight"},
+	DefaultSort:    "name",
+	CampaignScoped: true,
+}
@@ -29,6 +29,8 @@ func (r *weaponXCharacterDataSqlRepository) Create(weaponXCharacterData domain.W
 		weaponXCharacterData.CharacterData_Id,
 		weaponXCharacterData.Weapon.Weapon_Id,
 		weaponXCharacterData.Equipped,
+		weaponXCharacterData.Slot,
+		weaponXCharacterData.Attuned,
 	)
 	
 	if err != nil {
@@ -110,7 +112,13 @@ func (r *weaponXCharacterDataSqlRepository) GetAll() ([]domain.WeaponXCharacterD
 			&weaponXCharacterData.Weapon.Ammunition,
 			&weaponXCharacterData.Weapon.Damage_Type,
 			&weaponXCharacterData.Weapon.Campaign_Id,
+			&weaponXCharacterData.Weapon.TwoHanded,
+			&weaponXCharacterData.Weapon.Finesse,
+			&weaponXCharacterData.Weapon.Ranged,
+			&weaponXCharacterData.Weapon.RequiresAttunement,
 			&weaponXCharacterData.Equipped,
+			&weaponXCharacterData.Slot,
+			&weaponXCharacterData.Attuned,
 		)
 		if err != nil {
 			return []domain.WeaponXCharacterData{}, err
@@ -144,7 +152,13 @@ func (r *weaponXCharacterDataSqlRepository) GetById(id int) (domain.WeaponXChara
 		&weaponXCharacterData.Weapon.Ammunition,
 		&weaponXCharacterData.Weapon.Damage_Type,
 		&weaponXCharacterData.Weapon.Campaign_Id,
+		&weaponXCharacterData.Weapon.TwoHanded,
+		&weaponXCharacterData.Weapon.Finesse,
+		&weaponXCharacterData.Weapon.Ranged,
+		&weaponXCharacterData.Weapon.RequiresAttunement,
 		&weaponXCharacterData.Equipped,
+		&weaponXCharacterData.Slot,
+		&weaponXCharacterData.Attuned,
 	)
 	if err != nil {
 		return domain.WeaponXCharacterData{}, err
@@ -182,7 +196,13 @@ func (r *weaponXCharacterDataSqlRepository) GetByCharacterDataId(id int) ([]doma
 			&weaponXCharacterData.Weapon.Ammunition,
 			&weaponXCharacterData.Weapon.Damage_Type,
 			&weaponXCharacterData.Weapon.Campaign_Id,
+			&weaponXCharacterData.Weapon.TwoHanded,
+			&weaponXCharacterData.Weapon.Finesse,
+			&weaponXCharacterData.Weapon.Ranged,
+			&weaponXCharacterData.Weapon.RequiresAttunement,
 			&weaponXCharacterData.Equipped,
+			&weaponXCharacterData.Slot,
+			&weaponXCharacterData.Attuned,
 		)
 		if err != nil {
 			return []domain.WeaponXCharacterData{}, err
@@ -207,6 +227,8 @@ func (r *weaponXCharacterDataSqlRepository) Update(weaponXCharacterData domain.W
 		weaponXCharacterData.CharacterData_Id,
 		weaponXCharacterData.Weapon.Weapon_Id,
 		weaponXCharacterData.Equipped,
+		weaponXCharacterData.Slot,
+		weaponXCharacterData.Attuned,
 		weaponXCharacterData.Character_Weapon_Id,
 	)
 
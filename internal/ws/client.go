@@ -95,7 +95,11 @@ func (c *Client) readPump() {
 				log.Printf("error: %v", err)
 				continue
 			} else {
-				attackEvent, err := c.hub.attackEventService.CreateEvent(attackEventDto)
+				var attackEvent domain.AttackEvent
+				err = c.hub.track(attackEventDto.EventProtagonistId, "attack", func() (err error) {
+					attackEvent, err = c.hub.attackEventService.CreateEvent(attackEventDto)
+					return err
+				})
 				if err != nil {
 					log.Printf("error: %v", err)
 					continue
@@ -109,7 +113,10 @@ func (c *Client) readPump() {
 				log.Printf("error: %v", err)
 				continue
 			} else {
-				diceEvent, err = c.hub.diceEventService.Create(diceEvent)
+				err = c.hub.track(diceEvent.EventProtagonist, "dice rolled", func() (err error) {
+					diceEvent, err = c.hub.diceEventService.Create(diceEvent)
+					return err
+				})
 				if err != nil {
 					log.Printf("error: %v", err)
 					continue
@@ -1,16 +1,26 @@
 package ws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/proyecto-dnd/backend/internal/attackEvent"
 	"github.com/proyecto-dnd/backend/internal/dice_event"
+	"github.com/proyecto-dnd/backend/internal/domain"
 	tradeevent "github.com/proyecto-dnd/backend/internal/tradeEvent"
 )
 
+// HistoryRecorder keeps the version history of the characters socket events
+// change.
+type HistoryRecorder interface {
+	RecordBaseline(characterId int) error
+	Record(characterId int, authorId *string, change string) (domain.CharacterHistory, error)
+}
+
 type Hub struct {
 	clients    map[*Client]bool
 	broadcast  chan *Message
@@ -19,6 +29,7 @@ type Hub struct {
 	tradeEventService tradeevent.ServiceTradeEvent
 	attackEventService attackEvent.AttackEventService
 	diceEventService dice_event.DiceEventService
+	history HistoryRecorder
 }
 
 func NewHub(tradeEventService tradeevent.ServiceTradeEvent, attackEventService attackEvent.AttackEventService, diceEventService dice_event.DiceEventService) *Hub {
@@ -33,6 +44,39 @@ func NewHub(tradeEventService tradeevent.ServiceTradeEvent, attackEventService a
 	}
 }
 
+// UseAttackEventService replaces the service attack events sent over the
+// socket are created with, for decorators that need services built after the
+// hub. It must be called before the hub serves any client.
+func (h *Hub) UseAttackEventService(attackEventService attackEvent.AttackEventService) {
+	h.attackEventService = attackEventService
+}
+
+// UseHistory makes the hub record a character version after every socket event
+// that changes the character, like the HTTP routes do. It must be called
+// before the hub serves any client.
+func (h *Hub) UseHistory(history HistoryRecorder) {
+	h.history = history
+}
+
+// track runs apply and records the change in the history of the character,
+// with a baseline first so the change can be undone. Socket clients are not
+// logged in, so the versions have no author.
+func (h *Hub) track(characterId int, change string, apply func() error) error {
+	if h.history == nil || characterId == 0 {
+		return apply()
+	}
+	if err := h.history.RecordBaseline(characterId); err != nil {
+		log.Println("character history", characterId, err)
+	}
+	if err := apply(); err != nil {
+		return err
+	}
+	if _, err := h.history.Record(characterId, nil, change); err != nil {
+		log.Println("character history", characterId, err)
+	}
+	return nil
+}
+
 func (h *Hub) Run() {
 	for {
 		select {
@@ -59,6 +103,21 @@ func (h *Hub) Run() {
 	}
 }
 
+// Publish broadcasts an event raised outside the socket, such as a REST
+// request, to every client connected to the session.
+func (h *Hub) Publish(sessionId int, eventType string, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	h.broadcast <- &Message{
+		Content:   EventData{Type: eventType, EventData: data},
+		Sent:      time.Now(),
+		SessionID: sessionId,
+	}
+}
+
 func (h *Hub) ServeWs(ctx *gin.Context) {
 	conn, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
 	if err != nil {
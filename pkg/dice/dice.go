@@ -0,0 +1,82 @@
+package dice
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidDice = errors.New("invalid dice notation")
+
+// Dice is a parsed roll such as "2d6+3".
+type Dice struct {
+	Count    int
+	Sides    int
+	Modifier int
+}
+
+func (d Dice) String() string {
+	notation := fmt.Sprintf("%dd%d", d.Count, d.Sides)
+	if d.Modifier > 0 {
+		notation += fmt.Sprintf("+%d", d.Modifier)
+	} else if d.Modifier < 0 {
+		notation += strconv.Itoa(d.Modifier)
+	}
+	return notation
+}
+
+// Parse reads dice notation, the count defaulting to one ("d8" is "1d8").
+func Parse(notation string) (Dice, error) {
+	notation = strings.ToLower(strings.ReplaceAll(notation, " ", ""))
+	index := strings.Index(notation, "d")
+	if index < 0 {
+		return Dice{}, ErrInvalidDice
+	}
+	dice := Dice{Count: 1}
+	if index > 0 {
+		count, err := strconv.Atoi(notation[:index])
+		if err != nil || count < 1 {
+			return Dice{}, ErrInvalidDice
+		}
+		dice.Count = count
+	}
+	rest := notation[index+1:]
+	if sign := strings.IndexAny(rest, "+-"); sign >= 0 {
+		modifier, err := strconv.Atoi(rest[sign:])
+		if err != nil {
+			return Dice{}, ErrInvalidDice
+		}
+		dice.Modifier = modifier
+		rest = rest[:sign]
+	}
+	sides, err := strconv.Atoi(rest)
+	if err != nil || sides < 2 {
+		return Dice{}, ErrInvalidDice
+	}
+	dice.Sides = sides
+	return dice, nil
+}
+
+// Die rolls a single die with the given number of sides.
+func Die(sides int) int {
+	roll, err := rand.Int(rand.Reader, big.NewInt(int64(sides)))
+	if err != nil {
+		panic(err)
+	}
+	return int(roll.Int64()) + 1
+}
+
+// Roll rolls every die and returns the individual results and the total,
+// modifier included.
+func (d Dice) Roll() ([]int, int) {
+	rolls := make([]int, d.Count)
+	total := d.Modifier
+	for i := range rolls {
+		rolls[i] = Die(d.Sides)
+		total += rolls[i]
+	}
+	return rolls, total
+}
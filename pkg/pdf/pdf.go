@@ -0,0 +1,250 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Minimal PDF 1.4 writer used by the reports. It only supports the standard
+// Helvetica fonts (which every viewer ships, so nothing is embedded), text,
+// lines and rectangles. Coordinates are expressed in points with the origin
+// at the top-left corner of the page.
+
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+type Document struct {
+	title string
+	pages []*Page
+}
+
+type Page struct {
+	content bytes.Buffer
+}
+
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// Text draws s with its baseline at (x, y).
+func (p *Page) Text(x, y, size float64, font Font, s string) {
+	fmt.Fprintf(&p.content, "BT /F%d %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font+1, size, x, PageHeight-y, escape(s))
+}
+
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%.2f w %.2f %.2f m %.2f %.2f l S\n", width, x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+// Rect strokes a rectangle whose top-left corner is (x, y).
+func (p *Page) Rect(x, y, w, h, width float64) {
+	fmt.Fprintf(&p.content, "%.2f w %.2f %.2f %.2f %.2f re S\n", width, x, PageHeight-y-h, w, h)
+}
+
+// FillRect fills a rectangle with a gray level between 0 (black) and 1 (white).
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "q %.2f g %.2f %.2f %.2f %.2f re f Q\n", gray, x, PageHeight-y-h, w, h)
+}
+
+// Write serializes the document.
+func (d *Document) Write(w io.Writer) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	newObject := func() int {
+		offsets = append(offsets, buf.Len())
+		id := len(offsets)
+		fmt.Fprintf(&buf, "%d 0 obj\n", id)
+		return id
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Object ids are fixed for the shared objects: 1 catalog, 2 page tree,
+	// 3 and 4 fonts, 5 info. Pages start at 6 and use two objects each.
+	newObject()
+	buf.WriteString("<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+
+	newObject()
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+i*2)
+	}
+	fmt.Fprintf(&buf, "<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), len(d.pages))
+
+	newObject()
+	buf.WriteString("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>\nendobj\n")
+	newObject()
+	buf.WriteString("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>\nendobj\n")
+
+	newObject()
+	fmt.Fprintf(&buf, "<< /Title (%s) /Producer (Dicelogger) >>\nendobj\n", escape(d.title))
+
+	for _, page := range d.pages {
+		var stream bytes.Buffer
+		zw := zlib.NewWriter(&stream)
+		if _, err := zw.Write(page.content.Bytes()); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+
+		pageId := newObject()
+		fmt.Fprintf(&buf, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>\nendobj\n", PageWidth, PageHeight, pageId+1)
+
+		newObject()
+		fmt.Fprintf(&buf, "<< /Length %d /Filter /FlateDecode >>\nstream\n", stream.Len())
+		buf.Write(stream.Bytes())
+		buf.WriteString("\nendstream\nendobj\n")
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// TextWidth returns the width in points of s rendered with font at size.
+func TextWidth(font Font, size float64, s string) float64 {
+	widths := helveticaWidths
+	if font == Bold {
+		widths = helveticaBoldWidths
+	}
+	total := 0
+	for _, b := range toWinAnsi(s) {
+		if b >= 32 && b <= 126 {
+			total += widths[b-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// WrapText splits s into lines that fit in maxWidth, honouring existing line breaks.
+func WrapText(font Font, size float64, s string, maxWidth float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		current := ""
+		for _, word := range words {
+			candidate := word
+			if current != "" {
+				candidate = current + " " + word
+			}
+			if TextWidth(font, size, candidate) <= maxWidth {
+				current = candidate
+				continue
+			}
+			if current != "" {
+				lines = append(lines, current)
+			}
+			// Words longer than the whole line get hard-split.
+			for TextWidth(font, size, word) > maxWidth && len([]rune(word)) > 1 {
+				runes := []rune(word)
+				cut := len(runes) - 1
+				for cut > 1 && TextWidth(font, size, string(runes[:cut])) > maxWidth {
+					cut--
+				}
+				lines = append(lines, string(runes[:cut]))
+				word = string(runes[cut:])
+			}
+			current = word
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+func escape(s string) string {
+	var out bytes.Buffer
+	for _, b := range toWinAnsi(s) {
+		switch b {
+		case '(', ')', '\\':
+			out.WriteByte('\\')
+			out.WriteByte(b)
+		case '\n', '\r', '\t':
+			out.WriteByte(' ')
+		default:
+			out.WriteByte(b)
+		}
+	}
+	return out.String()
+}
+
+// toWinAnsi maps UTF-8 text to the single byte encoding used by the standard
+// fonts. Latin-1 covers the accented characters we need; anything else becomes '?'.
+func toWinAnsi(s string) []byte {
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r < 0x80 || (r >= 0xA0 && r <= 0xFF):
+			out = append(out, byte(r))
+		case r == '€':
+			out = append(out, 0x80)
+		case r == '‘' || r == '’':
+			out = append(out, '\'')
+		case r == '“' || r == '”':
+			out = append(out, '"')
+		case r == '–':
+			out = append(out, 0x96)
+		case r == '—':
+			out = append(out, 0x97)
+		case r == '•':
+			out = append(out, 0x95)
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
+
+// Glyph widths for characters 32 to 126, taken from the Adobe font metrics.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
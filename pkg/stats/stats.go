@@ -0,0 +1,15 @@
+package stats
+
+// Modifier is the modifier of an ability score, rounding down below 10.
+func Modifier(score int) int {
+	if score >= 10 {
+		return (score - 10) / 2
+	}
+	return (score - 11) / 2
+}
+
+// ProficiencyBonus is the proficiency bonus of a character of the given total
+// level, starting at +2 and growing every four levels.
+func ProficiencyBonus(level int) int {
+	return 2 + (max(level, 1)-1)/4
+}
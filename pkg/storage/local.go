@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage keeps files on disk, for development and tests without AWS.
+// The server is expected to serve Dir under BaseURL.
+type LocalStorage struct {
+	Dir     string
+	BaseURL string
+}
+
+func NewLocalStorage(dir string, baseURL string) *LocalStorage {
+	return &LocalStorage{Dir: dir, BaseURL: strings.TrimSuffix(baseURL, "/") + "/"}
+}
+
+// Put implements Storage.
+func (s *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", err
+	}
+	return s.BaseURL + key, nil
+}
+
+// Delete implements Storage.
+func (s *LocalStorage) Delete(ctx context.Context, url string) error {
+	key, found := strings.CutPrefix(url, s.BaseURL)
+	if !found {
+		return nil
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path resolves a key inside Dir, refusing keys that would escape it.
+func (s *LocalStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if cleaned == "/" {
+		return "", ErrInvalidURL
+	}
+	return filepath.Join(s.Dir, cleaned), nil
+}
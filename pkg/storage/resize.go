@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strings"
+
+	// Decoders for every accepted upload type.
+	_ "image/gif"
+
+	_ "golang.org/x/image/webp"
+
+	"golang.org/x/image/draw"
+)
+
+// Sizes every uploaded image is stored in. The full size is the URL kept in
+// the database; the others are derived from it with VariantURL.
+const (
+	SizeThumbnail = "thumbnail"
+	SizeCard      = "card"
+	SizeFull      = "full"
+)
+
+// MaxPixels bounds the decoded size of an upload, so a small file that
+// expands into a huge bitmap is rejected before it is decoded.
+const MaxPixels = 40_000_000
+
+const jpegQuality = 85
+
+var (
+	ErrInvalidImage    = errors.New("the image could not be decoded")
+	ErrImageDimensions = errors.New("the image is larger than 40 megapixels")
+)
+
+// imageSizes maps each size to the longest side it is scaled down to. Images
+// smaller than that are re-encoded but never scaled up.
+var imageSizes = []struct {
+	name         string
+	maxDimension int
+}{
+	{SizeThumbnail, 160},
+	{SizeCard, 480},
+	{SizeFull, 1920},
+}
+
+// variant is one encoded size of an upload.
+type variant struct {
+	size        string
+	data        []byte
+	contentType string
+}
+
+// resize decodes an upload and encodes it again in every size. Re-encoding
+// drops any metadata the original carried. JPEGs stay JPEGs; everything else
+// becomes a PNG, and animated GIFs keep only their first frame.
+func resize(upload Image) ([]variant, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(upload.Data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if config.Width*config.Height > MaxPixels {
+		return nil, ErrImageDimensions
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(upload.Data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	variants := make([]variant, 0, len(imageSizes))
+	for _, size := range imageSizes {
+		var buffer bytes.Buffer
+		scaled := scale(decoded, size.maxDimension)
+		contentType := "image/png"
+		if format == "jpeg" {
+			contentType = "image/jpeg"
+			err = jpeg.Encode(&buffer, scaled, &jpeg.Options{Quality: jpegQuality})
+		} else {
+			err = png.Encode(&buffer, scaled)
+		}
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant{size: size.name, data: buffer.Bytes(), contentType: contentType})
+	}
+	return variants, nil
+}
+
+// scale fits img inside a maxDimension square, keeping its aspect ratio.
+func scale(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxDimension || height > maxDimension {
+		if width >= height {
+			height = max(1, height*maxDimension/width)
+			width = maxDimension
+		} else {
+			width = max(1, width*maxDimension/height)
+			height = maxDimension
+		}
+	}
+	scaled := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, bounds, draw.Src, nil)
+	return scaled
+}
+
+// VariantURL returns the URL of one size of a stored image. URLs that were not
+// stored in several sizes, such as external links, are returned unchanged.
+func VariantURL(url string, size string) string {
+	slash := strings.LastIndex(url, "/")
+	stem, extension, found := strings.Cut(url[slash+1:], ".")
+	if !found || stem != SizeFull || size == SizeFull {
+		return url
+	}
+	return url[:slash+1] + size + "." + extension
+}
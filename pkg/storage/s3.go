@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Storage keeps files in an S3 bucket.
+type S3Storage struct {
+	client     *s3.Client
+	bucket     string
+	baseURL    string
+	publicRead bool
+}
+
+// NewS3Storage connects to a bucket with the default AWS credentials. Objects
+// are only uploaded public-read when publicRead is set; otherwise the bucket
+// policy decides who can read them.
+func NewS3Storage(ctx context.Context, bucket string, region string, publicRead bool) (*S3Storage, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return nil, err
+	}
+	return &S3Storage{
+		client:     s3.NewFromConfig(cfg),
+		bucket:     bucket,
+		baseURL:    "https://" + bucket + ".s3." + region + ".amazonaws.com/",
+		publicRead: publicRead,
+	}, nil
+}
+
+// Put implements Storage.
+func (s *S3Storage) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	}
+	if s.publicRead {
+		input.ACL = types.ObjectCannedACLPublicRead
+	}
+	if _, err := s.client.PutObject(ctx, input); err != nil {
+		return "", err
+	}
+	return s.baseURL + key, nil
+}
+
+// Delete implements Storage.
+func (s *S3Storage) Delete(ctx context.Context, url string) error {
+	key, found := strings.CutPrefix(url, s.baseURL)
+	if !found || key == "" {
+		return nil
+	}
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
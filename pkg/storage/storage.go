@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxImageSize is the largest image accepted for upload, in bytes.
+const MaxImageSize = 5 << 20
+
+var (
+	ErrTooLarge         = errors.New("the image is larger than 5 MB")
+	ErrUnsupportedImage = errors.New("the image must be a png, jpeg, gif or webp file")
+	ErrInvalidDataURL   = errors.New("the image is not a valid base64 data URL")
+	ErrInvalidURL       = errors.New("the url does not belong to this storage")
+)
+
+// imageExtensions lists the accepted image types by sniffed content type.
+var imageExtensions = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpg",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// Storage keeps uploaded files and serves them from public URLs.
+type Storage interface {
+	// Put stores data under key and returns the URL it is served from.
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	// Delete removes the object behind a URL returned by Put. URLs that
+	// belong somewhere else are left alone.
+	Delete(ctx context.Context, url string) error
+}
+
+// Image is an upload whose content has been checked to be an image.
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// ReadImage reads an upload of at most MaxImageSize bytes and checks its
+// content, rather than its declared type, is an accepted image.
+func ReadImage(reader io.Reader) (Image, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, MaxImageSize+1))
+	if err != nil {
+		return Image{}, err
+	}
+	return sniff(data)
+}
+
+// DecodeDataURL decodes a "data:image/...;base64,..." URL. The declared type
+// is ignored in favour of the decoded content.
+func DecodeDataURL(dataURL string) (Image, error) {
+	header, encoded, found := strings.Cut(dataURL, ",")
+	if !found || !strings.HasPrefix(header, "data:") || !strings.HasSuffix(header, ";base64") {
+		return Image{}, ErrInvalidDataURL
+	}
+	if base64.StdEncoding.DecodedLen(len(encoded)) > MaxImageSize+2 {
+		return Image{}, ErrTooLarge
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return Image{}, ErrInvalidDataURL
+	}
+	return sniff(data)
+}
+
+// SaveImage stores an image in every size under a random name in folder and
+// returns the URL of the full size. If any size fails, the ones already
+// stored are removed.
+func SaveImage(ctx context.Context, store Storage, folder string, image Image) (string, error) {
+	variants, err := resize(image)
+	if err != nil {
+		return "", err
+	}
+	prefix := folder + "/" + uuid.New().String() + "/"
+	stored := make([]string, 0, len(variants))
+	var fullURL string
+	for _, variant := range variants {
+		url, err := store.Put(ctx, prefix+variant.size+"."+imageExtensions[variant.contentType], variant.data, variant.contentType)
+		if err != nil {
+			for _, url := range stored {
+				if deleteErr := store.Delete(ctx, url); deleteErr != nil {
+					log.Println(deleteErr)
+				}
+			}
+			return "", err
+		}
+		stored = append(stored, url)
+		if variant.size == SizeFull {
+			fullURL = url
+		}
+	}
+	return fullURL, nil
+}
+
+// DeleteImage removes a stored image in every size.
+func DeleteImage(ctx context.Context, store Storage, url string) error {
+	var errs []error
+	for _, size := range imageSizes {
+		if variant := VariantURL(url, size.name); variant != url || size.name == SizeFull {
+			errs = append(errs, store.Delete(ctx, variant))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ReplaceImage stores a new image and, once save succeeds, removes the image it
+// replaces. A failed save leaves nothing behind; save failing after the upload
+// removes the new image instead.
+func ReplaceImage(ctx context.Context, store Storage, folder string, image Image, save func(url string) (previous string, err error)) (string, error) {
+	url, err := SaveImage(ctx, store, folder, image)
+	if err != nil {
+		return "", err
+	}
+	previous, err := save(url)
+	if err != nil {
+		if deleteErr := DeleteImage(ctx, store, url); deleteErr != nil {
+			log.Println(deleteErr)
+		}
+		return "", err
+	}
+	if previous != "" && previous != url {
+		// The old object is only garbage now; failing to remove it must not
+		// fail the upload.
+		if err := DeleteImage(ctx, store, previous); err != nil {
+			log.Println(err)
+		}
+	}
+	return url, nil
+}
+
+func sniff(data []byte) (Image, error) {
+	if len(data) > MaxImageSize {
+		return Image{}, ErrTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if _, ok := imageExtensions[contentType]; !ok {
+		return Image{}, ErrUnsupportedImage
+	}
+	return Image{Data: data, ContentType: contentType}, nil
+}